@@ -0,0 +1,246 @@
+package cosmos
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// DefaultContractSigVerificationGasLimit is the default maximum amount of gas
+// that a signer contract can consume to verify a single signature.
+const DefaultContractSigVerificationGasLimit uint64 = 200_000
+
+// EIP1271MagicValue is the value returned by `isValidSignature(bytes32,bytes)`
+// when the signature is valid. See https://eips.ethereum.org/EIPS/eip-1271.
+var EIP1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+const erc1271ABIJSON = `[{
+	"type": "function",
+	"name": "isValidSignature",
+	"stateMutability": "view",
+	"inputs": [{"name": "hash", "type": "bytes32"}, {"name": "signature", "type": "bytes"}],
+	"outputs": [{"name": "magicValue", "type": "bytes4"}]
+}]`
+
+var erc1271ABI abi.ABI
+
+func init() {
+	var err error
+	erc1271ABI, err = abi.JSON(strings.NewReader(erc1271ABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ContractSigVerificationDecorator verifies the signatures of a tx signed by
+// smart contract accounts. Each signature is verified by calling the EIP-1271
+// `isValidSignature(bytes32,bytes)` method of the signer contract with the
+// keccak256 hash of the tx SIGN_MODE_DIRECT sign bytes. Every call is limited
+// to the configured gas limit and the gas it consumes is charged to the tx.
+// Note, the ContractSigVerificationDecorator decorator will not get executed on
+// ReCheck.
+//
+// CONTRACT: Tx must have the ExtensionOptionContractSignatureTx extension option
+// CONTRACT: Tx must implement the authsigning.Tx interface
+// CONTRACT: Context must hold the raw tx bytes
+type ContractSigVerificationDecorator struct {
+	ak        anteinterfaces.AccountKeeper
+	evmKeeper anteinterfaces.EVMKeeper
+	gasLimit  uint64
+}
+
+// NewContractSigVerificationDecorator creates a new ContractSigVerificationDecorator
+func NewContractSigVerificationDecorator(
+	ak anteinterfaces.AccountKeeper,
+	evmKeeper anteinterfaces.EVMKeeper,
+	gasLimit uint64,
+) ContractSigVerificationDecorator {
+	return ContractSigVerificationDecorator{
+		ak:        ak,
+		evmKeeper: evmKeeper,
+		gasLimit:  gasLimit,
+	}
+}
+
+// AnteHandle handles validation of the contract signed cosmos txs.
+// it is not run on RecheckTx
+func (svd ContractSigVerificationDecorator) AnteHandle(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	// no need to verify signatures on recheck tx
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "tx %T doesn't implement the authsigning.Tx interface", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signerAddrs, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	// check that signer length and signature length are the same
+	if len(sigs) != len(signerAddrs) {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
+	}
+
+	for i, sig := range sigs {
+		acc, err := authante.GetSignerAcc(ctx, svd.ak, signerAddrs[i])
+		if err != nil {
+			return ctx, err
+		}
+
+		// contract accounts have no private key, so the signature cannot
+		// carry or rely on a public key
+		if sig.PubKey != nil || acc.GetPubKey() != nil {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "contract signer %s cannot have a public key", acc.GetAddress())
+		}
+
+		signer := common.BytesToAddress(signerAddrs[i])
+		if account := svd.evmKeeper.GetAccount(ctx, signer); account == nil || !account.IsContract() {
+			return ctx, errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "signer %s is not a contract", acc.GetAddress())
+		}
+
+		// Check account sequence number.
+		if sig.Sequence != acc.GetSequence() {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			)
+		}
+
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != signing.SignMode_SIGN_MODE_DIRECT {
+			return ctx, errorsmod.Wrapf(errortypes.ErrNotSupported, "unexpected SignatureData %T: contract signatures must use SIGN_MODE_DIRECT", sig.Data)
+		}
+
+		// retrieve signer data
+		genesis := ctx.BlockHeight() == 0
+		chainID := ctx.ChainID()
+
+		var accNum uint64
+		if !genesis {
+			accNum = acc.GetAccountNumber()
+		}
+
+		signBytes, err := directSignBytes(ctx, chainID, accNum)
+		if err != nil {
+			return ctx, err
+		}
+
+		// the verification is still executed when simulating, so that the gas
+		// it consumes is accounted for in the gas estimation
+		err = svd.verifyContractSignature(ctx, signer, acc.GetSequence(), ethcrypto.Keccak256Hash(signBytes), data.Signature)
+		if err != nil && !simulate {
+			errMsg := fmt.Errorf("signature verification failed; please verify account number (%d) and chain-id (%s): %w", accNum, chainID, err)
+			return ctx, errorsmod.Wrap(errortypes.ErrUnauthorized, errMsg.Error())
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// directSignBytes returns the SIGN_MODE_DIRECT sign bytes of the tx. They are
+// built from the raw tx bytes rather than through the sign mode handlers, as
+// those require the signer infos to carry a public key, which contract signers
+// lack. The body and auth info bytes are the ones of the TxRaw transmitted over
+// the wire, so they are not re-encoded.
+func directSignBytes(ctx sdk.Context, chainID string, accNum uint64) ([]byte, error) {
+	var txRaw txtypes.TxRaw
+	if err := txRaw.Unmarshal(ctx.TxBytes()); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrTxDecode, err.Error())
+	}
+	if len(txRaw.BodyBytes) == 0 || len(txRaw.AuthInfoBytes) == 0 {
+		return nil, errorsmod.Wrap(errortypes.ErrTxDecode, "missing raw tx body or auth info bytes")
+	}
+
+	signDoc := txtypes.SignDoc{
+		BodyBytes:     txRaw.BodyBytes,
+		AuthInfoBytes: txRaw.AuthInfoBytes,
+		ChainId:       chainID,
+		AccountNumber: accNum,
+	}
+	return signDoc.Marshal()
+}
+
+// verifyContractSignature calls the EIP-1271 `isValidSignature(bytes32,bytes)`
+// method of the signer contract and checks that it returns the magic value.
+// The gas limit of the call is the decorator gas limit, and the gas consumed by
+// the call is charged to the given context.
+func (svd ContractSigVerificationDecorator) verifyContractSignature(
+	ctx sdk.Context,
+	signer common.Address,
+	nonce uint64,
+	hash common.Hash,
+	signature []byte,
+) error {
+	data, err := erc1271ABI.Pack("isValidSignature", hash, signature)
+	if err != nil {
+		return errorsmod.Wrap(evmtypes.ErrABIPack, err.Error())
+	}
+
+	msg := core.Message{
+		From:       signer,
+		To:         &signer,
+		Nonce:      nonce,
+		Value:      big.NewInt(0),
+		GasLimit:   svd.gasLimit,
+		GasPrice:   big.NewInt(0),
+		GasTipCap:  big.NewInt(0),
+		GasFeeCap:  big.NewInt(0),
+		Data:       data,
+		AccessList: ethtypes.AccessList{},
+	}
+
+	res, err := svd.evmKeeper.ApplyMessage(ctx, msg, nil, false, true)
+	if err != nil {
+		return err
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "EIP-1271 signature verification")
+
+	if res.Failed() {
+		return errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	out, err := erc1271ABI.Unpack("isValidSignature", res.Ret)
+	if err != nil || len(out) != 1 {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "invalid isValidSignature return value: %x", res.Ret)
+	}
+
+	magicValue, ok := out[0].([4]byte)
+	if !ok || !bytes.Equal(magicValue[:], EIP1271MagicValue[:]) {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "contract %s rejected the signature", signer)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	"github.com/cosmos/evm/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -79,8 +80,16 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 			"for eth tx body Memo TimeoutHeight NonCriticalExtensionOptions should be empty")
 	}
 
-	if len(body.ExtensionOptions) != 1 {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx length of ExtensionOptions should be 1")
+	// the ExtensionOptionsEthereumTx can only be followed by the optional
+	// ExtensionOptionFeePayerTx
+	switch len(body.ExtensionOptions) {
+	case 1:
+	case 2:
+		if !types.HasFeePayerExtensionOption(body.ExtensionOptions[1]) {
+			return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx the second ExtensionOption should be ExtensionOptionFeePayerTx")
+		}
+	default:
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx length of ExtensionOptions should be 1 or 2")
 	}
 
 	authInfo := protoTx.AuthInfo
@@ -33,7 +33,7 @@ func NewEthSigVerificationDecorator(ek anteinterfaces.EVMKeeper) EthSigVerificat
 // won't see the error message.
 func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	evmParams := esvd.evmKeeper.GetParams(ctx)
-	ethCfg := evmtypes.GetEthChainConfig()
+	ethCfg := esvd.evmKeeper.GetEthChainConfig(ctx)
 	blockNum := big.NewInt(ctx.BlockHeight())
 	signer := ethtypes.MakeSigner(ethCfg, blockNum, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	allowUnprotectedTxs := evmParams.GetAllowUnprotectedTxs()
@@ -68,7 +68,6 @@ func SignatureVerification(
 	allowUnprotectedTxs bool,
 ) error {
 	ethTx := msg.AsTransaction()
-	ethCfg := evmtypes.GetEthChainConfig()
 
 	if !allowUnprotectedTxs {
 		if !ethTx.Protected() {
@@ -76,10 +75,10 @@ func SignatureVerification(
 				errortypes.ErrNotSupported,
 				"rejected unprotected ethereum transaction; please sign your transaction according to EIP-155 to protect it against replay-attacks")
 		}
-		if ethTx.ChainId().Uint64() != ethCfg.ChainID.Uint64() {
+		if ethTx.ChainId().Uint64() != signer.ChainID().Uint64() {
 			return errorsmod.Wrapf(
 				errortypes.ErrInvalidChainID,
-				"rejected ethereum transaction with incorrect chain-id; expected %d, got %d", ethCfg.ChainID, ethTx.ChainId())
+				"rejected ethereum transaction with incorrect chain-id; expected %d, got %d", signer.ChainID(), ethTx.ChainId())
 		}
 	}
 
@@ -28,13 +28,49 @@ func VerifyAccountBalance(
 	from common.Address,
 	ethTx *ethtypes.Transaction,
 ) error {
+	account, err := verifyAccount(ctx, evmKeeper, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// VerifyAccount checks that the sender is an EOA, without checking its balance.
+// It's used for the transactions which fees are paid by a fee granter, as the
+// sender balance only needs to cover the value, which is checked by CanTransfer.
+// The account will be set to store if it doesn't exist, i.e. cannot be found on store.
+func VerifyAccount(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+) error {
+	_, err := verifyAccount(ctx, evmKeeper, accountKeeper, account, from)
+	return err
+}
+
+// verifyAccount checks that the sender is an EOA and sets its account to store
+// if it doesn't exist. It returns the account of the sender.
+func verifyAccount(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+) (*statedb.Account, error) {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.IsContract() {
 		// check eip-7702
 		code := evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
 		_, delegated := ethtypes.ParseDelegation(code)
 		if len(code) > 0 && !delegated {
-			return errorsmod.Wrapf(
+			return nil, errorsmod.Wrapf(
 				errortypes.ErrInvalidType,
 				"the sender is not EOA: address %s", from,
 			)
@@ -46,9 +82,5 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
-		return errorsmod.Wrap(err, "failed to check sender balance")
-	}
-
-	return nil
+	return account, nil
 }
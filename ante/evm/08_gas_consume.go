@@ -1,10 +1,12 @@
 package evm
 
 import (
+	"bytes"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	"github.com/cosmos/evm/types"
@@ -14,6 +16,7 @@ import (
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 // UpdateCumulativeGasWanted updates the cumulative gas wanted
@@ -61,6 +64,89 @@ func ConsumeFeesAndEmitEvent(
 	return nil
 }
 
+// GetFeePayer returns the fee granter specified by the ExtensionOptionFeePayerTx
+// extension option of the transaction, or nil if the sender pays the fees.
+// The extension options are not covered by the Ethereum signature, so the fee
+// payer must be authorized by a signature of the sender over the tx hash and
+// the fee payer address.
+//
+// CONTRACT: the sender of the Ethereum msg must have been verified.
+func GetFeePayer(tx sdktypes.Tx, ethMsg *evmtypes.MsgEthereumTx) (sdktypes.AccAddress, error) {
+	txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	for _, opt := range txWithExtensions.GetExtensionOptions() {
+		extOpt, ok := opt.GetCachedValue().(*types.ExtensionOptionFeePayerTx)
+		if !ok {
+			continue
+		}
+
+		feePayer, err := sdktypes.AccAddressFromBech32(extOpt.FeePayer)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to parse feePayer from ExtensionOptionFeePayerTx")
+		}
+
+		if err := verifyFeePayerSig(ethMsg, feePayer, extOpt.FeePayerSig); err != nil {
+			return nil, err
+		}
+		return feePayer, nil
+	}
+
+	return nil, nil
+}
+
+// verifyFeePayerSig checks that the fee payer signature is signed by the
+// sender of the Ethereum msg for the given fee payer.
+func verifyFeePayerSig(ethMsg *evmtypes.MsgEthereumTx, feePayer sdktypes.AccAddress, feePayerSig []byte) error {
+	if len(feePayerSig) != ethcrypto.SignatureLength {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "fee payer signature length doesn't match typical [R||S||V] signature 65 bytes")
+	}
+
+	// Remove the recovery offset if needed (ie. Metamask signature)
+	sig := common.CopyBytes(feePayerSig)
+	if sig[ethcrypto.RecoveryIDOffset] == 27 || sig[ethcrypto.RecoveryIDOffset] == 28 {
+		sig[ethcrypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := ethcrypto.SigToPub(types.FeePayerSignHash(ethMsg.Hash(), feePayer).Bytes(), sig)
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "failed to recover the signer of the fee payer signature")
+	}
+
+	signer := ethcrypto.PubkeyToAddress(*pubKey)
+	if !bytes.Equal(signer.Bytes(), ethMsg.GetFrom()) {
+		return errorsmod.Wrapf(
+			errortypes.ErrorInvalidSigner,
+			"fee payer signature signed by %s instead of the sender %s", signer, common.BytesToAddress(ethMsg.GetFrom()),
+		)
+	}
+
+	return nil
+}
+
+// UseFeeAllowance deducts the fees from the allowance granted by the fee payer
+// to the sender of the transaction.
+func UseFeeAllowance(
+	ctx sdktypes.Context,
+	feegrantKeeper anteinterfaces.FeegrantKeeper,
+	fees sdktypes.Coins,
+	feePayer sdktypes.AccAddress,
+	from sdktypes.AccAddress,
+	msgs []sdktypes.Msg,
+) error {
+	if feegrantKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	if err := feegrantKeeper.UseGrantedFees(ctx, feePayer, from, fees, msgs); err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feePayer, from)
+	}
+
+	return nil
+}
+
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
 func deductFees(
 	ctx sdktypes.Context,
@@ -3,6 +3,8 @@ package evm
 import (
 	"math"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 
 	errorsmod "cosmossdk.io/errors"
@@ -45,3 +47,26 @@ func IncrementNonce(
 	accountKeeper.SetAccount(ctx, account)
 	return nil
 }
+
+// CheckMempoolNonce validates the nonce of a transaction that doesn't match the
+// account sequence on CheckTx. Transactions with a future nonce are accepted so
+// that the mempool can queue them until the nonce gap is closed, while
+// transactions with an already used nonce are only accepted if they replace a
+// transaction held by the mempool. The sequence is not incremented in both
+// cases since the transaction is not executed on top of the current state.
+func CheckMempoolNonce(
+	mempool anteinterfaces.EVMMempool,
+	account sdk.AccountI,
+	sender common.Address,
+	txNonce uint64,
+) error {
+	nonce := account.GetSequence()
+	if txNonce > nonce || mempool.HasNonce(sender, txNonce) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		errortypes.ErrInvalidSequence,
+		"invalid nonce; got %d, expected %d", txNonce, nonce,
+	)
+}
@@ -25,7 +25,7 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
-func NewDynamicFeeChecker(k anteinterfaces.FeeMarketKeeper) authante.TxFeeChecker {
+func NewDynamicFeeChecker(ek anteinterfaces.ChainConfigKeeper, k anteinterfaces.FeeMarketKeeper) authante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
 		if !ok {
@@ -38,7 +38,7 @@ func NewDynamicFeeChecker(k anteinterfaces.FeeMarketKeeper) authante.TxFeeChecke
 			return checkTxFeeWithValidatorMinGasPrices(ctx, feeTx)
 		}
 		denom := evmtypes.GetEVMCoinDenom()
-		ethCfg := evmtypes.GetEthChainConfig()
+		ethCfg := ek.GetEthChainConfig(ctx)
 
 		return FeeChecker(ctx, k, denom, ethCfg, feeTx)
 	}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -47,6 +48,14 @@ func (m MockFeemarketKeeper) GetParams(_ sdk.Context) (params feemarkettypes.Par
 	return feemarkettypes.DefaultParams()
 }
 
+var _ anteinterfaces.ChainConfigKeeper = MockChainConfigKeeper{}
+
+type MockChainConfigKeeper struct{}
+
+func (m MockChainConfigKeeper) GetEthChainConfig(_ sdk.Context) *params.ChainConfig {
+	return evmtypes.GetEthChainConfig()
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
@@ -258,7 +267,7 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			} else {
 				cfg.LondonBlock = big.NewInt(0)
 			}
-			fees, priority, err := evm.NewDynamicFeeChecker(tc.keeper)(tc.ctx, tc.buildTx())
+			fees, priority, err := evm.NewDynamicFeeChecker(MockChainConfigKeeper{}, tc.keeper)(tc.ctx, tc.buildTx())
 			if tc.expSuccess {
 				require.Equal(t, tc.expFees, fees.String())
 				require.Equal(t, tc.expPriority, priority)
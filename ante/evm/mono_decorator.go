@@ -25,6 +25,8 @@ type MonoDecorator struct {
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
 	maxGasWanted    uint64
+	mempool         anteinterfaces.EVMMempool
+	feegrantKeeper  anteinterfaces.FeegrantKeeper
 }
 
 // NewEVMMonoDecorator creates the 'mono' decorator, that is used to run the ante handle logic
@@ -47,6 +49,24 @@ func NewEVMMonoDecorator(
 	}
 }
 
+// WithMempool returns a copy of the decorator that accepts on CheckTx the
+// transactions with a nonce gap or replacing a transaction held by the given
+// application mempool, instead of requiring the nonce to match the account
+// sequence.
+func (md MonoDecorator) WithMempool(mempool anteinterfaces.EVMMempool) MonoDecorator {
+	md.mempool = mempool
+	return md
+}
+
+// WithFeegrantKeeper returns a copy of the decorator that accepts the
+// transactions which fees are paid by a fee granter, specified through the
+// ExtensionOptionFeePayerTx extension option, using the allowance granted to
+// the sender on the given feegrant keeper.
+func (md MonoDecorator) WithFeegrantKeeper(feegrantKeeper anteinterfaces.FeegrantKeeper) MonoDecorator {
+	md.feegrantKeeper = feegrantKeeper
+	return md
+}
+
 // AnteHandle handles the entire decorator chain using a mono decorator.
 func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// 0. Basic validation of the transaction
@@ -139,19 +159,41 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	from := ethMsg.GetFrom()
 	fromAddr := common.BytesToAddress(from)
 
+	// the fees are paid by the fee granter, if any, on behalf of the sender
+	feeGranter, err := GetFeePayer(tx, ethMsg)
+	if err != nil {
+		return ctx, err
+	}
+	if feeGranter.Equals(from) {
+		feeGranter = nil
+	}
+
 	// 6. account balance verification
 	// We get the account with the balance from the EVM keeper because it is
 	// using a wrapper of the bank keeper as a dependency to scale all
 	// balances to 18 decimals.
 	account := md.evmKeeper.GetAccount(ctx, fromAddr)
-	if err := VerifyAccountBalance(
-		ctx,
-		md.evmKeeper,
-		md.accountKeeper,
-		account,
-		fromAddr,
-		ethTx,
-	); err != nil {
+	if feeGranter != nil {
+		// the sender balance only needs to cover the value of the tx, which
+		// is checked by CanTransfer
+		err = VerifyAccount(
+			ctx,
+			md.evmKeeper,
+			md.accountKeeper,
+			account,
+			fromAddr,
+		)
+	} else {
+		err = VerifyAccountBalance(
+			ctx,
+			md.evmKeeper,
+			md.accountKeeper,
+			account,
+			fromAddr,
+			ethTx,
+		)
+	}
+	if err != nil {
 		return ctx, err
 	}
 
@@ -182,16 +224,35 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	feePayer := from
+	if feeGranter != nil {
+		if err := UseFeeAllowance(
+			ctx,
+			md.feegrantKeeper,
+			msgFees,
+			feeGranter,
+			from,
+			msgs,
+		); err != nil {
+			return ctx, err
+		}
+		feePayer = feeGranter
+	}
+
 	err = ConsumeFeesAndEmitEvent(
 		ctx,
 		md.evmKeeper,
 		msgFees,
-		from,
+		feePayer,
 	)
 	if err != nil {
 		return ctx, err
 	}
 
+	// store the fee granter, if any, to refund it the leftover gas after
+	// the execution of the tx
+	md.evmKeeper.SetTxFeePayerTransient(ctx, feeGranter)
+
 	gasWanted := UpdateCumulativeGasWanted(
 		ctx,
 		gas,
@@ -226,7 +287,13 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		)
 	}
 
-	if err := IncrementNonce(ctx, md.accountKeeper, acc, ethTx.Nonce()); err != nil {
+	if md.mempool != nil && ctx.IsCheckTx() && ethTx.Nonce() != acc.GetSequence() {
+		// the transaction is either queued or replacing a pending one on the
+		// mempool, so it's not executed on top of the current sequence
+		if err := CheckMempoolNonce(md.mempool, acc, fromAddr, ethTx.Nonce()); err != nil {
+			return ctx, err
+		}
+	} else if err := IncrementNonce(ctx, md.accountKeeper, acc, ethTx.Nonce()); err != nil {
 		return ctx, err
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
@@ -62,13 +63,20 @@ func (k *ExtendedEVMKeeper) SpendableCoin(ctx sdk.Context, addr common.Address)
 	return uint256.NewInt(0)
 }
 
-func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context) {}
+func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context)                    {}
+func (k *ExtendedEVMKeeper) SetTxFeePayerTransient(_ sdk.Context, _ sdk.AccAddress) {}
 func (k *ExtendedEVMKeeper) GetParams(_ sdk.Context) evmsdktypes.Params {
 	return evmsdktypes.DefaultParams()
 }
 func (k *ExtendedEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int           { return big.NewInt(0) }
 func (k *ExtendedEVMKeeper) GetMinGasPrice(_ sdk.Context) math.LegacyDec { return math.LegacyZeroDec() }
 func (k *ExtendedEVMKeeper) GetTxIndexTransient(_ sdk.Context) uint64    { return 0 }
+func (k *ExtendedEVMKeeper) GetEthChainConfig(_ sdk.Context) *params.ChainConfig {
+	return evmsdktypes.GetEthChainConfig()
+}
+func (k *ExtendedEVMKeeper) ApplyMessage(_ sdk.Context, _ core.Message, _ *tracing.Hooks, _ bool, _ bool) (*evmsdktypes.MsgEthereumTxResponse, error) {
+	return nil, nil
+}
 
 // only methods called by EVMMonoDecorator
 type MockFeeMarketKeeper struct{}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
 	evmante "github.com/cosmos/evm/ante/evm"
@@ -48,6 +49,11 @@ func (m *mockAccountKeeper) TryAddUnorderedNonce(ctx sdk.Context, sender []byte,
 	return nil
 }
 
+// --- mock implementing anteinterfaces.EVMMempool ---
+type mockMempool struct{ nonces map[uint64]bool }
+
+func (m mockMempool) HasNonce(_ common.Address, nonce uint64) bool { return m.nonces[nonce] }
+
 func baseAcc(seq uint64) *authtypes.BaseAccount { return &authtypes.BaseAccount{Sequence: seq} }
 
 func TestIncrementNonce_HappyPath(t *testing.T) {
@@ -82,3 +88,18 @@ func TestIncrementNonce_OverflowGuard(t *testing.T) {
 	require.Contains(t, err.Error(), "overflow")
 	require.Equal(t, uint64(math.MaxUint64), acc.GetSequence()) // unchanged
 }
+
+func TestCheckMempoolNonce(t *testing.T) {
+	sender := common.HexToAddress("0x1")
+	mempool := mockMempool{nonces: map[uint64]bool{8: true}}
+	acc := baseAcc(10)
+
+	// future nonces are queued by the mempool
+	require.NoError(t, evmante.CheckMempoolNonce(mempool, acc, sender, 12))
+	// used nonces are only accepted as a replacement
+	require.NoError(t, evmante.CheckMempoolNonce(mempool, acc, sender, 8))
+	err := evmante.CheckMempoolNonce(mempool, acc, sender, 9)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid nonce")
+	require.Equal(t, uint64(10), acc.GetSequence()) // unchanged
+}
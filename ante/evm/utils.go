@@ -45,7 +45,7 @@ func NewMonoDecoratorUtils(
 	ek anteinterfaces.EVMKeeper,
 ) (*DecoratorUtils, error) {
 	evmParams := ek.GetParams(ctx)
-	ethCfg := evmtypes.GetEthChainConfig()
+	ethCfg := ek.GetEthChainConfig(ctx)
 	evmDenom := evmtypes.GetEVMCoinDenom()
 	blockHeight := big.NewInt(ctx.BlockHeight())
 	rules := ethCfg.Rules(blockHeight, true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
@@ -30,3 +30,9 @@ type BankKeeper interface {
 	SendCoins(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// FeegrantKeeper defines the expected feegrant keeper used to deduct the fees
+// paid by a fee granter on behalf of the sender of a transaction.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
@@ -19,15 +20,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
+// ChainConfigKeeper exposes the chain config used in the EVM at a given context
+type ChainConfigKeeper interface {
+	GetEthChainConfig(ctx sdk.Context) *params.ChainConfig
+}
+
 // EVMKeeper exposes the required EVM keeper interface required for ante handlers
 type EVMKeeper interface {
 	statedb.Keeper
+	ChainConfigKeeper
 
 	NewEVM(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, tracer *tracing.Hooks,
 		stateDB vm.StateDB) *vm.EVM
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
 	SpendableCoin(ctx sdk.Context, addr common.Address) *uint256.Int
 	ResetTransientGasUsed(ctx sdk.Context)
+	SetTxFeePayerTransient(ctx sdk.Context, feePayer sdk.AccAddress)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
 	// GetBaseFee returns the BaseFee param from the fee market module
@@ -36,6 +44,15 @@ type EVMKeeper interface {
 	// GetMinGasPrice returns the MinGasPrice param from the fee market module
 	// adapted according to the evm denom decimals
 	GetMinGasPrice(ctx sdk.Context) math.LegacyDec
+	ApplyMessage(ctx sdk.Context, msg core.Message, tracer *tracing.Hooks, commit bool, internal bool) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+// EVMMempool exposes the required application mempool interface to accept
+// transactions with a nonce gap or replacing a pending one on CheckTx
+type EVMMempool interface {
+	// HasNonce returns true if the mempool holds a transaction from the sender
+	// with the given nonce.
+	HasNonce(sender common.Address, nonce uint64) bool
 }
 
 // FeeMarketKeeper exposes the required feemarket keeper interface required for ante handlers
@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_EthereumTx_FullMethodName          = "/cosmos.evm.vm.v1.Msg/EthereumTx"
-	Msg_UpdateParams_FullMethodName        = "/cosmos.evm.vm.v1.Msg/UpdateParams"
-	Msg_RegisterPreinstalls_FullMethodName = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_EthereumTx_FullMethodName             = "/cosmos.evm.vm.v1.Msg/EthereumTx"
+	Msg_UpdateParams_FullMethodName           = "/cosmos.evm.vm.v1.Msg/UpdateParams"
+	Msg_RegisterPreinstalls_FullMethodName    = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_ScheduleForkActivation_FullMethodName = "/cosmos.evm.vm.v1.Msg/ScheduleForkActivation"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +39,10 @@ type MsgClient interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// ScheduleForkActivation defines a governance operation for scheduling the
+	// activation time of a future Ethereum hard fork. The authority is the same
+	// as is used for Params updates.
+	ScheduleForkActivation(ctx context.Context, in *MsgScheduleForkActivation, opts ...grpc.CallOption) (*MsgScheduleForkActivationResponse, error)
 }
 
 type msgClient struct {
@@ -75,6 +80,15 @@ func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPrei
 	return out, nil
 }
 
+func (c *msgClient) ScheduleForkActivation(ctx context.Context, in *MsgScheduleForkActivation, opts ...grpc.CallOption) (*MsgScheduleForkActivationResponse, error) {
+	out := new(MsgScheduleForkActivationResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleForkActivation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -89,6 +103,10 @@ type MsgServer interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// ScheduleForkActivation defines a governance operation for scheduling the
+	// activation time of a future Ethereum hard fork. The authority is the same
+	// as is used for Params updates.
+	ScheduleForkActivation(context.Context, *MsgScheduleForkActivation) (*MsgScheduleForkActivationResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -105,6 +123,9 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
 }
+func (UnimplementedMsgServer) ScheduleForkActivation(context.Context, *MsgScheduleForkActivation) (*MsgScheduleForkActivationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleForkActivation not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -172,6 +193,24 @@ func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleForkActivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleForkActivation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleForkActivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleForkActivation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleForkActivation(ctx, req.(*MsgScheduleForkActivation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +230,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPreinstalls",
 			Handler:    _Msg_RegisterPreinstalls_Handler,
 		},
+		{
+			MethodName: "ScheduleForkActivation",
+			Handler:    _Msg_ScheduleForkActivation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
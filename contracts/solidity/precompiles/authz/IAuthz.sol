@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev GrantAuthorization defines an authorization granted by a granter to a grantee.
+struct GrantAuthorization {
+    /// @dev granter is the address of the account granting the authorization
+    address granter;
+    /// @dev grantee is the address of the account the authorization is granted to
+    address grantee;
+    /// @dev msgTypeUrl is the type URL of the Cosmos message the authorization is granted for
+    string msgTypeUrl;
+    /// @dev authorization is the JSON encoding of the authorization, including its "@type"
+    bytes authorization;
+    /// @dev expiration is the unix timestamp at which the authorization expires, 0 if it does not expire
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with authz.
+/// We follow this same interface including four-byte function selectors, in the precompile that
+/// wraps the pallet.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface IAuthz {
+    /// @dev Emitted when an authorization is granted.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the Cosmos message the authorization is granted for
+    event Grant(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Emitted when an authorization is revoked.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the Cosmos message the authorization was granted for
+    event Revoke(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Emitted for each message executed by a grantee on behalf of a granter.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the executed Cosmos message
+    event Exec(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Grants the grantee an authorization to execute any message of the given type on behalf of the granter.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the Cosmos message to authorize
+    /// @param expiration The unix timestamp at which the authorization expires, 0 for no expiration
+    /// @return success Whether the authorization was granted
+    function grantGenericAuthorization(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Grants the grantee an authorization to send coins on behalf of the granter.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of coins the grantee can send
+    /// @param allowList The addresses the grantee can send coins to, empty for any address
+    /// @param expiration The unix timestamp at which the authorization expires, 0 for no expiration
+    /// @return success Whether the authorization was granted
+    function grantSendAuthorization(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Grants the grantee an authorization to perform a staking operation on behalf of the granter.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param authorizationType The staking operation to authorize: 1 for delegate, 2 for undelegate,
+    /// 3 for redelegate and 4 for cancel unbonding delegation
+    /// @param allowedValidators The validators the operation is allowed for
+    /// @param deniedValidators The validators the operation is denied for, used when allowedValidators is empty
+    /// @param maxTokens The maximum amount of tokens the operation can use, 0 for no limit
+    /// @param expiration The unix timestamp at which the authorization expires, 0 for no expiration
+    /// @return success Whether the authorization was granted
+    function grantStakeAuthorization(
+        address granter,
+        address grantee,
+        uint8 authorizationType,
+        address[] calldata allowedValidators,
+        address[] calldata deniedValidators,
+        Coin calldata maxTokens,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes the authorization granted to the grantee for the given message type.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the Cosmos message the authorization was granted for
+    /// @return success Whether the authorization was revoked
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Executes the given Cosmos messages on behalf of their signers, using the
+    /// authorizations granted to the grantee.
+    /// @param grantee The address of the grantee, which must be the caller
+    /// @param msgs The JSON encoded Cosmos messages, including their "@type"
+    /// @return results The results of the executed messages
+    function exec(
+        address grantee,
+        bytes[] calldata msgs
+    ) external returns (bytes[] memory results);
+
+    /// @dev Returns the authorizations granted by the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the Cosmos message to filter by, empty for all types
+    /// @param pagination Pagination configuration for the query
+    /// @return authorizations The granted authorizations
+    /// @return pageResponse Pagination information for the response
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    ) external view returns (GrantAuthorization[] memory authorizations, PageResponse memory pageResponse);
+
+    /// @dev Returns the authorizations granted by the granter.
+    /// @param granter The address of the granter
+    /// @param pagination Pagination configuration for the query
+    /// @return authorizations The granted authorizations
+    /// @return pageResponse Pagination information for the response
+    function granterGrants(
+        address granter,
+        PageRequest calldata pagination
+    ) external view returns (GrantAuthorization[] memory authorizations, PageResponse memory pageResponse);
+
+    /// @dev Returns the authorizations granted to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination Pagination configuration for the query
+    /// @return authorizations The granted authorizations
+    /// @return pageResponse Pagination information for the response
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    ) external view returns (GrantAuthorization[] memory authorizations, PageResponse memory pageResponse);
+}
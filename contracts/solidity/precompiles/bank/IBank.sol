@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
 
@@ -15,12 +17,63 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev Input specifies the account sending coins in a multiSend transaction.
+struct Input {
+    /// addr defines the address of the sender.
+    address addr;
+    /// coins defines the coins sent by the sender.
+    Coin[] coins;
+}
+
+/// @dev Output specifies an account receiving coins in a multiSend transaction.
+struct Output {
+    /// addr defines the address of the recipient.
+    address addr;
+    /// coins defines the coins received by the recipient.
+    Coin[] coins;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module,
+ * and for transferring native coins.
  */
 interface IBank {
+    /// @dev Send defines an Event emitted for each coin transferred by a send
+    /// or multiSend transaction.
+    /// @param from the address of the sender
+    /// @param to the address of the recipient
+    /// @param denom the denomination of the transferred coin
+    /// @param amount the amount of the transferred coin
+    event Send(
+        address indexed from,
+        address indexed to,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev send defines a method for transferring native coins of any
+    /// denomination from the caller to the given recipient.
+    /// @param to the address of the recipient.
+    /// @param amount the coins to transfer.
+    /// @return success true if the transfer was successful.
+    function send(
+        address to,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev multiSend defines a method for transferring native coins from the
+    /// caller to multiple recipients. The caller must be the single input, and
+    /// the sum of the input coins must match the sum of the output coins.
+    /// @param inputs the sender of the coins and the coins it sends.
+    /// @param outputs the recipients of the coins and the coins they receive.
+    /// @return success true if the transfer was successful.
+    function multiSend(
+        Input[] calldata inputs,
+        Output[] calldata outputs
+    ) external returns (bool success);
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev FeeAllowance defines a fee allowance granted by a granter to a grantee.
+struct FeeAllowance {
+    /// @dev granter is the address of the account paying the fees
+    address granter;
+    /// @dev grantee is the address of the account whose fees are paid
+    address grantee;
+    /// @dev allowance is the JSON encoding of the fee allowance, including its "@type"
+    bytes allowance;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with feegrant.
+/// We follow this same interface including four-byte function selectors, in the precompile that
+/// wraps the pallet.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IFeegrant {
+    /// @dev Emitted when a fee allowance is granted.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    event GrantAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev Emitted when a fee allowance is revoked.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev Grants the grantee an allowance to pay its transaction fees from the granter's balance.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of coins the grantee can spend on fees, empty for no limit
+    /// @param expiration The unix timestamp at which the allowance expires, 0 for no expiration
+    /// @param allowedMessages The type URLs of the Cosmos messages the allowance can be used for,
+    /// empty for any message
+    /// @return success Whether the allowance was granted
+    function grantAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        string[] calldata allowedMessages
+    ) external returns (bool success);
+
+    /// @dev Revokes the fee allowance granted to the grantee.
+    /// @param granter The address of the granter, which must be the caller
+    /// @param grantee The address of the grantee
+    /// @return success Whether the allowance was revoked
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// @dev Returns the fee allowance granted by the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return allowance The granted fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (FeeAllowance memory allowance);
+
+    /// @dev Returns the fee allowances granted to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination Pagination configuration for the query
+    /// @return allowances The granted fee allowances
+    /// @return pageResponse Pagination information for the response
+    function allowances(
+        address grantee,
+        PageRequest calldata pagination
+    ) external view returns (FeeAllowance[] memory allowances, PageResponse memory pageResponse);
+
+    /// @dev Returns the fee allowances granted by the granter.
+    /// @param granter The address of the granter
+    /// @param pagination Pagination configuration for the query
+    /// @return allowances The granted fee allowances
+    /// @return pageResponse Pagination information for the response
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pagination
+    ) external view returns (FeeAllowance[] memory allowances, PageResponse memory pageResponse);
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The ICS27I contract's address.
+address constant ICS27_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The ICS27 contract's instance.
+ICS27I constant ICS27_CONTRACT = ICS27I(ICS27_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title ICS27 Interchain Accounts Precompiled Contract
+/// @dev The interface through which solidity contracts will control interchain accounts (ICS27)
+/// on counterparty chains.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface ICS27I {
+    /// @dev Emitted when the registration of an interchain account is initiated.
+    /// @param owner The address of the interchain account owner.
+    /// @param connectionId The identifier of the connection to the host chain.
+    /// @param portId The controller port identifier of the owner.
+    /// @param channelId The identifier of the channel being opened.
+    event InterchainAccountRegistered(
+        address indexed owner,
+        string connectionId,
+        string portId,
+        string channelId
+    );
+
+    /// @dev Emitted when an interchain account transaction packet is sent.
+    /// @param owner The address of the interchain account owner.
+    /// @param connectionId The identifier of the connection to the host chain.
+    /// @param channelId The identifier of the channel the packet is sent on.
+    /// @param sequence The sequence number of the packet.
+    event InterchainAccountTxSent(
+        address indexed owner,
+        string connectionId,
+        string channelId,
+        uint64 sequence
+    );
+
+    /// @dev Registers an interchain account for the owner on the host chain of the
+    /// given connection. The account address is available once the channel handshake
+    /// is completed by a relayer.
+    /// @param owner The address of the interchain account owner, which must be the caller.
+    /// @param connectionId The identifier of the connection to the host chain.
+    /// @param version The ICS27 metadata used as channel version. The default metadata,
+    /// with protobuf encoding, is used when empty.
+    /// @return channelId The identifier of the channel being opened.
+    /// @return portId The controller port identifier of the owner.
+    function registerInterchainAccount(
+        address owner,
+        string memory connectionId,
+        string memory version
+    ) external returns (string memory channelId, string memory portId);
+
+    /// @dev Sends the given messages to be executed by the interchain account of the
+    /// owner on the host chain. Acknowledgements and timeouts are delivered to the
+    /// ICallbacks entrypoints of the contract set as "src_callback" in the memo.
+    /// @param owner The address of the interchain account owner, which must be the caller.
+    /// @param connectionId The identifier of the connection to the host chain.
+    /// @param msgs The protobuf-encoded google.protobuf.Any messages to execute.
+    /// @param memo The optional memo of the packet.
+    /// @param relativeTimeout The timeout of the packet in nanoseconds, relative to
+    /// the current block time.
+    /// @return sequence The sequence number of the packet.
+    function sendTx(
+        address owner,
+        string memory connectionId,
+        bytes[] memory msgs,
+        string memory memo,
+        uint64 relativeTimeout
+    ) external returns (uint64 sequence);
+
+    /// @dev Returns the address of the interchain account of the owner on the host
+    /// chain of the given connection.
+    /// @param owner The address of the interchain account owner.
+    /// @param connectionId The identifier of the connection to the host chain.
+    /// @return accountAddress The interchain account address, or an empty string if
+    /// the account is not registered.
+    function interchainAccount(
+        address owner,
+        string memory connectionId
+    ) external view returns (string memory accountAddress);
+}
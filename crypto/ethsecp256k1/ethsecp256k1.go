@@ -218,11 +218,18 @@ func (pubKey PubKey) VerifySignature(msg, sig []byte) bool {
 
 // Verifies the signature as an EIP-712 signature by first converting the message payload
 // to EIP-712 object bytes, then performing ECDSA verification on the hash. This is to support
-// signing a Cosmos payload using EIP-712.
+// signing a Cosmos payload using EIP-712. The schema-driven encoding is only used for the
+// payloads which cannot be represented by walking their JSON, so that the encoding is
+// selected by the payload (see eip712.SelectEIP712TypedDataForMsg).
 func (pubKey PubKey) verifySignatureAsEIP712(msg, sig []byte) bool {
 	eip712Bytes, err := eip712.GetEIP712BytesForMsg(msg)
 	if err != nil {
-		return false
+		schemaEIP712Bytes, err := eip712.SchemaGetEIP712BytesForMsg(msg)
+		if err != nil {
+			return false
+		}
+
+		return pubKey.verifySignatureECDSA(schemaEIP712Bytes, sig)
 	}
 
 	if pubKey.verifySignatureECDSA(eip712Bytes, sig) {
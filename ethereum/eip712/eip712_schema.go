@@ -0,0 +1,466 @@
+package eip712
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	anyTypeName       = "Any"
+	anyFullName       = "google.protobuf.Any"
+	timestampFullName = "google.protobuf.Timestamp"
+	durationFullName  = "google.protobuf.Duration"
+
+	anyTypeField   = "type"
+	anyValueField  = "value"
+	tupleItemField = "item"
+
+	ethBytes  = "bytes"
+	ethInt32  = "int32"
+	ethUint32 = "uint32"
+	ethUint64 = "uint64"
+
+	// maxSchemaDepth is the maximum nesting depth of the messages encoded with
+	// the schema-driven encoder, which bounds the recursion on nested Anys and
+	// recursive message definitions.
+	maxSchemaDepth = 32
+)
+
+// schemaTx holds the signed fields of a Cosmos transaction, independently of
+// the sign mode.
+type schemaTx struct {
+	chainID       string
+	accountNumber uint64
+	sequence      uint64
+	timeoutHeight uint64
+	fee           sdk.Coins
+	gas           uint64
+	payer         string
+	granter       string
+	memo          string
+	msgs          []*codectypes.Any
+}
+
+// schemaEncoder derives the EIP-712 types of the messages from the protobuf
+// descriptors registered in the interface registry, rather than from their
+// JSON representation. The types only depend on the message schemas and on
+// the concrete types of the Anys, so that heterogeneous arrays, empty fields
+// and nested Anys are encoded deterministically.
+type schemaEncoder struct {
+	registry codectypes.InterfaceRegistry
+	types    apitypes.Types
+}
+
+// schemaWrapTxToTypedData wraps the transaction into an EIP712-compatible
+// TypedData request, using the schema-driven encoder for the messages.
+func schemaWrapTxToTypedData(
+	registry codectypes.InterfaceRegistry,
+	chainID uint64,
+	tx schemaTx,
+) (typedData apitypes.TypedData, err error) {
+	defer doRecover(&err)
+
+	enc := &schemaEncoder{
+		registry: registry,
+		types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "string"},
+				{Name: "salt", Type: "string"},
+			},
+			"Fee": {
+				{Name: "amount", Type: "Coin[]"},
+				{Name: "gas", Type: "string"},
+				{Name: "payer", Type: "string"},
+				{Name: "granter", Type: "string"},
+			},
+			"Coin": {
+				{Name: "denom", Type: "string"},
+				{Name: "amount", Type: "string"},
+			},
+		},
+	}
+
+	msgs := make([]encodedValue, len(tx.msgs))
+	for i, msg := range tx.msgs {
+		if msgs[i], err = enc.encodeAny(msg.TypeUrl, msg.Value, 0); err != nil {
+			return apitypes.TypedData{}, err
+		}
+	}
+	var emptyType string
+	if len(msgs) == 0 {
+		emptyAny, err := enc.encodeAny("", nil, 0)
+		if err != nil {
+			return apitypes.TypedData{}, err
+		}
+		emptyType = emptyAny.typ
+	}
+	msgsType, msgsValue, err := enc.encodeList(txField, payloadMsgsField, msgs, emptyType)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	enc.types[txField] = []apitypes.Type{
+		{Name: "account_number", Type: ethString},
+		{Name: "chain_id", Type: ethString},
+		{Name: "fee", Type: "Fee"},
+		{Name: "memo", Type: ethString},
+		{Name: payloadMsgsField, Type: msgsType},
+		{Name: "sequence", Type: ethString},
+		{Name: "timeout_height", Type: ethString},
+	}
+
+	amount := make([]interface{}, len(tx.fee))
+	for i, coin := range tx.fee {
+		amount[i] = map[string]interface{}{
+			"denom":  coin.Denom,
+			"amount": coin.Amount.String(),
+		}
+	}
+
+	message := apitypes.TypedDataMessage{
+		"account_number": strconv.FormatUint(tx.accountNumber, 10),
+		"chain_id":       tx.chainID,
+		"fee": map[string]interface{}{
+			"amount":  amount,
+			"gas":     strconv.FormatUint(tx.gas, 10),
+			"payer":   tx.payer,
+			"granter": tx.granter,
+		},
+		"memo":           tx.memo,
+		payloadMsgsField: msgsValue,
+		"sequence":       strconv.FormatUint(tx.sequence, 10),
+		"timeout_height": strconv.FormatUint(tx.timeoutHeight, 10),
+	}
+
+	return apitypes.TypedData{
+		Types:       enc.types,
+		PrimaryType: txField,
+		Domain:      createEIP712Domain(chainID),
+		Message:     message,
+	}, nil
+}
+
+// encodedValue is a value encoded with its EIP-712 type.
+type encodedValue struct {
+	typ   string
+	value interface{}
+}
+
+// encodeAny encodes the packed message as a struct holding its type URL and
+// its value, typed by the concrete type. The concrete type must be registered
+// in the interface registry. An empty Any is encoded with its raw value.
+func (e *schemaEncoder) encodeAny(typeURL string, value []byte, depth int) (encodedValue, error) {
+	if depth > maxSchemaDepth {
+		return encodedValue{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "message exceeds the maximum nesting depth of %d", maxSchemaDepth)
+	}
+
+	if typeURL == "" {
+		typeDef, err := addTypesToRoot(e.types, anyTypeName, []apitypes.Type{
+			{Name: anyTypeField, Type: ethString},
+			{Name: anyValueField, Type: ethBytes},
+		})
+		if err != nil {
+			return encodedValue{}, err
+		}
+		return encodedValue{
+			typ: typeDef,
+			value: map[string]interface{}{
+				anyTypeField:  typeURL,
+				anyValueField: hexutil.Bytes(value),
+			},
+		}, nil
+	}
+
+	if _, err := e.registry.Resolve(typeURL); err != nil {
+		return encodedValue{}, errorsmod.Wrapf(errortypes.ErrInvalidType, "unregistered type %s: %s", typeURL, err)
+	}
+	desc, err := e.registry.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(typeURL, "/")))
+	if err != nil {
+		return encodedValue{}, errorsmod.Wrapf(errortypes.ErrInvalidType, "no descriptor found for type %s: %s", typeURL, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return encodedValue{}, errorsmod.Wrapf(errortypes.ErrInvalidType, "type %s is not a message", typeURL)
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return encodedValue{}, errorsmod.Wrapf(errortypes.ErrInvalidType, "failed to unmarshal %s: %s", typeURL, err)
+	}
+
+	encoded, err := e.encodeMessage(msg, depth+1)
+	if err != nil {
+		return encodedValue{}, err
+	}
+
+	typeDef, err := addTypesToRoot(e.types, anyTypeName+typeNameForMessage(msgDesc.FullName()), []apitypes.Type{
+		{Name: anyTypeField, Type: ethString},
+		{Name: anyValueField, Type: encoded.typ},
+	})
+	if err != nil {
+		return encodedValue{}, err
+	}
+
+	return encodedValue{
+		typ: typeDef,
+		value: map[string]interface{}{
+			anyTypeField:  typeURL,
+			anyValueField: encoded.value,
+		},
+	}, nil
+}
+
+// encodeMessage encodes the message as a struct with all the fields of its
+// descriptor, in declaration order. Unset fields are encoded with their zero
+// value.
+func (e *schemaEncoder) encodeMessage(msg protoreflect.Message, depth int) (encodedValue, error) {
+	if depth > maxSchemaDepth {
+		return encodedValue{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "message exceeds the maximum nesting depth of %d", maxSchemaDepth)
+	}
+
+	desc := msg.Descriptor()
+	switch desc.FullName() {
+	case anyFullName:
+		fields := desc.Fields()
+		return e.encodeAny(
+			msg.Get(fields.ByName("type_url")).String(),
+			msg.Get(fields.ByName("value")).Bytes(),
+			depth,
+		)
+	case timestampFullName:
+		fields := desc.Fields()
+		t := time.Unix(msg.Get(fields.ByName("seconds")).Int(), msg.Get(fields.ByName("nanos")).Int()).UTC()
+		return encodedValue{typ: ethString, value: t.Format(time.RFC3339Nano)}, nil
+	case durationFullName:
+		fields := desc.Fields()
+		d := time.Duration(msg.Get(fields.ByName("seconds")).Int())*time.Second + time.Duration(msg.Get(fields.ByName("nanos")).Int())
+		return encodedValue{typ: ethString, value: d.String()}, nil
+	}
+
+	typeName := typeNameForMessage(desc.FullName())
+	fields := desc.Fields()
+	types := make([]apitypes.Type, 0, fields.Len())
+	value := make(map[string]interface{}, fields.Len())
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := string(fd.Name())
+
+		var (
+			encoded encodedValue
+			err     error
+		)
+		switch {
+		case fd.IsMap():
+			encoded, err = e.encodeMap(typeName, fd, msg.Get(fd).Map(), depth)
+		case fd.IsList():
+			encoded, err = e.encodeRepeated(typeName, fd, msg.Get(fd).List(), depth)
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			encoded, err = e.encodeMessage(msg.Get(fd).Message(), depth+1)
+		default:
+			encoded = encodeScalar(fd, msg.Get(fd))
+		}
+		if err != nil {
+			return encodedValue{}, err
+		}
+
+		types = append(types, apitypes.Type{Name: name, Type: encoded.typ})
+		value[name] = encoded.value
+	}
+
+	typeDef, err := addTypesToRoot(e.types, typeName, types)
+	if err != nil {
+		return encodedValue{}, err
+	}
+
+	return encodedValue{typ: typeDef, value: value}, nil
+}
+
+// encodeRepeated encodes a repeated field as an array, or as a tuple when its
+// elements have different EIP-712 types.
+func (e *schemaEncoder) encodeRepeated(parentType string, fd protoreflect.FieldDescriptor, list protoreflect.List, depth int) (encodedValue, error) {
+	if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+		values := make([]interface{}, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			values[i] = encodeScalar(fd, list.Get(i)).value
+		}
+		return encodedValue{typ: scalarType(fd) + "[]", value: values}, nil
+	}
+
+	msgs := make([]protoreflect.Message, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		msgs[i] = list.Get(i).Message()
+	}
+	return e.encodeMessages(parentType, fd, msgs, depth)
+}
+
+// encodeMap encodes a map field as an array of its entries, sorted by key.
+func (e *schemaEncoder) encodeMap(parentType string, fd protoreflect.FieldDescriptor, m protoreflect.Map, depth int) (encodedValue, error) {
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, key)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	entries := make([]protoreflect.Message, len(keys))
+	for i, key := range keys {
+		entry := dynamicpb.NewMessage(fd.Message())
+		entry.Set(fd.MapKey(), key.Value())
+		entry.Set(fd.MapValue(), m.Get(key))
+		entries[i] = entry
+	}
+	return e.encodeMessages(parentType, fd, entries, depth)
+}
+
+// encodeMessages encodes the messages of a repeated or map field.
+func (e *schemaEncoder) encodeMessages(parentType string, fd protoreflect.FieldDescriptor, msgs []protoreflect.Message, depth int) (encodedValue, error) {
+	elems := make([]encodedValue, len(msgs))
+	for i, msg := range msgs {
+		encoded, err := e.encodeMessage(msg, depth+1)
+		if err != nil {
+			return encodedValue{}, err
+		}
+		elems[i] = encoded
+	}
+
+	// the element type of an empty list is the one of an empty message
+	var emptyType string
+	if len(elems) == 0 {
+		encoded, err := e.encodeMessage(dynamicpb.NewMessage(fd.Message()), depth+1)
+		if err != nil {
+			return encodedValue{}, err
+		}
+		emptyType = encoded.typ
+	}
+
+	typ, value, err := e.encodeList(parentType, string(fd.Name()), elems, emptyType)
+	if err != nil {
+		return encodedValue{}, err
+	}
+	return encodedValue{typ: typ, value: value}, nil
+}
+
+// encodeList encodes the elements as an array when they share the same type,
+// and as a tuple struct with one field per element otherwise, since EIP-712
+// arrays are homogeneous. The type of an empty list is emptyType[].
+func (e *schemaEncoder) encodeList(parentType, fieldName string, elems []encodedValue, emptyType string) (string, interface{}, error) {
+	homogeneous := true
+	for _, elem := range elems[min(1, len(elems)):] {
+		if elem.typ != elems[0].typ {
+			homogeneous = false
+			break
+		}
+	}
+
+	if homogeneous {
+		elemType := emptyType
+		if len(elems) > 0 {
+			elemType = elems[0].typ
+		}
+		values := make([]interface{}, len(elems))
+		for i, elem := range elems {
+			values[i] = elem.value
+		}
+		return elemType + "[]", values, nil
+	}
+
+	types := make([]apitypes.Type, len(elems))
+	values := make(map[string]interface{}, len(elems))
+	for i, elem := range elems {
+		field := fmt.Sprintf("%s%d", tupleItemField, i)
+		types[i] = apitypes.Type{Name: field, Type: elem.typ}
+		values[field] = elem.value
+	}
+
+	typeDef, err := addTypesToRoot(e.types, parentType+sanitizeTypedef(fieldName), types)
+	if err != nil {
+		return "", nil, err
+	}
+	return typeDef, values, nil
+}
+
+// encodeScalar encodes a non-message value with the EIP-712 type of the field.
+func encodeScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) encodedValue {
+	typ := scalarType(fd)
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return encodedValue{typ: typ, value: v.Bool()}
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByNumber(v.Enum()); enumValue != nil {
+			return encodedValue{typ: typ, value: string(enumValue.Name())}
+		}
+		return encodedValue{typ: typ, value: strconv.FormatInt(int64(v.Enum()), 10)}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return encodedValue{typ: typ, value: strconv.FormatInt(v.Int(), 10)}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return encodedValue{typ: typ, value: strconv.FormatUint(v.Uint(), 10)}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return encodedValue{typ: typ, value: strconv.FormatFloat(v.Float(), 'g', -1, 64)}
+	case protoreflect.BytesKind:
+		return encodedValue{typ: typ, value: hexutil.Bytes(v.Bytes())}
+	default:
+		return encodedValue{typ: typ, value: v.String()}
+	}
+}
+
+// scalarType returns the EIP-712 type of a non-message field. Enums and
+// floating point numbers are encoded as strings.
+func scalarType(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return ethBool
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return ethInt32
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return ethInt64
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return ethUint32
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return ethUint64
+	case protoreflect.BytesKind:
+		return ethBytes
+	default:
+		return ethString
+	}
+}
+
+// cosmos.bank.v1beta1.MsgSend -> CosmosBankV1beta1MsgSend
+//
+// typeNameForMessage returns the base EIP-712 type name of a protobuf message.
+func typeNameForMessage(fullName protoreflect.FullName) string {
+	caser := cases.Title(language.English, cases.NoLower)
+
+	var b strings.Builder
+	for _, part := range strings.Split(string(fullName), ".") {
+		for _, subpart := range strings.Split(part, "_") {
+			b.WriteString(caser.String(subpart))
+		}
+	}
+	return b.String()
+}
@@ -114,7 +114,10 @@ func decodeAminoSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 
 // decodeProtobufSignDoc attempts to decode the provided sign doc (bytes) as a Protobuf payload
 // and returns a signable EIP-712 TypedData object.
-func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
+func decodeProtobufSignDoc(signDocBytes []byte) (typedData apitypes.TypedData, err error) {
+	// StdSignBytes panics on the messages which cannot be Amino JSON encoded
+	defer doRecover(&err)
+
 	// Ensure codecs have been initialized
 	if err := validateCodecInit(); err != nil {
 		return apitypes.TypedData{}, err
@@ -176,7 +179,7 @@ func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 		body.Memo,
 	)
 
-	typedData, err := WrapTxToTypedData(
+	typedData, err = WrapTxToTypedData(
 		eip155ChainID,
 		signBytes,
 	)
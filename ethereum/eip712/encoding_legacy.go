@@ -111,7 +111,10 @@ func legacyDecodeAminoSignDoc(signDocBytes []byte, eip155ChainID uint64) (apityp
 
 // legacyDecodeProtobufSignDoc attempts to decode the provided sign doc (bytes) as a Protobuf payload
 // and returns a signable EIP-712 TypedData object.
-func legacyDecodeProtobufSignDoc(signDocBytes []byte, eip155ChainID uint64) (apitypes.TypedData, error) {
+func legacyDecodeProtobufSignDoc(signDocBytes []byte, eip155ChainID uint64) (typedData apitypes.TypedData, err error) {
+	// StdSignBytes panics on the messages which cannot be Amino JSON encoded
+	defer doRecover(&err)
+
 	// Ensure codecs have been initialized
 	if err := validateCodecInit(); err != nil {
 		return apitypes.TypedData{}, err
@@ -185,7 +188,7 @@ func legacyDecodeProtobufSignDoc(signDocBytes []byte, eip155ChainID uint64) (api
 		body.Memo,
 	)
 
-	typedData, err := LegacyWrapTxToTypedData(
+	typedData, err = LegacyWrapTxToTypedData(
 		protoCodec,
 		eip155ChainID,
 		msg,
@@ -0,0 +1,199 @@
+package eip712
+
+import (
+	"errors"
+	"fmt"
+
+	apitypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txTypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// SchemaGetEIP712BytesForMsg returns the EIP-712 object bytes for the given SignDoc bytes by decoding the bytes into
+// an EIP-712 object whose types are derived from the protobuf descriptors of the messages. See
+// https://eips.ethereum.org/EIPS/eip-712 for more.
+func SchemaGetEIP712BytesForMsg(signDocBytes []byte) ([]byte, error) {
+	typedData, err := SchemaGetEIP712TypedDataForMsg(signDocBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	_, rawData, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("could not get EIP-712 object bytes: %w", err)
+	}
+
+	return []byte(rawData), nil
+}
+
+// SelectEIP712TypedDataForMsg returns the EIP-712 TypedData to sign for the given SignDoc bytes,
+// with the encoding selected from the payload as done on signature verification: the typed data
+// of GetEIP712TypedDataForMsg if it can represent the payload, the schema-driven typed data of
+// SchemaGetEIP712TypedDataForMsg otherwise.
+func SelectEIP712TypedDataForMsg(signDocBytes []byte) (apitypes.TypedData, error) {
+	typedData, err := GetEIP712TypedDataForMsg(signDocBytes)
+	if err == nil {
+		if _, _, err := apitypes.TypedDataAndHash(typedData); err == nil {
+			return typedData, nil
+		}
+	}
+
+	return SchemaGetEIP712TypedDataForMsg(signDocBytes)
+}
+
+// SchemaGetEIP712TypedDataForMsg returns the schema-driven EIP-712 TypedData representation for either
+// Amino or Protobuf encoded signature doc bytes. Unlike GetEIP712TypedDataForMsg, the types are derived
+// from the protobuf descriptors registered in the interface registry, so that any registered message
+// can be represented, including the messages nesting other messages in Anys.
+func SchemaGetEIP712TypedDataForMsg(signDocBytes []byte) (apitypes.TypedData, error) {
+	// Attempt to decode as both Amino and Protobuf since the message format is unknown.
+	// If either decode works, we can move forward with the corresponding typed data.
+	typedDataAmino, errAmino := schemaDecodeAminoSignDoc(signDocBytes)
+	if errAmino == nil && isValidEIP712Payload(typedDataAmino) {
+		return typedDataAmino, nil
+	}
+	typedDataProtobuf, errProtobuf := schemaDecodeProtobufSignDoc(signDocBytes)
+	if errProtobuf == nil && isValidEIP712Payload(typedDataProtobuf) {
+		return typedDataProtobuf, nil
+	}
+
+	return apitypes.TypedData{}, fmt.Errorf("could not decode sign doc as either Amino or Protobuf.\n amino: %v\n protobuf: %v", errAmino, errProtobuf)
+}
+
+// schemaDecodeAminoSignDoc attempts to decode the provided sign doc (bytes) as an Amino payload
+// and returns a signable schema-driven EIP-712 TypedData object.
+func schemaDecodeAminoSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
+	// Ensure codecs have been initialized
+	if err := validateCodecInit(); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	var aminoDoc legacytx.StdSignDoc
+	if err := aminoCodec.UnmarshalJSON(signDocBytes, &aminoDoc); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	var fees legacytx.StdFee
+	if err := aminoCodec.UnmarshalJSON(aminoDoc.Fee, &fees); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	// Validate payload messages
+	msgs := make([]sdk.Msg, len(aminoDoc.Msgs))
+	anys := make([]*codectypes.Any, len(aminoDoc.Msgs))
+	for i, jsonMsg := range aminoDoc.Msgs {
+		var m sdk.Msg
+		if err := aminoCodec.UnmarshalJSON(jsonMsg, &m); err != nil {
+			return apitypes.TypedData{}, fmt.Errorf("failed to unmarshal sign doc message: %w", err)
+		}
+		msgs[i] = m
+
+		anyMsg, err := codectypes.NewAnyWithValue(m)
+		if err != nil {
+			return apitypes.TypedData{}, fmt.Errorf("failed to pack sign doc message: %w", err)
+		}
+		anys[i] = anyMsg
+	}
+
+	if err := validatePayloadMessages(msgs); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	typedData, err := schemaWrapTxToTypedData(
+		protoCodec.InterfaceRegistry(),
+		eip155ChainID,
+		schemaTx{
+			chainID:       aminoDoc.ChainID,
+			accountNumber: aminoDoc.AccountNumber,
+			sequence:      aminoDoc.Sequence,
+			timeoutHeight: aminoDoc.TimeoutHeight,
+			fee:           fees.Amount,
+			gas:           fees.Gas,
+			payer:         fees.Payer,
+			granter:       fees.Granter,
+			memo:          aminoDoc.Memo,
+			msgs:          anys,
+		},
+	)
+	if err != nil {
+		return apitypes.TypedData{}, fmt.Errorf("could not convert to EIP712 representation: %w", err)
+	}
+
+	return typedData, nil
+}
+
+// schemaDecodeProtobufSignDoc attempts to decode the provided sign doc (bytes) as a Protobuf payload
+// and returns a signable schema-driven EIP-712 TypedData object.
+func schemaDecodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
+	// Ensure codecs have been initialized
+	if err := validateCodecInit(); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	signDoc := &txTypes.SignDoc{}
+	if err := signDoc.Unmarshal(signDocBytes); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	authInfo := &txTypes.AuthInfo{}
+	if err := authInfo.Unmarshal(signDoc.AuthInfoBytes); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	body := &txTypes.TxBody{}
+	if err := body.Unmarshal(signDoc.BodyBytes); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	// Until support for these fields is added, throw an error at their presence
+	if body.Unordered || body.TimeoutTimestamp != nil || len(body.ExtensionOptions) != 0 || len(body.NonCriticalExtensionOptions) != 0 {
+		return apitypes.TypedData{}, errors.New("body contains unsupported fields: Unordered, TimeoutTimestamp, ExtensionOptions, or NonCriticalExtensionOptions")
+	}
+
+	if len(authInfo.SignerInfos) != 1 {
+		return apitypes.TypedData{}, fmt.Errorf("invalid number of signer infos provided, expected 1 got %v", len(authInfo.SignerInfos))
+	}
+
+	if authInfo.Fee == nil {
+		return apitypes.TypedData{}, errors.New("missing fee in auth info")
+	}
+
+	// Validate payload messages
+	msgs := make([]sdk.Msg, len(body.Messages))
+	for i, protoMsg := range body.Messages {
+		var m sdk.Msg
+		if err := protoCodec.UnpackAny(protoMsg, &m); err != nil {
+			return apitypes.TypedData{}, fmt.Errorf("could not unpack message object with error %w", err)
+		}
+		msgs[i] = m
+	}
+
+	if err := validatePayloadMessages(msgs); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	typedData, err := schemaWrapTxToTypedData(
+		protoCodec.InterfaceRegistry(),
+		eip155ChainID,
+		schemaTx{
+			chainID:       signDoc.ChainId,
+			accountNumber: signDoc.AccountNumber,
+			sequence:      authInfo.SignerInfos[0].Sequence,
+			timeoutHeight: body.TimeoutHeight,
+			fee:           authInfo.Fee.Amount,
+			gas:           authInfo.Fee.GasLimit,
+			payer:         authInfo.Fee.Payer,
+			granter:       authInfo.Fee.Granter,
+			memo:          body.Memo,
+			msgs:          body.Messages,
+		},
+	)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	return typedData, nil
+}
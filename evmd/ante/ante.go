@@ -29,6 +29,9 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 				case "/cosmos.evm.types.v1.ExtensionOptionDynamicFeeTx":
 					// cosmos-sdk tx with dynamic fee extension
 					anteHandler = newCosmosAnteHandler(options)
+				case "/cosmos.evm.types.v1.ExtensionOptionContractSignatureTx":
+					// cosmos-sdk tx signed by smart contract accounts
+					anteHandler = newCosmosContractSignatureAnteHandler(options)
 				default:
 					return ctx, errorsmod.Wrapf(
 						errortypes.ErrUnknownExtensionOptions,
@@ -8,14 +8,20 @@ import (
 
 // newMonoEVMAnteHandler creates the sdk.AnteHandler implementation for the EVM transactions.
 func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	decorators := []sdk.AnteDecorator{
-		evmante.NewEVMMonoDecorator(
-			options.AccountKeeper,
-			options.FeeMarketKeeper,
-			options.EvmKeeper,
-			options.MaxTxGasWanted,
-		),
+	monoDecorator := evmante.NewEVMMonoDecorator(
+		options.AccountKeeper,
+		options.FeeMarketKeeper,
+		options.EvmKeeper,
+		options.MaxTxGasWanted,
+	)
+	if options.Mempool != nil {
+		monoDecorator = monoDecorator.WithMempool(options.Mempool)
 	}
+	if options.FeegrantKeeper != nil {
+		monoDecorator = monoDecorator.WithFeegrantKeeper(options.FeegrantKeeper)
+	}
+
+	decorators := []sdk.AnteDecorator{monoDecorator}
 	if options.PendingTxListener != nil {
 		decorators = append(decorators, NewTxListenerDecorator(options.PendingTxListener))
 	}
@@ -31,6 +31,10 @@ type HandlerOptions struct {
 	MaxTxGasWanted         uint64
 	TxFeeChecker           ante.TxFeeChecker
 	PendingTxListener      PendingTxListener
+	// Mempool is the optional nonce aware application mempool. If set, the EVM
+	// transactions with a nonce gap or replacing a pending transaction are
+	// accepted on CheckTx so that the mempool can hold them.
+	Mempool anteinterfaces.EVMMempool
 }
 
 // Validate checks if the keepers are defined
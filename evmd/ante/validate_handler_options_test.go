@@ -132,7 +132,7 @@ func RunValidateHandlerOptionsTest(t *testing.T, create network.CreateEvmApp, op
 				SignModeHandler:        nw.GetEncodingConfig().TxConfig.SignModeHandler(),
 				SigGasConsumer:         ante.SigVerificationGasConsumer,
 				MaxTxGasWanted:         40000000,
-				TxFeeChecker:           ethante.NewDynamicFeeChecker(nw.App.GetFeeMarketKeeper()),
+				TxFeeChecker:           ethante.NewDynamicFeeChecker(nw.App.GetEVMKeeper(), nw.App.GetFeeMarketKeeper()),
 			},
 			true,
 		},
@@ -2,6 +2,7 @@ package evmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -21,6 +22,8 @@ import (
 	evmconfig "github.com/cosmos/evm/config"
 	evmosencoding "github.com/cosmos/evm/encoding"
 	"github.com/cosmos/evm/evmd/ante"
+	evmmempool "github.com/cosmos/evm/mempool"
+	cosmosevmserver "github.com/cosmos/evm/server"
 	srvflags "github.com/cosmos/evm/server/flags"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20"
@@ -44,6 +47,14 @@ import (
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icahost "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host"
+	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
 	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -88,6 +99,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -184,9 +196,11 @@ type EVMD struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// IBC keepers
-	IBCKeeper      *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	TransferKeeper transferkeeper.Keeper
-	CallbackKeeper ibccallbackskeeper.ContractKeeper
+	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	TransferKeeper      transferkeeper.Keeper
+	CallbackKeeper      ibccallbackskeeper.ContractKeeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	ICAHostKeeper       icahostkeeper.Keeper
 
 	// Cosmos EVM keepers
 	FeeMarketKeeper   feemarketkeeper.Keeper
@@ -203,6 +217,9 @@ type EVMD struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// node-local db of the SHA3 preimages recorded by the EVM, nil if disabled
+	preimageDB dbm.DB
 }
 
 // NewExampleApp returns a reference to an initialized EVMD.
@@ -273,7 +290,7 @@ func NewExampleApp(
 		govtypes.StoreKey, paramstypes.StoreKey, consensusparamtypes.StoreKey,
 		upgradetypes.StoreKey, feegrant.StoreKey, evidencetypes.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
-		ibcexported.StoreKey, ibctransfertypes.StoreKey,
+		ibcexported.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey,
 		// Cosmos EVM store keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey, precisebanktypes.StoreKey,
 	)
@@ -386,7 +403,7 @@ func NewExampleApp(
 		authAddr,
 	)
 
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper).SetBankKeeper(app.BankKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -541,13 +558,59 @@ func NewExampleApp(
 	)
 	transferStack = ibccallbacks.NewIBCMiddleware(transferStack, app.IBCKeeper.ChannelKeeper, app.CallbackKeeper, maxCallbackGas)
 
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icacontrollertypes.StoreKey]),
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.MsgServiceRouter(),
+		authAddr,
+	)
+
+	app.ICAHostKeeper = icahostkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icahosttypes.StoreKey]),
+		app.GetSubspace(icahosttypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.AccountKeeper,
+		app.MsgServiceRouter(),
+		app.GRPCQueryRouter(),
+		authAddr,
+	)
+
+	/*
+		Create Interchain Accounts Controller Stack
+
+		controller stack contains (from bottom to top):
+			- IBC Callbacks Middleware (with EVM ContractKeeper)
+			- ICA Controller
+
+		SendPacket, since it is originating from the ICS-27 precompile to core IBC:
+			icaControllerKeeper.SendTx -> callbacks.SendPacket -> channel.SendPacket
+
+		The callbacks middleware routes the acknowledgements and timeouts of the packets
+		to the ICallbacks entrypoints of the contract set in the packet memo.
+	*/
+	var icaControllerStack porttypes.IBCModule
+
+	icaControllerStack = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
+	icaControllerStack = ibccallbacks.NewIBCMiddleware(icaControllerStack, app.IBCKeeper.ChannelKeeper, app.CallbackKeeper, maxCallbackGas)
+	// NOTE: the ICS4Wrapper must be set before the controller keeper is passed to the ICS-27 precompile
+	app.ICAControllerKeeper.WithICS4Wrapper(icaControllerStack.(porttypes.ICS4Wrapper))
+
+	icaHostStack := icahost.NewIBCModule(app.ICAHostKeeper)
+
 	var transferStackV2 ibcapi.IBCModule
 	transferStackV2 = transferv2.NewIBCModule(app.TransferKeeper)
 	transferStackV2 = erc20v2.NewIBCMiddleware(transferStackV2, app.Erc20Keeper)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
+		AddRoute(icahosttypes.SubModuleName, icaHostStack)
 	ibcRouterV2 := ibcapi.NewRouter()
 	ibcRouterV2.AddRoute(ibctransfertypes.ModuleName, transferStackV2)
 
@@ -575,10 +638,26 @@ func NewExampleApp(
 			app.EVMKeeper,
 			app.GovKeeper,
 			app.SlashingKeeper,
+			app.AuthzKeeper,
+			app.FeeGrantKeeper,
+			app.ICAControllerKeeper,
 			app.AppCodec(),
 		),
 	)
 
+	// record the SHA3 preimages seen by the EVM in a node-local db if enabled
+	if cast.ToBool(appOpts.Get(srvflags.EVMEnablePreimageRecording)) {
+		preimageDB, err := cosmosevmserver.OpenPreimageDB(homePath, server.GetAppDBBackend(appOpts))
+		if err != nil {
+			panic(fmt.Errorf("failed to open the preimage db: %w", err))
+		}
+		app.preimageDB = preimageDB
+		app.EVMKeeper.WithPreimageDB(preimageDB)
+	}
+
+	// serve the storage proofs of eth_getProof only if enabled, as they are costly
+	app.EVMKeeper.WithMPTProofs(cast.ToBool(appOpts.Get(srvflags.JSONRPCEnableMPTProofs)))
+
 	/****  Module Options ****/
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -605,6 +684,7 @@ func NewExampleApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctm.NewAppModule(tmLightClientModule),
 		transferModule,
+		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		// Cosmos EVM modules
 		vm.NewAppModule(app.EVMKeeper, app.AccountKeeper, app.AccountKeeper.AddressCodec()),
 		feemarket.NewAppModule(app.FeeMarketKeeper),
@@ -648,7 +728,7 @@ func NewExampleApp(
 		minttypes.ModuleName,
 
 		// IBC modules
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName,
 
 		// Cosmos EVM BeginBlockers
 		erc20types.ModuleName, feemarkettypes.ModuleName,
@@ -674,7 +754,7 @@ func NewExampleApp(
 		evmtypes.ModuleName, erc20types.ModuleName, feemarkettypes.ModuleName,
 
 		// no-ops
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName, minttypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
@@ -701,7 +781,7 @@ func NewExampleApp(
 		erc20types.ModuleName,
 		precisebanktypes.ModuleName,
 
-		ibctransfertypes.ModuleName,
+		ibctransfertypes.ModuleName, icatypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
 	}
@@ -754,6 +834,7 @@ func NewExampleApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.setMempool(appOpts)
 	app.setAnteHandler(app.txConfig, maxGasWanted)
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
@@ -812,9 +893,15 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         evmante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
-		TxFeeChecker:           cosmosevmante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+		TxFeeChecker:           cosmosevmante.NewDynamicFeeChecker(app.EVMKeeper, app.FeeMarketKeeper),
 		PendingTxListener:      app.onPendingTx,
 	}
+	if evmMempool, ok := app.Mempool().(*evmmempool.EVMMempool); ok {
+		// the mempool notifies the pending transactions, skipping the
+		// queued ones until they are promoted
+		options.Mempool = evmMempool
+		options.PendingTxListener = nil
+	}
 	if err := options.Validate(); err != nil {
 		panic(err)
 	}
@@ -822,6 +909,29 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 	app.SetAnteHandler(ante.NewAnteHandler(options))
 }
 
+// setMempool sets the nonce aware EVM mempool and the ABCI proposal handlers
+// building the blocks from it. The application mempool is disabled if the
+// mempool max txs setting is negative, and unbounded if it is zero.
+func (app *EVMD) setMempool(appOpts servertypes.AppOptions) {
+	maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs))
+	if maxTxs < 0 {
+		return
+	}
+
+	mpool := evmmempool.NewEVMMempool(app.EVMKeeper, evmmempool.Config{
+		PriceBump:         cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)),
+		AccountQueue:      cast.ToInt(appOpts.Get(srvflags.EVMMempoolAccountQueue)),
+		MaxTx:             maxTxs,
+		PendingTxListener: app.onPendingTx,
+	})
+	app.SetMempool(mpool)
+
+	handler := baseapp.NewDefaultProposalHandler(mpool, app)
+	handler.SetSignerExtractionAdapter(NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter()))
+	app.SetPrepareProposal(handler.PrepareProposalHandler())
+	app.SetProcessProposal(handler.ProcessProposalHandler())
+}
+
 func (app *EVMD) onPendingTx(hash common.Hash) {
 	for _, listener := range app.pendingTxListeners {
 		listener(hash)
@@ -888,6 +998,15 @@ func (app *EVMD) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
 }
 
+// Close closes the BaseApp and the preimage db of the EVM, if any.
+func (app *EVMD) Close() error {
+	err := app.BaseApp.Close()
+	if app.preimageDB != nil {
+		err = errors.Join(err, app.preimageDB.Close())
+	}
+	return err
+}
+
 // LegacyAmino returns EVMD's amino codec.
 //
 // NOTE: This is solely to be used for testing purposes as it may be desirable
@@ -929,6 +1048,10 @@ func (app *EVMD) DefaultGenesis() map[string]json.RawMessage {
 	erc20GenState := NewErc20GenesisState()
 	genesis[erc20types.ModuleName] = app.appCodec.MustMarshalJSON(erc20GenState)
 
+	// NOTE: the interchain accounts host only allows an explicit list of messages
+	icaGenState := NewICAGenesisState()
+	genesis[icatypes.ModuleName] = app.appCodec.MustMarshalJSON(icaGenState)
+
 	return genesis
 }
 
@@ -1094,6 +1217,10 @@ func (app *EVMD) GetCallbackKeeper() ibccallbackskeeper.ContractKeeper {
 	return app.CallbackKeeper
 }
 
+func (app *EVMD) GetICAControllerKeeper() icacontrollerkeeper.Keeper {
+	return app.ICAControllerKeeper
+}
+
 func (app *EVMD) GetTransferKeeper() transferkeeper.Keeper {
 	return app.TransferKeeper
 }
@@ -1149,6 +1276,8 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	keyTable.RegisterParamSet(&ibcconnectiontypes.Params{})
 	paramsKeeper.Subspace(ibcexported.ModuleName).WithKeyTable(keyTable)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName).WithKeyTable(ibctransfertypes.ParamKeyTable())
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName).WithKeyTable(icacontrollertypes.ParamKeyTable())
+	paramsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
 	// TODO: do we need a keytable? copied from Evmos repo
 
 	return paramsKeeper
@@ -6,6 +6,7 @@ import (
 	"os"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -20,6 +21,7 @@ import (
 	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
 	cosmosevmserver "github.com/cosmos/evm/server"
 	srvflags "github.com/cosmos/evm/server/flags"
+	evmcli "github.com/cosmos/evm/x/vm/client/cli"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
@@ -35,12 +37,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/snapshot"
-	"github.com/cosmos/cosmos-sdk/server"
 	sdkserver "github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/mempool"
 	sdktestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
@@ -175,9 +175,12 @@ func initRootCmd(rootCmd *cobra.Command, evmApp *evmd.EVMD) {
 	cfg.Seal()
 
 	defaultNodeHome := evmdconfig.MustGetDefaultNodeHome()
+	genesisCmd := genutilcli.Commands(evmApp.TxConfig(), evmApp.BasicModuleManager, defaultNodeHome)
+	genesisCmd.AddCommand(evmcli.NewImportAllocCmd(defaultNodeHome))
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(evmApp.BasicModuleManager, defaultNodeHome),
-		genutilcli.Commands(evmApp.TxConfig(), evmApp.BasicModuleManager, defaultNodeHome),
+		genesisCmd,
 		cmtcli.NewCompletionCmd(rootCmd, true),
 		debug.Cmd(),
 		confixcmd.ConfigCommand(),
@@ -194,6 +197,12 @@ func initRootCmd(rootCmd *cobra.Command, evmApp *evmd.EVMD) {
 		addModuleInitFlags,
 	)
 
+	// add the EVM state export to a geth genesis allocation
+	rootCmd.AddCommand(
+		cosmosevmserver.NewExportAllocCmd(appExportAlloc, defaultNodeHome),
+		cosmosevmserver.NewDumpStorageCmd(appDumpStorage, defaultNodeHome),
+	)
+
 	// add Cosmos EVM key commands
 	rootCmd.AddCommand(
 		cosmosevmcmd.KeyCommands(defaultNodeHome, true),
@@ -315,25 +324,6 @@ func newApp(
 		baseapp.SetChainID(chainID),
 	}
 
-	// Set up the required mempool and ABCI proposal handlers for Cosmos EVM
-	baseappOptions = append(baseappOptions, func(app *baseapp.BaseApp) {
-		var mpool mempool.Mempool
-		if maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs)); maxTxs >= 0 {
-			// Setup Mempool and Proposal Handlers
-			mpool = mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
-				TxPriority:      mempool.NewDefaultTxPriority(),
-				SignerExtractor: evmd.NewEthSignerExtractionAdapter(mempool.NewDefaultSignerExtractionAdapter()),
-				MaxTx:           maxTxs,
-			})
-		} else {
-			mpool = mempool.NoOpMempool{}
-		}
-		app.SetMempool(mpool)
-		handler := baseapp.NewDefaultProposalHandler(mpool, app)
-		app.SetPrepareProposal(handler.PrepareProposalHandler())
-		app.SetProcessProposal(handler.ProcessProposalHandler())
-	})
-
 	return evmd.NewExampleApp(
 		logger, db, traceStore, true,
 		appOpts,
@@ -391,6 +381,57 @@ func appExport(
 	return exampleApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
 }
 
+// appExportAlloc creates a new application (optionally at a given height) and
+// exports its EVM state as a geth genesis allocation.
+func appExportAlloc(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	appOpts servertypes.AppOptions,
+	w io.Writer,
+) error {
+	chainID, err := getChainIDFromOpts(appOpts)
+	if err != nil {
+		return err
+	}
+
+	exampleApp := evmd.NewExampleApp(logger, db, traceStore, height == -1, appOpts, evmdconfig.EVMChainID, evmdconfig.EvmAppOptions, baseapp.SetChainID(chainID))
+	if height != -1 {
+		if err := exampleApp.LoadHeight(height); err != nil {
+			return err
+		}
+	}
+
+	return exampleApp.ExportEVMAlloc(w)
+}
+
+// appDumpStorage creates a new application (optionally at a given height) and
+// dumps the storage of an EVM account.
+func appDumpStorage(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	appOpts servertypes.AppOptions,
+	address common.Address,
+	w io.Writer,
+) error {
+	chainID, err := getChainIDFromOpts(appOpts)
+	if err != nil {
+		return err
+	}
+
+	exampleApp := evmd.NewExampleApp(logger, db, traceStore, height == -1, appOpts, evmdconfig.EVMChainID, evmdconfig.EvmAppOptions, baseapp.SetChainID(chainID))
+	if height != -1 {
+		if err := exampleApp.LoadHeight(height); err != nil {
+			return err
+		}
+	}
+
+	return exampleApp.DumpEVMStorage(address, w)
+}
+
 // getChainIDFromOpts returns the chain Id from app Opts
 // It first tries to get from the chainId flag, if not available
 // it will load from home
@@ -3,8 +3,11 @@ package evmd
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	storetypes "cosmossdk.io/store/types"
@@ -51,6 +54,20 @@ func (app *EVMD) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddr
 	}, err
 }
 
+// ExportEVMAlloc writes the EVM state of the application at its last height
+// to w as a geth genesis allocation.
+func (app *EVMD) ExportEVMAlloc(w io.Writer) error {
+	ctx := app.NewContextLegacy(true, tmproto.Header{Height: app.LastBlockHeight()})
+	return app.EVMKeeper.ExportAlloc(ctx, w)
+}
+
+// DumpEVMStorage writes the storage of the EVM account at the last height of
+// the application to w, resolving its slots with the recorded SHA3 preimages.
+func (app *EVMD) DumpEVMStorage(address common.Address, w io.Writer) error {
+	ctx := app.NewContextLegacy(true, tmproto.Header{Height: app.LastBlockHeight()})
+	return app.EVMKeeper.DumpStorage(ctx, address, w)
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //
@@ -8,8 +8,16 @@ import (
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	icagenesistypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/genesis/types"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // GenesisState of the blockchain is represented here as a map of raw json
@@ -64,3 +72,28 @@ func NewFeeMarketGenesisState() *feemarkettypes.GenesisState {
 
 	return feeMarketGenState
 }
+
+// NewICAGenesisState returns the default genesis state for the interchain accounts module.
+//
+// NOTE: for the example chain implementation the host only executes an explicit
+// allow-list of messages. Ethereum txs, ERC20 conversions and authz executions
+// are left out, as they are not meant to be signed by an interchain account.
+func NewICAGenesisState() *icagenesistypes.GenesisState {
+	icaGenState := icagenesistypes.DefaultGenesis()
+	icaGenState.HostGenesisState.Params = icahosttypes.NewParams(true, []string{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+		sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+		sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}),
+		sdk.MsgTypeURL(&govv1.MsgVote{}),
+		sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+		sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+		sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}),
+	})
+
+	return icaGenState
+}
@@ -9,22 +9,28 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	authzprecompile "github.com/cosmos/evm/precompiles/authz"
 	bankprecompile "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bech32"
 	cmn "github.com/cosmos/evm/precompiles/common"
 	distprecompile "github.com/cosmos/evm/precompiles/distribution"
+	feegrantprecompile "github.com/cosmos/evm/precompiles/feegrant"
 	govprecompile "github.com/cosmos/evm/precompiles/gov"
 	ics20precompile "github.com/cosmos/evm/precompiles/ics20"
+	ics27precompile "github.com/cosmos/evm/precompiles/ics27"
 	"github.com/cosmos/evm/precompiles/p256"
 	slashingprecompile "github.com/cosmos/evm/precompiles/slashing"
 	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
 
 	"cosmossdk.io/core/address"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -35,7 +41,7 @@ import (
 // Extend this struct, add a sane default to defaultOptionals, and an Option function to provide users with a non-breaking
 // way to provide custom args to certain precompiles.
 type Optionals struct {
-	AddressCodec       address.Codec // used by gov/staking
+	AddressCodec       address.Codec // used by gov/staking/authz/feegrant
 	ValidatorAddrCodec address.Codec // used by slashing
 	ConsensusAddrCodec address.Codec // used by slashing
 }
@@ -83,6 +89,9 @@ func NewAvailableStaticPrecompiles(
 	evmKeeper *evmkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
 	codec codec.Codec,
 	opts ...Option,
 ) map[common.Address]vm.PrecompiledContract {
@@ -142,6 +151,21 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate slashing precompile: %w", err))
 	}
 
+	authzPrecompile, err := authzprecompile.NewPrecompile(authzKeeper, codec, options.AddressCodec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(feegrantKeeper, codec, options.AddressCodec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
+	ics27Precompile, err := ics27precompile.NewPrecompile(icaControllerKeeper, options.AddressCodec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate ICS27 precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -153,6 +177,9 @@ func NewAvailableStaticPrecompiles(
 	precompiles[bankPrecompile.Address()] = bankPrecompile
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
+	precompiles[ics27Precompile.Address()] = ics27Precompile
 
 	return precompiles
 }
@@ -0,0 +1,336 @@
+package ibc
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/precompiles/ics27"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	callbackstestutil "github.com/cosmos/evm/x/ibc/callbacks/testutil"
+	evmante "github.com/cosmos/evm/x/vm/ante"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type ICS27PrecompileTestSuite struct {
+	suite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA           *evmibctesting.TestChain
+	chainAPrecompile *ics27.Precompile
+	chainB           *evmibctesting.TestChain
+}
+
+func (suite *ICS27PrecompileTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 2, 0, integration.SetupEvmd)
+	suite.chainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(2))
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	var err error
+	suite.chainAPrecompile, err = ics27.NewPrecompile(
+		evmAppA.ICAControllerKeeper,
+		evmAppA.AccountKeeper.AddressCodec(),
+	)
+	suite.Require().NoError(err)
+}
+
+// registerInterchainAccount registers an interchain account for the owner
+// through the ICS27 precompile and relays the channel handshake.
+func (suite *ICS27PrecompileTestSuite) registerInterchainAccount(ownerIdx int) *evmibctesting.Path {
+	owner := suite.chainA.SenderAccounts[ownerIdx]
+	ownerAddr := common.BytesToAddress(owner.SenderAccount.GetAddress().Bytes())
+
+	path := evmibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	portID, err := icatypes.NewControllerPortID(owner.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	data, err := suite.chainAPrecompile.Pack(
+		ics27.RegisterInterchainAccountMethod,
+		ownerAddr,
+		path.EndpointA.ConnectionID,
+		"",
+	)
+	suite.Require().NoError(err)
+
+	res, _, ethRes, err := suite.chainA.SendEvmTx(owner, ownerIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+
+	out, err := suite.chainAPrecompile.Unpack(ics27.RegisterInterchainAccountMethod, ethRes.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(portID, out[1])
+
+	channelID, err := evmibctesting.ParseChannelIDFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channelID, out[0])
+
+	path.EndpointA.ChannelID = channelID
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	return path
+}
+
+// queryInterchainAccount returns the interchain account address of the owner
+// through the ICS27 precompile.
+func (suite *ICS27PrecompileTestSuite) queryInterchainAccount(owner common.Address, connectionID string) string {
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	ctxA := evmante.BuildEvmExecutionCtx(suite.chainA.GetContext())
+	evmRes, err := evmAppA.EVMKeeper.CallEVM(
+		ctxA,
+		suite.chainAPrecompile.ABI,
+		owner,
+		suite.chainAPrecompile.Address(),
+		false,
+		nil,
+		ics27.InterchainAccountMethod,
+		owner,
+		connectionID,
+	)
+	suite.Require().NoError(err)
+
+	out, err := suite.chainAPrecompile.Unpack(ics27.InterchainAccountMethod, evmRes.Ret)
+	suite.Require().NoError(err)
+
+	return out[0].(string)
+}
+
+func (suite *ICS27PrecompileTestSuite) TestRegisterInterchainAccount() {
+	suite.SetupTest()
+
+	ownerIdx := 1
+	ownerAddr := common.BytesToAddress(suite.chainA.SenderAccounts[ownerIdx].SenderAccount.GetAddress().Bytes())
+
+	path := evmibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+	suite.Require().Empty(suite.queryInterchainAccount(ownerAddr, path.EndpointA.ConnectionID))
+
+	path = suite.registerInterchainAccount(ownerIdx)
+
+	icaAddr := suite.queryInterchainAccount(ownerAddr, path.EndpointA.ConnectionID)
+	suite.Require().NotEmpty(icaAddr)
+
+	evmAppB := suite.chainB.App.(*evmd.EVMD)
+	hostAddr, found := evmAppB.ICAHostKeeper.GetInterchainAccountAddress(
+		suite.chainB.GetContext(),
+		path.EndpointB.ConnectionID,
+		path.EndpointA.ChannelConfig.PortID,
+	)
+	suite.Require().True(found)
+	suite.Require().Equal(hostAddr, icaAddr)
+}
+
+func (suite *ICS27PrecompileTestSuite) TestSendTx() {
+	var (
+		path     *evmibctesting.Path
+		icaAddr  sdk.AccAddress
+		msgs     [][]byte
+		memo     string
+		timeout  uint64
+		sendAmt  sdk.Coins
+		receiver sdk.AccAddress
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func(contractAddr common.Address)
+		relay      func(packet channeltypes.Packet)
+		expErr     bool
+		expCounter int64
+		expBalance func() sdk.Coins
+	}{
+		{
+			"success - acknowledgement with callback",
+			func(contractAddr common.Address) {
+				memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "%d"}}`, contractAddr, 1_000_000)
+			},
+			func(packet channeltypes.Packet) {
+				suite.Require().NoError(path.RelayPacket(packet))
+			},
+			false,
+			1,
+			func() sdk.Coins { return sendAmt },
+		},
+		{
+			"success - acknowledgement without callback",
+			func(_ common.Address) {
+				memo = ""
+			},
+			func(packet channeltypes.Packet) {
+				suite.Require().NoError(path.RelayPacket(packet))
+			},
+			false,
+			0,
+			func() sdk.Coins { return sendAmt },
+		},
+		{
+			"success - timeout with callback",
+			func(contractAddr common.Address) {
+				memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "%d"}}`, contractAddr, 1_000_000)
+				timeout = uint64(time.Second.Nanoseconds())
+			},
+			func(packet channeltypes.Packet) {
+				suite.coordinator.IncrementTimeBy(time.Hour)
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+			},
+			false,
+			-1,
+			func() sdk.Coins { return sdk.NewCoins() },
+		},
+		{
+			"success - error acknowledgement for a msg not allowed on the host",
+			func(_ common.Address) {
+				memo = ""
+				execMsg := authz.NewMsgExec(icaAddr, []sdk.Msg{banktypes.NewMsgSend(icaAddr, receiver, sendAmt)})
+				msgAny, err := codectypes.NewAnyWithValue(&execMsg)
+				suite.Require().NoError(err)
+				msgBz, err := msgAny.Marshal()
+				suite.Require().NoError(err)
+				msgs = [][]byte{msgBz}
+			},
+			func(packet channeltypes.Packet) {
+				suite.Require().NoError(path.RelayPacket(packet))
+			},
+			false,
+			0,
+			func() sdk.Coins { return sdk.NewCoins() },
+		},
+		{
+			"fail - message is not an Any",
+			func(_ common.Address) {
+				msgs = [][]byte{{0xff}}
+			},
+			nil,
+			true,
+			0,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			ownerIdx := 1
+			owner := suite.chainA.SenderAccounts[ownerIdx]
+			ownerAddr := common.BytesToAddress(owner.SenderAccount.GetAddress().Bytes())
+
+			path = suite.registerInterchainAccount(ownerIdx)
+			icaAddr = sdk.MustAccAddressFromBech32(suite.queryInterchainAccount(ownerAddr, path.EndpointA.ConnectionID))
+
+			// fund the interchain account on the host chain
+			evmAppB := suite.chainB.App.(*evmd.EVMD)
+			bondDenom, err := evmAppB.StakingKeeper.BondDenom(suite.chainB.GetContext())
+			suite.Require().NoError(err)
+			sendAmt = sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1000)))
+			_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), icaAddr, sendAmt))
+			suite.Require().NoError(err)
+
+			receiver = sdk.AccAddress(common.HexToAddress("0x0000000000000000000000000000000000c0ffee").Bytes())
+			msgAny, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(icaAddr, receiver, sendAmt))
+			suite.Require().NoError(err)
+			msgBz, err := msgAny.Marshal()
+			suite.Require().NoError(err)
+			msgs = [][]byte{msgBz}
+			memo = ""
+			timeout = uint64(time.Hour.Nanoseconds())
+
+			// deploy the callback contract on the controller chain
+			contractData, err := callbackstestutil.LoadCounterWithCallbacksContract()
+			suite.Require().NoError(err)
+			contractAddr, err := DeployContract(suite.T(), suite.chainA, testutiltypes.ContractDeploymentData{
+				Contract: contractData,
+			})
+			suite.Require().NoError(err)
+			// the deployment increases the nonce of the relayer account
+			err = suite.chainA.SenderAccount.SetSequence(suite.chainA.SenderAccount.GetSequence() + 1)
+			suite.Require().NoError(err)
+
+			tc.malleate(contractAddr)
+
+			data, err := suite.chainAPrecompile.Pack(
+				ics27.SendTxMethod,
+				ownerAddr,
+				path.EndpointA.ConnectionID,
+				msgs,
+				memo,
+				timeout,
+			)
+			suite.Require().NoError(err)
+
+			res, _, ethRes, err := suite.chainA.SendEvmTx(owner, ownerIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+			suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, packet.SourcePort)
+			suite.Require().Equal(icahosttypes.SubModuleName, packet.DestinationPort)
+
+			out, err := suite.chainAPrecompile.Unpack(ics27.SendTxMethod, ethRes.Ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal(packet.Sequence, out[0])
+
+			tc.relay(packet)
+
+			// check the execution of the messages on the host chain
+			balance := evmAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, bondDenom)
+			suite.Require().Equal(tc.expBalance().AmountOf(bondDenom).String(), balance.Amount.String())
+
+			// check the callback on the controller chain
+			evmAppA := suite.chainA.App.(*evmd.EVMD)
+			counterRes, err := evmAppA.EVMKeeper.CallEVM(
+				suite.chainA.GetContext(),
+				contractData.ABI,
+				ownerAddr,
+				contractAddr,
+				false,
+				big.NewInt(100000),
+				"getCounter",
+			)
+			suite.Require().NoError(err)
+
+			var counter *big.Int
+			err = contractData.ABI.UnpackIntoInterface(&counter, "getCounter", counterRes.Ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expCounter, counter.Int64())
+		})
+	}
+}
+
+func TestICS27PrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(ICS27PrecompileTestSuite))
+}
@@ -9,3 +9,11 @@ import (
 func TestKVIndexer(t *testing.T) {
 	indexer.TestKVIndexer(t, CreateEvmd)
 }
+
+func TestKVIndexerLogs(t *testing.T) {
+	indexer.TestKVIndexerLogs(t, CreateEvmd)
+}
+
+func TestSQLIndexer(t *testing.T) {
+	indexer.TestSQLIndexer(t, CreateEvmd)
+}
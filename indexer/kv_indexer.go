@@ -2,14 +2,15 @@ package indexer
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	dbm "github.com/cosmos/cosmos-db"
-	rpctypes "github.com/cosmos/evm/rpc/types"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -19,107 +20,108 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash     = 1
+	KeyPrefixTxIndex    = 2
+	KeyPrefixLog        = 3
+	KeyPrefixLogAddress = 4
+	KeyPrefixLogTopic   = 5
+	KeyPrefixLogBlock   = 6
+	KeyPrefixReceipts   = 7
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// LogBlockKeyLength is the length of log-block key
+	LogBlockKeyLength = 1 + 8
+	// logPositionLength is the length of the (block number, log index) suffix
+	// shared by the log keys
+	logPositionLength = 8 + 8
 )
 
-var _ cosmosevmtypes.EVMTxIndexer = &KVIndexer{}
+var (
+	_ cosmosevmtypes.EVMTxIndexer           = &KVIndexer{}
+	_ cosmosevmtypes.EVMLogIndexer          = &KVIndexer{}
+	_ cosmosevmtypes.EVMReceiptsRootIndexer = &KVIndexer{}
+)
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+	indexLogs bool
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+}
+
+// WithLogIndex sets if the indexer also maintains the secondary index of the
+// eth logs by address and topic.
+func (kv *KVIndexer) WithLogIndex(enable bool) *KVIndexer {
+	kv.indexLogs = enable
+	return kv
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
-// - Iterates over all of the Txs in Block
-// - Parses eth Tx infos from cosmos-sdk events for every TxResult
-// - Iterates over all the messages of the Tx
-// - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Parses the eth txs of the block and their results
+// - Stores a indexer.TxResult for every eth tx
+// - Stores the root of the receipts trie of the block
+// - Stores the logs of the block and their address and topic indexes, if the log index is enabled
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Height
 
+	parsed, err := parseBlock(kv.logger, kv.clientCtx.TxConfig.TxDecoder(), block, txResults, kv.indexLogs)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
-	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
-		result := txResults[txIndex]
-		if !rpctypes.TxSucessOrExpectedFailure(result) {
-			continue
+	for _, tx := range parsed.txs {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, tx.hash, &tx.result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
-
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
-			continue
+	}
+	if err := batch.Set(ReceiptsRootKey(height), parsed.receiptsRoot.Bytes()); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set receipts-root key", height)
+	}
+	if kv.indexLogs {
+		if err := kv.saveLogs(batch, height, parsed.logs); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
-
-		if !isEthTx(tx) {
-			continue
+		// mark the block as indexed, even if it has no logs
+		if err := batch.Set(LogBlockKey(height), []byte{}); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set log-block key", height)
 		}
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
 
-		txs, err := rpctypes.ParseTxResult(result, tx)
-		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
-			continue
+// saveLogs indexes the logs of a block into the kv db batch
+func (kv *KVIndexer) saveLogs(batch dbm.Batch, height int64, logs []*ethtypes.Log) error {
+	for _, ethLog := range logs {
+		index := uint64(ethLog.Index)
+		bz := kv.clientCtx.Codec.MustMarshal(evmtypes.NewLogFromEth(ethLog))
+		if err := batch.Set(LogKey(height, index), bz); err != nil {
+			return errorsmod.Wrap(err, "set log key")
 		}
-
-		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			txHash := ethMsg.Hash()
-
-			txResult := cosmosevmtypes.TxResult{
-				Height:     height,
-				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
-				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
-				EthTxIndex: ethTxIndex,
-			}
-			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
-				// some old versions don't emit any events, so workaround here directly.
-				txResult.GasUsed = ethMsg.GetGas()
-				txResult.Failed = true
-			} else {
-				parsedTx := txs.GetTxByMsgIndex(msgIndex)
-				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
-					continue
-				}
-				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
-				}
-				txResult.GasUsed = parsedTx.GasUsed
-				txResult.Failed = parsedTx.Failed
-			}
-
-			cumulativeGasUsed += txResult.GasUsed
-			txResult.CumulativeGasUsed = cumulativeGasUsed
-			ethTxIndex++
-
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		if err := batch.Set(LogAddressKey(ethLog.Address, height, index), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log-address key")
+		}
+		for position, topic := range ethLog.Topics {
+			if err := batch.Set(LogTopicKey(position, topic, height, index), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log-topic key")
 			}
 		}
 	}
-	if err := batch.Write(); err != nil {
-		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
-	}
 	return nil
 }
 
@@ -161,6 +163,195 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*cosm
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetReceiptsRoot returns the root of the receipts trie of a block, returns
+// nil if the block is not indexed
+func (kv *KVIndexer) GetReceiptsRoot(blockNumber int64) (*common.Hash, error) {
+	bz, err := kv.db.Get(ReceiptsRootKey(blockNumber))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetReceiptsRoot %d", blockNumber)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	root := common.BytesToHash(bz)
+	return &root, nil
+}
+
+// FirstLogIndexedBlock returns the first block number of the log index, returns -1 if it is empty
+func (kv *KVIndexer) FirstLogIndexedBlock() (int64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstLogIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromLogBlockKey(it.Key())
+}
+
+// LastLogIndexedBlock returns the latest block number of the log index, returns -1 if it is empty
+func (kv *KVIndexer) LastLogIndexedBlock() (int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastLogIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromLogBlockKey(it.Key())
+}
+
+// IsLogIndexed returns true if the log index is enabled and all the blocks of
+// the range are in it
+func (kv *KVIndexer) IsLogIndexed(from, to int64) (bool, error) {
+	if !kv.indexLogs || from > to {
+		return false, nil
+	}
+	it, err := kv.db.Iterator(LogBlockKey(from), LogBlockKey(to+1))
+	if err != nil {
+		return false, errorsmod.Wrapf(err, "IsLogIndexed %d %d", from, to)
+	}
+	defer it.Close()
+
+	var count int64
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	return count == to-from+1, nil
+}
+
+// GetLogs finds the eth logs of the block range matching the addresses and the
+// topics. The candidates of every non-empty criteria are looked up in the
+// address and topic indexes and intersected, so only the matching logs are read.
+func (kv *KVIndexer) GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error) {
+	if from > to {
+		return []*ethtypes.Log{}, nil
+	}
+
+	// positions is the set of the (block number, log index) suffixes matching
+	// all the criteria, nil if there is no criteria
+	var positions map[string]struct{}
+	intersect := func(matches map[string]struct{}) {
+		if positions == nil {
+			positions = matches
+			return
+		}
+		for pos := range positions {
+			if _, ok := matches[pos]; !ok {
+				delete(positions, pos)
+			}
+		}
+	}
+
+	if len(addresses) > 0 {
+		matches := make(map[string]struct{})
+		for _, address := range addresses {
+			prefix := append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+			if err := kv.collectLogPositions(prefix, from, to, matches); err != nil {
+				return nil, errorsmod.Wrapf(err, "GetLogs address %s", address.Hex())
+			}
+		}
+		intersect(matches)
+	}
+	for position, topicList := range topics {
+		// an empty list matches any topic at this position
+		if len(topicList) == 0 {
+			continue
+		}
+		matches := make(map[string]struct{})
+		for _, topic := range topicList {
+			if err := kv.collectLogPositions(logTopicPrefix(position, topic), from, to, matches); err != nil {
+				return nil, errorsmod.Wrapf(err, "GetLogs topic %s", topic.Hex())
+			}
+		}
+		intersect(matches)
+	}
+
+	if positions == nil {
+		return kv.getLogsInRange(from, to, limit)
+	}
+	if limit > 0 && len(positions) > limit {
+		return nil, fmt.Errorf("query returned more than %d results", limit)
+	}
+
+	sorted := make([]string, 0, len(positions))
+	for pos := range positions {
+		sorted = append(sorted, pos)
+	}
+	// the big endian encoding keeps the logs in chain order
+	sort.Strings(sorted)
+
+	logs := make([]*ethtypes.Log, 0, len(sorted))
+	for _, pos := range sorted {
+		bz, err := kv.db.Get(append([]byte{KeyPrefixLog}, pos...))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		if len(bz) == 0 {
+			return nil, fmt.Errorf("indexed log not found, position: %x", pos)
+		}
+		ethLog, err := kv.unmarshalLog(bz)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, ethLog)
+	}
+	return logs, nil
+}
+
+// getLogsInRange returns all the logs of the block range
+func (kv *KVIndexer) getLogsInRange(from, to int64, limit int) ([]*ethtypes.Log, error) {
+	it, err := kv.db.Iterator(LogKey(from, 0), LogKey(to+1, 0))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetLogs %d %d", from, to)
+	}
+	defer it.Close()
+
+	logs := []*ethtypes.Log{}
+	for ; it.Valid(); it.Next() {
+		if limit > 0 && len(logs) == limit {
+			return nil, fmt.Errorf("query returned more than %d results", limit)
+		}
+		ethLog, err := kv.unmarshalLog(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, ethLog)
+	}
+	return logs, nil
+}
+
+// collectLogPositions adds to matches the (block number, log index) suffixes of
+// the index entries with the given prefix within the block range
+func (kv *KVIndexer) collectLogPositions(prefix []byte, from, to int64, matches map[string]struct{}) error {
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...) //nolint:gosec // G115 // block number won't exceed uint64
+	end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to+1))...)   //nolint:gosec // G115 // block number won't exceed uint64
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != len(prefix)+logPositionLength {
+			return fmt.Errorf("wrong log index key length, expect: %d, got: %d", len(prefix)+logPositionLength, len(key))
+		}
+		matches[string(key[len(prefix):])] = struct{}{}
+	}
+	return nil
+}
+
+func (kv *KVIndexer) unmarshalLog(bz []byte) (*ethtypes.Log, error) {
+	var log evmtypes.Log
+	if err := kv.clientCtx.Codec.Unmarshal(bz, &log); err != nil {
+		return nil, errorsmod.Wrap(err, "unmarshal indexed log")
+	}
+	return log.ToEthereum(), nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -173,6 +364,42 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// LogKey returns the key for db entry: `(block number, log index) -> log`
+func LogKey(blockNumber int64, logIndex uint64) []byte {
+	return append([]byte{KeyPrefixLog}, logPosition(blockNumber, logIndex)...)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log index) -> nil`
+func LogAddressKey(address common.Address, blockNumber int64, logIndex uint64) []byte {
+	key := append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+	return append(key, logPosition(blockNumber, logIndex)...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number, log index) -> nil`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64, logIndex uint64) []byte {
+	return append(logTopicPrefix(position, topic), logPosition(blockNumber, logIndex)...)
+}
+
+// LogBlockKey returns the key for db entry: `block number -> nil`, recording
+// that the logs of the block are indexed
+func LogBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLogBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// ReceiptsRootKey returns the key for db entry: `block number -> receipts root`
+func ReceiptsRootKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixReceipts}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+func logTopicPrefix(position int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...) //nolint:gosec // G115 // a log has at most 4 topics
+}
+
+func logPosition(blockNumber int64, logIndex uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	return append(bz, sdk.Uint64ToBigEndian(logIndex)...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -199,19 +426,6 @@ func LoadFirstBlock(db dbm.DB) (int64, error) {
 	return parseBlockNumberFromKey(it.Key())
 }
 
-// isEthTx check if the tx is an eth tx
-func isEthTx(tx sdk.Tx) bool {
-	extTx, ok := tx.(authante.HasExtensionOptionsTx)
-	if !ok {
-		return false
-	}
-	opts := extTx.GetExtensionOptions()
-	if len(opts) != 1 || opts[0].GetTypeUrl() != "/cosmos.evm.vm.v1.ExtensionOptionsEthereumTx" {
-		return false
-	}
-	return true
-}
-
 // saveTxResult index the txResult into the kv db batch
 func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResult *cosmosevmtypes.TxResult) error {
 	bz := codec.MustMarshal(txResult)
@@ -224,6 +438,14 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+func parseBlockNumberFromLogBlockKey(key []byte) (int64, error) {
+	if len(key) != LogBlockKeyLength {
+		return 0, fmt.Errorf("wrong log block key length, expect: %d, got: %d", LogBlockKeyLength, len(key))
+	}
+
+	return int64(sdk.BigEndianToUint64(key[1:])), nil //#nosec G115 -- int overflow is not a concern here
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -0,0 +1,424 @@
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// sqlSchema defines the relational tables of the SQL indexer. Addresses and
+// hashes are stored as lower case 0x-prefixed hex strings.
+const sqlSchema = `
+CREATE TABLE IF NOT EXISTS blocks (
+	height INTEGER PRIMARY KEY,
+	hash TEXT NOT NULL,
+	receipts_root TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS transactions (
+	hash TEXT PRIMARY KEY,
+	height INTEGER NOT NULL,
+	tx_index INTEGER NOT NULL,
+	msg_index INTEGER NOT NULL,
+	eth_tx_index INTEGER NOT NULL,
+	type INTEGER NOT NULL,
+	from_address TEXT NOT NULL,
+	to_address TEXT,
+	nonce INTEGER NOT NULL,
+	value TEXT NOT NULL,
+	gas INTEGER NOT NULL,
+	input BLOB
+);
+CREATE UNIQUE INDEX IF NOT EXISTS transactions_height_eth_tx_index ON transactions (height, eth_tx_index);
+CREATE INDEX IF NOT EXISTS transactions_from_address ON transactions (from_address);
+CREATE INDEX IF NOT EXISTS transactions_to_address ON transactions (to_address);
+
+CREATE TABLE IF NOT EXISTS receipts (
+	tx_hash TEXT PRIMARY KEY REFERENCES transactions (hash),
+	status INTEGER NOT NULL,
+	gas_used INTEGER NOT NULL,
+	cumulative_gas_used INTEGER NOT NULL,
+	contract_address TEXT
+);
+
+CREATE TABLE IF NOT EXISTS logs (
+	height INTEGER NOT NULL,
+	log_index INTEGER NOT NULL,
+	tx_hash TEXT NOT NULL,
+	tx_index INTEGER NOT NULL,
+	block_hash TEXT NOT NULL,
+	address TEXT NOT NULL,
+	topic0 TEXT,
+	topic1 TEXT,
+	topic2 TEXT,
+	topic3 TEXT,
+	data BLOB,
+	PRIMARY KEY (height, log_index)
+);
+CREATE INDEX IF NOT EXISTS logs_tx_hash ON logs (tx_hash);
+CREATE INDEX IF NOT EXISTS logs_address ON logs (address, height);
+CREATE INDEX IF NOT EXISTS logs_topic0 ON logs (topic0, height);
+CREATE INDEX IF NOT EXISTS logs_topic1 ON logs (topic1, height);
+CREATE INDEX IF NOT EXISTS logs_topic2 ON logs (topic2, height);
+CREATE INDEX IF NOT EXISTS logs_topic3 ON logs (topic3, height);
+`
+
+// maxLogTopics is the maximum number of topics of a log
+const maxLogTopics = 4
+
+var (
+	_ cosmosevmtypes.EVMTxIndexer           = &SQLIndexer{}
+	_ cosmosevmtypes.EVMLogIndexer          = &SQLIndexer{}
+	_ cosmosevmtypes.EVMReceiptsRootIndexer = &SQLIndexer{}
+)
+
+// SQLIndexer implements a eth tx indexer on a SQL db, storing the txs, the
+// receipts and the logs in relational tables for ad-hoc queries. The queries
+// use the SQLite dialect.
+type SQLIndexer struct {
+	db        *sql.DB
+	logger    log.Logger
+	clientCtx client.Context
+	indexLogs bool
+}
+
+// NewSQLIndexer creates the SQLIndexer, creating the tables if they don't exist
+func NewSQLIndexer(db *sql.DB, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	if _, err := db.Exec(sqlSchema); err != nil {
+		return nil, errorsmod.Wrap(err, "create indexer tables")
+	}
+	return &SQLIndexer{db: db, logger: logger, clientCtx: clientCtx}, nil
+}
+
+// WithLogIndex sets if the indexer serves the log queries from its logs table.
+// The logs are stored regardless.
+func (si *SQLIndexer) WithLogIndex(enable bool) *SQLIndexer {
+	si.indexLogs = enable
+	return si
+}
+
+// IndexBlock index all the eth txs in a block, together with their receipts
+// and logs, in a single db transaction. Indexing a block again replaces its rows.
+func (si *SQLIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Height
+
+	parsed, err := parseBlock(si.logger, si.clientCtx.TxConfig.TxDecoder(), block, txResults, true)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, begin", height)
+	}
+	defer dbTx.Rollback() //nolint:errcheck // no-op once committed
+
+	if err := deleteSQLHeight(dbTx, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if _, err := dbTx.Exec(
+		`INSERT OR REPLACE INTO blocks (height, hash, receipts_root) VALUES (?, ?, ?)`,
+		height, hashString(common.BytesToHash(block.Hash())), hashString(parsed.receiptsRoot),
+	); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, insert block", height)
+	}
+	for _, tx := range parsed.txs {
+		if err := saveSQLTx(dbTx, tx); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	for _, ethLog := range parsed.logs {
+		if err := saveSQLLog(dbTx, ethLog); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit", height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) LastIndexedBlock() (int64, error) {
+	return si.queryHeight(`SELECT MAX(height) FROM blocks`)
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	return si.queryHeight(`SELECT MIN(height) FROM blocks`)
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (si *SQLIndexer) GetByTxHash(hash common.Hash) (*cosmosevmtypes.TxResult, error) {
+	res, err := si.queryTxResult(`t.hash = ?`, hashString(hash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return res, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (si *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*cosmosevmtypes.TxResult, error) {
+	res, err := si.queryTxResult(`t.height = ? AND t.eth_tx_index = ?`, blockNumber, txIndex)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return res, nil
+}
+
+// GetReceiptsRoot returns the root of the receipts trie of a block, returns
+// nil if the block is not indexed
+func (si *SQLIndexer) GetReceiptsRoot(blockNumber int64) (*common.Hash, error) {
+	var root string
+	err := si.db.QueryRow(`SELECT receipts_root FROM blocks WHERE height = ?`, blockNumber).Scan(&root)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetReceiptsRoot %d", blockNumber)
+	}
+	hash := common.HexToHash(root)
+	return &hash, nil
+}
+
+// IsLogIndexed returns true if the log index is enabled and all the blocks of
+// the range are indexed
+func (si *SQLIndexer) IsLogIndexed(from, to int64) (bool, error) {
+	if !si.indexLogs || from > to {
+		return false, nil
+	}
+	var count int64
+	if err := si.db.QueryRow(
+		`SELECT COUNT(*) FROM blocks WHERE height BETWEEN ? AND ?`, from, to,
+	).Scan(&count); err != nil {
+		return false, errorsmod.Wrapf(err, "IsLogIndexed %d %d", from, to)
+	}
+	return count == to-from+1, nil
+}
+
+// GetLogs finds the eth logs of the block range matching the addresses and the topics
+func (si *SQLIndexer) GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error) {
+	query := strings.Builder{}
+	query.WriteString(`SELECT height, log_index, tx_hash, tx_index, block_hash, address, topic0, topic1, topic2, topic3, data
+FROM logs WHERE height BETWEEN ? AND ?`)
+	args := []interface{}{from, to}
+
+	if len(addresses) > 0 {
+		values := make([]string, len(addresses))
+		for i, address := range addresses {
+			values[i] = addressString(address)
+		}
+		args = appendInClause(&query, "address", values, args)
+	}
+	for position, topicList := range topics {
+		// an empty list matches any topic at this position
+		if len(topicList) == 0 {
+			continue
+		}
+		// no log has a topic at this position
+		if position >= maxLogTopics {
+			return []*ethtypes.Log{}, nil
+		}
+		values := make([]string, len(topicList))
+		for i, topic := range topicList {
+			values[i] = hashString(topic)
+		}
+		args = appendInClause(&query, fmt.Sprintf("topic%d", position), values, args)
+	}
+
+	query.WriteString(` ORDER BY height, log_index`)
+	if limit > 0 {
+		// query one more log to detect if the limit is exceeded
+		query.WriteString(` LIMIT ?`)
+		args = append(args, limit+1)
+	}
+
+	rows, err := si.db.Query(query.String(), args...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetLogs %d %d", from, to)
+	}
+	defer rows.Close()
+
+	logs := []*ethtypes.Log{}
+	for rows.Next() {
+		if limit > 0 && len(logs) == limit {
+			return nil, fmt.Errorf("query returned more than %d results", limit)
+		}
+		ethLog, err := scanSQLLog(rows)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetLogs %d %d", from, to)
+		}
+		logs = append(logs, ethLog)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetLogs %d %d", from, to)
+	}
+	return logs, nil
+}
+
+func (si *SQLIndexer) queryHeight(query string) (int64, error) {
+	var height sql.NullInt64
+	if err := si.db.QueryRow(query).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "query indexed block")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+func (si *SQLIndexer) queryTxResult(where string, args ...interface{}) (*cosmosevmtypes.TxResult, error) {
+	var (
+		res    cosmosevmtypes.TxResult
+		status int64
+	)
+	err := si.db.QueryRow(
+		`SELECT t.height, t.tx_index, t.msg_index, t.eth_tx_index, r.status, r.gas_used, r.cumulative_gas_used
+FROM transactions t JOIN receipts r ON r.tx_hash = t.hash WHERE `+where, args...,
+	).Scan(&res.Height, &res.TxIndex, &res.MsgIndex, &res.EthTxIndex, &status, &res.GasUsed, &res.CumulativeGasUsed)
+	if err != nil {
+		return nil, err
+	}
+	res.Failed = status == int64(ethtypes.ReceiptStatusFailed)
+	return &res, nil
+}
+
+// deleteSQLHeight deletes the txs, receipts and logs of the height from the db
+// transaction, so that indexing a block again doesn't leave stale rows
+func deleteSQLHeight(dbTx *sql.Tx, height int64) error {
+	if _, err := dbTx.Exec(
+		`DELETE FROM receipts WHERE tx_hash IN (SELECT hash FROM transactions WHERE height = ?)`, height,
+	); err != nil {
+		return errorsmod.Wrap(err, "delete receipts")
+	}
+	if _, err := dbTx.Exec(`DELETE FROM transactions WHERE height = ?`, height); err != nil {
+		return errorsmod.Wrap(err, "delete transactions")
+	}
+	if _, err := dbTx.Exec(`DELETE FROM logs WHERE height = ?`, height); err != nil {
+		return errorsmod.Wrap(err, "delete logs")
+	}
+	return nil
+}
+
+// saveSQLTx inserts the eth tx and its receipt into the db transaction
+func saveSQLTx(dbTx *sql.Tx, tx ethTx) error {
+	ethTx := tx.msg.AsTransaction()
+	from := common.BytesToAddress(tx.msg.From)
+
+	var to, contractAddress interface{}
+	if ethTx.To() != nil {
+		to = addressString(*ethTx.To())
+	} else {
+		contractAddress = addressString(crypto.CreateAddress(from, ethTx.Nonce()))
+	}
+
+	if _, err := dbTx.Exec(
+		`INSERT OR REPLACE INTO transactions
+(hash, height, tx_index, msg_index, eth_tx_index, type, from_address, to_address, nonce, value, gas, input)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		hashString(tx.hash), tx.result.Height, tx.result.TxIndex, tx.result.MsgIndex, tx.result.EthTxIndex,
+		ethTx.Type(), addressString(from), to, ethTx.Nonce(), ethTx.Value().String(), ethTx.Gas(), ethTx.Data(),
+	); err != nil {
+		return errorsmod.Wrap(err, "insert transaction")
+	}
+
+	status := ethtypes.ReceiptStatusSuccessful
+	if tx.result.Failed {
+		status = ethtypes.ReceiptStatusFailed
+	}
+	if _, err := dbTx.Exec(
+		`INSERT OR REPLACE INTO receipts (tx_hash, status, gas_used, cumulative_gas_used, contract_address)
+VALUES (?, ?, ?, ?, ?)`,
+		hashString(tx.hash), status, tx.result.GasUsed, tx.result.CumulativeGasUsed, contractAddress,
+	); err != nil {
+		return errorsmod.Wrap(err, "insert receipt")
+	}
+	return nil
+}
+
+// saveSQLLog inserts the eth log into the db transaction
+func saveSQLLog(dbTx *sql.Tx, ethLog *ethtypes.Log) error {
+	topics := make([]interface{}, maxLogTopics)
+	for i, topic := range ethLog.Topics {
+		if i == maxLogTopics {
+			break
+		}
+		topics[i] = hashString(topic)
+	}
+
+	if _, err := dbTx.Exec(
+		`INSERT OR REPLACE INTO logs
+(height, log_index, tx_hash, tx_index, block_hash, address, topic0, topic1, topic2, topic3, data)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		ethLog.BlockNumber, ethLog.Index, hashString(ethLog.TxHash), ethLog.TxIndex, hashString(ethLog.BlockHash),
+		addressString(ethLog.Address), topics[0], topics[1], topics[2], topics[3], ethLog.Data,
+	); err != nil {
+		return errorsmod.Wrap(err, "insert log")
+	}
+	return nil
+}
+
+func scanSQLLog(rows *sql.Rows) (*ethtypes.Log, error) {
+	var (
+		ethLog                     ethtypes.Log
+		txHash, blockHash, address string
+		topics                     [maxLogTopics]sql.NullString
+	)
+	if err := rows.Scan(
+		&ethLog.BlockNumber, &ethLog.Index, &txHash, &ethLog.TxIndex, &blockHash, &address,
+		&topics[0], &topics[1], &topics[2], &topics[3], &ethLog.Data,
+	); err != nil {
+		return nil, err
+	}
+
+	ethLog.TxHash = common.HexToHash(txHash)
+	ethLog.BlockHash = common.HexToHash(blockHash)
+	ethLog.Address = common.HexToAddress(address)
+	ethLog.Topics = []common.Hash{}
+	for _, topic := range topics {
+		if !topic.Valid {
+			break
+		}
+		ethLog.Topics = append(ethLog.Topics, common.HexToHash(topic.String))
+	}
+	return &ethLog, nil
+}
+
+// appendInClause appends to the query a `column IN (...)` condition on the values
+func appendInClause(query *strings.Builder, column string, values []string, args []interface{}) []interface{} {
+	query.WriteString(" AND " + column + " IN (?" + strings.Repeat(", ?", len(values)-1) + ")")
+	for _, value := range values {
+		args = append(args, value)
+	}
+	return args
+}
+
+func hashString(hash common.Hash) string {
+	return hash.Hex()
+}
+
+func addressString(address common.Address) string {
+	return strings.ToLower(address.Hex())
+}
@@ -0,0 +1,147 @@
+package indexer
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// ethTx is an eth tx of a block together with its indexed result
+type ethTx struct {
+	hash   common.Hash
+	msg    *evmtypes.MsgEthereumTx
+	result cosmosevmtypes.TxResult
+}
+
+// parsedBlock holds the eth txs of a block, the logs they emitted and the
+// root of the receipts trie
+type parsedBlock struct {
+	txs          []ethTx
+	logs         []*ethtypes.Log
+	receiptsRoot common.Hash
+}
+
+// parseBlock parses the eth txs of a block through the following steps:
+// - Iterates over all of the Txs in Block
+// - Parses eth Tx infos from cosmos-sdk events for every TxResult
+// - Iterates over all the messages of the Tx
+// - Builds a indexer.TxResult based on parsed events for every message
+// - Decodes the logs of the successful Txs, if withLogs is set
+// - Computes the root of the receipts trie of the block
+func parseBlock(
+	logger log.Logger,
+	txDecoder sdk.TxDecoder,
+	block *cmttypes.Block,
+	txResults []*abci.ExecTxResult,
+	withLogs bool,
+) (*parsedBlock, error) {
+	height := block.Height
+	parsed := &parsedBlock{}
+
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSucessOrExpectedFailure(result) {
+			continue
+		}
+
+		tx, err := txDecoder(tx)
+		if err != nil {
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		txs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if withLogs && result.Code == abci.CodeTypeOK {
+			logs, err := evmtypes.DecodeTxLogsFromEvents(result.Data, result.Events, uint64(height)) //nolint:gosec // G115 // block number won't exceed uint64
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "decode logs of tx %d", txIndex)
+			}
+			parsed.logs = append(parsed.logs, logs...)
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+
+			txResult := cosmosevmtypes.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
+				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
+				EthTxIndex: ethTxIndex,
+			}
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// some old versions don't emit any events, so workaround here directly.
+				txResult.GasUsed = ethMsg.GetGas()
+				txResult.Failed = true
+			} else {
+				parsedTx := txs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				txResult.GasUsed = parsedTx.GasUsed
+				txResult.Failed = parsedTx.Failed
+			}
+
+			cumulativeGasUsed += txResult.GasUsed
+			txResult.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			parsed.txs = append(parsed.txs, ethTx{hash: ethMsg.Hash(), msg: ethMsg, result: txResult})
+		}
+	}
+
+	receiptsRoot, err := rpctypes.ReceiptsRoot(txDecoder, block, txResults)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "compute receipts root")
+	}
+	parsed.receiptsRoot = receiptsRoot
+	return parsed, nil
+}
+
+// isEthTx check if the tx is an eth tx, which holds the ExtensionOptionsEthereumTx
+// extension option, optionally followed by the ExtensionOptionFeePayerTx one
+func isEthTx(tx sdk.Tx) bool {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return false
+	}
+	opts := extTx.GetExtensionOptions()
+	switch len(opts) {
+	case 1:
+	case 2:
+		if opts[1].GetTypeUrl() != "/cosmos.evm.types.v1.ExtensionOptionFeePayerTx" {
+			return false
+		}
+	default:
+		return false
+	}
+	return opts[0].GetTypeUrl() == "/cosmos.evm.vm.v1.ExtensionOptionsEthereumTx"
+}
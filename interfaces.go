@@ -9,6 +9,7 @@ import (
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	precisebankkeeper "github.com/cosmos/evm/x/precisebank/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 
 	storetypes "cosmossdk.io/store/types"
@@ -55,6 +56,7 @@ type EvmApp interface { //nolint:revive
 	GetConsensusParamsKeeper() consensusparamkeeper.Keeper
 	GetCallbackKeeper() keeper.ContractKeeper
 	GetTransferKeeper() transferkeeper.Keeper
+	GetICAControllerKeeper() icacontrollerkeeper.Keeper
 	SetTransferKeeper(transferKeeper transferkeeper.Keeper)
 	DefaultGenesis() map[string]json.RawMessage
 	GetKey(storeKey string) *storetypes.KVStoreKey
@@ -0,0 +1,19 @@
+package mempool
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VMKeeper defines the EVM keeper methods required by the mempool to resolve
+// the executable transactions against the latest state.
+type VMKeeper interface {
+	// GetNonce returns the sequence number of an account
+	GetNonce(ctx sdk.Context, addr common.Address) uint64
+	// GetBaseFee returns the BaseFee param from the fee market module
+	// adapted according to the evm denom decimals
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
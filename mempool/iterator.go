@@ -0,0 +1,119 @@
+package mempool
+
+import (
+	"container/heap"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.Iterator = &iterator{}
+
+// iterator iterates over a snapshot of the executable transactions of the
+// mempool.
+type iterator struct {
+	txs []sdk.Tx
+	idx int
+}
+
+// newIterator returns an iterator over the given transactions or nil if there
+// are none, as expected by the proposal handlers.
+func newIterator(txs []sdk.Tx) sdkmempool.Iterator {
+	if len(txs) == 0 {
+		return nil
+	}
+	return &iterator{txs: txs}
+}
+
+// Next implements sdkmempool.Iterator.
+func (it *iterator) Next() sdkmempool.Iterator {
+	it.idx++
+	if it.idx >= len(it.txs) {
+		return nil
+	}
+	return it
+}
+
+// Tx implements sdkmempool.Iterator.
+func (it *iterator) Tx() sdk.Tx {
+	return it.txs[it.idx]
+}
+
+// senderTxs holds the executable transactions of a sender, ordered by nonce,
+// together with the effective tip of the first one.
+type senderTxs struct {
+	txs []*evmTx
+	tip *big.Int
+}
+
+// tipHeap orders the senders by the effective tip of their next transaction.
+type tipHeap []*senderTxs
+
+var _ heap.Interface = &tipHeap{}
+
+func (h tipHeap) Len() int { return len(h) }
+
+func (h tipHeap) Less(i, j int) bool {
+	if cmp := h[i].tip.Cmp(h[j].tip); cmp != 0 {
+		return cmp > 0
+	}
+	// first come, first served on equal tips
+	return h[i].txs[0].seq < h[j].txs[0].seq
+}
+
+func (h tipHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *tipHeap) Push(x any) { *h = append(*h, x.(*senderTxs)) }
+
+func (h *tipHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
+
+// orderByTip merges the executable transactions of all senders in descending
+// order of effective tip, keeping the nonce order of every sender. The
+// transactions of a sender that can't pay the base fee are skipped together
+// with their successors.
+func orderByTip(senders [][]*evmTx, baseFee *big.Int) []sdk.Tx {
+	h := make(tipHeap, 0, len(senders))
+	count := 0
+	for _, txs := range senders {
+		if entry := newSenderTxs(txs, baseFee); entry != nil {
+			h = append(h, entry)
+			count += len(txs)
+		}
+	}
+	heap.Init(&h)
+
+	ordered := make([]sdk.Tx, 0, count)
+	for h.Len() > 0 {
+		entry := h[0]
+		ordered = append(ordered, entry.txs[0].tx)
+
+		if next := newSenderTxs(entry.txs[1:], baseFee); next != nil {
+			h[0] = next
+			heap.Fix(&h, 0)
+		} else {
+			heap.Pop(&h)
+		}
+	}
+	return ordered
+}
+
+// newSenderTxs returns the heap entry of the given transactions or nil if the
+// first one can't be included at the given base fee.
+func newSenderTxs(txs []*evmTx, baseFee *big.Int) *senderTxs {
+	if len(txs) == 0 {
+		return nil
+	}
+	tip, err := txs[0].ethTx.EffectiveGasTip(baseFee)
+	if err != nil {
+		return nil
+	}
+	return &senderTxs{txs: txs, tip: tip}
+}
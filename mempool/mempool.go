@@ -0,0 +1,311 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+const (
+	// DefaultPriceBump is the default minimum price bump percentage required
+	// to replace a transaction with the same nonce.
+	DefaultPriceBump uint64 = 10
+	// DefaultAccountQueue is the default maximum number of queued (non
+	// executable) transactions per sender.
+	DefaultAccountQueue = 64
+)
+
+var (
+	// ErrReplaceUnderpriced is returned if a transaction is attempted to be
+	// replaced with a different one without the required price bump.
+	ErrReplaceUnderpriced = errors.New("replacement transaction underpriced")
+	// ErrAccountQueueFull is returned if a sender exceeds the maximum number of
+	// queued transactions.
+	ErrAccountQueueFull = errors.New("account queue limit reached")
+)
+
+var _ sdkmempool.ExtMempool = &EVMMempool{}
+
+// Config defines the configuration of the EVM mempool.
+type Config struct {
+	// PriceBump is the minimum price bump percentage required to replace a
+	// transaction with the same nonce. Defaults to DefaultPriceBump if zero.
+	PriceBump uint64
+	// AccountQueue is the maximum number of queued transactions per sender.
+	// Defaults to DefaultAccountQueue if zero.
+	AccountQueue int
+	// MaxTx is the maximum number of Ethereum transactions held by the
+	// mempool. A zero value means unbounded.
+	MaxTx int
+	// CosmosMempool holds the non Ethereum transactions. Defaults to a
+	// priority nonce mempool if nil.
+	CosmosMempool sdkmempool.Mempool
+	// PendingTxListener is called with the hash of every Ethereum transaction
+	// that becomes executable, either on insertion or when it is promoted
+	// after its nonce gap is closed.
+	PendingTxListener func(common.Hash)
+}
+
+// EVMMempool is an application side mempool that is aware of the Ethereum
+// transaction nonces. Transactions are held per sender: the ones with
+// contiguous nonces starting at the sender nonce are pending and included in
+// block proposals, while the ones after a nonce gap are queued and promoted
+// once the missing nonces are received. A transaction can be replaced by
+// another one with the same nonce that bumps its price by the configured
+// percentage.
+//
+// Block proposals are built with the executable transactions ordered by
+// effective tip, followed by the Cosmos transactions held in the wrapped
+// Cosmos mempool.
+type EVMMempool struct {
+	mtx sync.RWMutex
+
+	vmKeeper VMKeeper
+	config   Config
+
+	senders map[common.Address]*txList
+	// count is the number of Ethereum transactions held
+	count int
+	// seq is the insertion counter of the Ethereum transactions
+	seq uint64
+}
+
+// NewEVMMempool creates a new EVM mempool with the given configuration.
+func NewEVMMempool(vmKeeper VMKeeper, config Config) *EVMMempool {
+	if config.PriceBump == 0 {
+		config.PriceBump = DefaultPriceBump
+	}
+	if config.AccountQueue == 0 {
+		config.AccountQueue = DefaultAccountQueue
+	}
+	if config.CosmosMempool == nil {
+		config.CosmosMempool = sdkmempool.NewPriorityMempool(sdkmempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority: sdkmempool.NewDefaultTxPriority(),
+			MaxTx:      config.MaxTx,
+		})
+	}
+
+	return &EVMMempool{
+		vmKeeper: vmKeeper,
+		config:   config,
+		senders:  make(map[common.Address]*txList),
+	}
+}
+
+// Insert implements sdkmempool.Mempool. Ethereum transactions are inserted
+// into the list of their sender, replacing the transaction with the same
+// nonce if it pays the required price bump. Any other transaction is inserted
+// into the Cosmos mempool.
+//
+// The context is expected to be the CheckTx context after the ante handler
+// run, so the sender nonce accounts for the already executable transactions.
+func (m *EVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	msg, ok := ethMsgFromTx(tx)
+	if !ok {
+		return m.config.CosmosMempool.Insert(goCtx, tx)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender := common.BytesToAddress(msg.GetFrom())
+	ethTx := msg.AsTransaction()
+	nonce := ethTx.Nonce()
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	list, found := m.senders[sender]
+	if !found {
+		// the ante handler only increments the sequence of executable
+		// transactions, so the transaction is executable if its nonce is
+		// below the current one.
+		start := m.vmKeeper.GetNonce(ctx, sender)
+		if nonce < start {
+			start = nonce
+		}
+		list = newTxList(start)
+	} else if nonce < list.nonce {
+		list.nonce = nonce
+	}
+
+	pendingEnd := list.pendingEnd(list.nonce)
+	old, replacement := list.txs[nonce]
+	switch {
+	case replacement:
+		if old.ethTx.Hash() == ethTx.Hash() {
+			return nil
+		}
+		if !isReplacement(old.ethTx, ethTx, m.config.PriceBump) {
+			return errorsmod.Wrapf(
+				ErrReplaceUnderpriced,
+				"nonce %d requires a %d%% price bump", nonce, m.config.PriceBump,
+			)
+		}
+	case m.config.MaxTx > 0 && m.count >= m.config.MaxTx:
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	case nonce > pendingEnd && list.queuedLen() >= m.config.AccountQueue:
+		return errorsmod.Wrapf(ErrAccountQueueFull, "sender %s", sender.Hex())
+	}
+
+	if !replacement {
+		m.count++
+	}
+	m.seq++
+	list.txs[nonce] = &evmTx{tx: tx, msg: msg, ethTx: ethTx, seq: m.seq}
+	m.senders[sender] = list
+
+	// notify the transaction if executable, together with the queued ones
+	// promoted by closing the nonce gap
+	newPendingEnd := list.pendingEnd(list.nonce)
+	if nonce < newPendingEnd {
+		m.notifyPending(list.txs[nonce])
+		for promoted := max(pendingEnd, nonce+1); promoted < newPendingEnd; promoted++ {
+			m.notifyPending(list.txs[promoted])
+		}
+	}
+	return nil
+}
+
+// Select implements sdkmempool.Mempool. It returns an iterator over the
+// transactions that are executable against the state of the given context,
+// ordered by effective tip, followed by the Cosmos transactions.
+func (m *EVMMempool) Select(goCtx context.Context, txs [][]byte) sdkmempool.Iterator {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return newIterator(m.selectTxs(goCtx, txs))
+}
+
+// SelectBy implements sdkmempool.ExtMempool. It calls the callback with the
+// transactions returned by Select while holding the mempool lock, until the
+// callback returns false.
+func (m *EVMMempool) SelectBy(goCtx context.Context, txs [][]byte, callback func(sdk.Tx) bool) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	for _, tx := range m.selectTxs(goCtx, txs) {
+		if !callback(tx) {
+			return
+		}
+	}
+}
+
+// CountTx implements sdkmempool.Mempool.
+func (m *EVMMempool) CountTx() int {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.count + m.config.CosmosMempool.CountTx()
+}
+
+// Remove implements sdkmempool.Mempool. Ethereum transactions are only
+// removed if the held transaction for the sender and nonce has the same hash,
+// so that removing a replaced transaction doesn't drop its replacement.
+func (m *EVMMempool) Remove(tx sdk.Tx) error {
+	msg, ok := ethMsgFromTx(tx)
+	if !ok {
+		return m.config.CosmosMempool.Remove(tx)
+	}
+
+	sender := common.BytesToAddress(msg.GetFrom())
+	ethTx := msg.AsTransaction()
+	nonce := ethTx.Nonce()
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	list, found := m.senders[sender]
+	if !found {
+		return sdkmempool.ErrTxNotFound
+	}
+	held, found := list.txs[nonce]
+	if !found || held.ethTx.Hash() != ethTx.Hash() {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	delete(list.txs, nonce)
+	m.count--
+	if nonce == list.nonce {
+		// the transaction is either included in a block or invalid, so the
+		// following transactions are executable only with a new transaction
+		// with this nonce or once it is included.
+		list.nonce++
+	}
+	if len(list.txs) == 0 {
+		delete(m.senders, sender)
+	}
+	return nil
+}
+
+// HasNonce returns true if the mempool holds a transaction from the sender
+// with the given nonce.
+func (m *EVMMempool) HasNonce(sender common.Address, nonce uint64) bool {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	list, found := m.senders[sender]
+	if !found {
+		return false
+	}
+	_, found = list.txs[nonce]
+	return found
+}
+
+// Stats returns the number of pending and queued Ethereum transactions.
+func (m *EVMMempool) Stats() (pending, queued int) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	for _, list := range m.senders {
+		pending += list.pendingLen()
+		queued += list.queuedLen()
+	}
+	return pending, queued
+}
+
+// selectTxs returns the transactions to be proposed. The executable Ethereum
+// transactions are resolved using the sender nonces of the given context.
+//
+// CONTRACT: the caller must hold the mempool lock.
+func (m *EVMMempool) selectTxs(goCtx context.Context, txs [][]byte) []sdk.Tx {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	senders := make([][]*evmTx, 0, len(m.senders))
+	for sender, list := range m.senders {
+		senders = append(senders, list.executable(m.vmKeeper.GetNonce(ctx, sender)))
+	}
+	selected := orderByTip(senders, m.vmKeeper.GetBaseFee(ctx))
+
+	sdkmempool.SelectBy(goCtx, m.config.CosmosMempool, txs, func(tx sdk.Tx) bool {
+		selected = append(selected, tx)
+		return true
+	})
+	return selected
+}
+
+// notifyPending calls the pending transaction listener, if any, with the hash
+// of the given transaction.
+func (m *EVMMempool) notifyPending(tx *evmTx) {
+	if m.config.PendingTxListener != nil {
+		m.config.PendingTxListener(tx.ethTx.Hash())
+	}
+}
+
+// ethMsgFromTx returns the Ethereum message of the transaction, if it's an
+// Ethereum transaction.
+func ethMsgFromTx(tx sdk.Tx) (*evmtypes.MsgEthereumTx, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, false
+	}
+	msg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	return msg, ok
+}
@@ -0,0 +1,185 @@
+package mempool_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/evm/mempool"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var (
+	alice = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bob   = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+// mockVMKeeper returns the configured account nonces and base fee.
+type mockVMKeeper struct {
+	nonces  map[common.Address]uint64
+	baseFee *big.Int
+}
+
+func (k *mockVMKeeper) GetNonce(_ sdk.Context, addr common.Address) uint64 { return k.nonces[addr] }
+func (k *mockVMKeeper) GetBaseFee(_ sdk.Context) *big.Int                  { return k.baseFee }
+
+// testTx is a minimal sdk.Tx wrapping the given messages.
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+// newEthTx returns a dynamic fee transaction sent by the given address.
+func newEthTx(from common.Address, nonce uint64, feeCap, tipCap int64) sdk.Tx {
+	msg := &evmtypes.MsgEthereumTx{}
+	msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     nonce,
+		GasFeeCap: big.NewInt(feeCap),
+		GasTipCap: big.NewInt(tipCap),
+		Gas:       21000,
+		To:        &common.Address{},
+		Value:     big.NewInt(1),
+	}))
+	msg.From = from.Bytes()
+	return testTx{msgs: []sdk.Msg{msg}}
+}
+
+func txHash(tx sdk.Tx) common.Hash {
+	return tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).Hash()
+}
+
+// selectTxs returns all the transactions selected by the mempool.
+func selectTxs(mp *mempool.EVMMempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := mp.Select(sdk.Context{}, nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func TestInsertPromotesQueuedTxs(t *testing.T) {
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{}}
+	var notified []common.Hash
+	mp := mempool.NewEVMMempool(keeper, mempool.Config{
+		PendingTxListener: func(hash common.Hash) { notified = append(notified, hash) },
+	})
+
+	tx1 := newEthTx(alice, 1, 10, 1)
+	tx2 := newEthTx(alice, 2, 10, 1)
+	require.NoError(t, mp.Insert(sdk.Context{}, tx1))
+	require.NoError(t, mp.Insert(sdk.Context{}, tx2))
+
+	pending, queued := mp.Stats()
+	require.Equal(t, 0, pending)
+	require.Equal(t, 2, queued)
+	require.Empty(t, notified)
+	require.Empty(t, selectTxs(mp))
+
+	// the ante handler increments the sequence of the executable transaction
+	keeper.nonces[alice] = 1
+	tx0 := newEthTx(alice, 0, 10, 1)
+	require.NoError(t, mp.Insert(sdk.Context{}, tx0))
+
+	pending, queued = mp.Stats()
+	require.Equal(t, 3, pending)
+	require.Equal(t, 0, queued)
+	require.Equal(t, []common.Hash{txHash(tx0), txHash(tx1), txHash(tx2)}, notified)
+
+	keeper.nonces[alice] = 0
+	require.Equal(t, []sdk.Tx{tx0, tx1, tx2}, selectTxs(mp))
+}
+
+func TestSelectOrdersByEffectiveTip(t *testing.T) {
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{}, baseFee: big.NewInt(10)}
+	mp := mempool.NewEVMMempool(keeper, mempool.Config{})
+
+	alice0 := newEthTx(alice, 0, 11, 5) // effective tip 1
+	alice1 := newEthTx(alice, 1, 30, 20)
+	alice3 := newEthTx(alice, 3, 30, 20) // nonce gap
+	bob0 := newEthTx(bob, 0, 20, 3)
+	bob1 := newEthTx(bob, 1, 9, 9) // can't pay the base fee
+	for _, tx := range []sdk.Tx{alice0, alice1, alice3, bob0, bob1} {
+		require.NoError(t, mp.Insert(sdk.Context{}, tx))
+	}
+	require.Equal(t, 5, mp.CountTx())
+
+	require.Equal(t, []sdk.Tx{bob0, alice0, alice1}, selectTxs(mp))
+
+	// the selection starts at the latest account nonce
+	keeper.nonces[alice] = 1
+	require.Equal(t, []sdk.Tx{alice1, bob0}, selectTxs(mp))
+}
+
+func TestInsertReplacement(t *testing.T) {
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{}}
+	mp := mempool.NewEVMMempool(keeper, mempool.Config{PriceBump: 10})
+
+	original := newEthTx(alice, 0, 100, 10)
+	require.NoError(t, mp.Insert(sdk.Context{}, original))
+	// inserting the same transaction again is a no-op
+	require.NoError(t, mp.Insert(sdk.Context{}, original))
+
+	err := mp.Insert(sdk.Context{}, newEthTx(alice, 0, 109, 11))
+	require.ErrorIs(t, err, mempool.ErrReplaceUnderpriced)
+	err = mp.Insert(sdk.Context{}, newEthTx(alice, 0, 110, 10))
+	require.ErrorIs(t, err, mempool.ErrReplaceUnderpriced)
+
+	replacement := newEthTx(alice, 0, 110, 11)
+	require.NoError(t, mp.Insert(sdk.Context{}, replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []sdk.Tx{replacement}, selectTxs(mp))
+
+	// removing the replaced transaction doesn't affect the replacement
+	require.ErrorIs(t, mp.Remove(original), sdkmempool.ErrTxNotFound)
+	require.True(t, mp.HasNonce(alice, 0))
+
+	require.NoError(t, mp.Remove(replacement))
+	require.False(t, mp.HasNonce(alice, 0))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestInsertLimits(t *testing.T) {
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{}}
+	mp := mempool.NewEVMMempool(keeper, mempool.Config{AccountQueue: 2, MaxTx: 4})
+
+	require.NoError(t, mp.Insert(sdk.Context{}, newEthTx(alice, 5, 10, 1)))
+	require.NoError(t, mp.Insert(sdk.Context{}, newEthTx(alice, 6, 10, 1)))
+	err := mp.Insert(sdk.Context{}, newEthTx(alice, 8, 10, 1))
+	require.ErrorIs(t, err, mempool.ErrAccountQueueFull)
+
+	require.NoError(t, mp.Insert(sdk.Context{}, newEthTx(bob, 0, 10, 1)))
+	require.NoError(t, mp.Insert(sdk.Context{}, newEthTx(bob, 1, 10, 1)))
+	err = mp.Insert(sdk.Context{}, newEthTx(bob, 2, 10, 1))
+	require.ErrorIs(t, err, sdkmempool.ErrMempoolTxMaxCapacity)
+}
+
+func TestRemoveIncludedTx(t *testing.T) {
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{}}
+	mp := mempool.NewEVMMempool(keeper, mempool.Config{})
+
+	tx0 := newEthTx(alice, 0, 10, 1)
+	tx1 := newEthTx(alice, 1, 10, 1)
+	tx3 := newEthTx(alice, 3, 10, 1)
+	for _, tx := range []sdk.Tx{tx0, tx1, tx3} {
+		require.NoError(t, mp.Insert(sdk.Context{}, tx))
+	}
+
+	// the first transaction is included in a block
+	require.NoError(t, mp.Remove(tx0))
+	keeper.nonces[alice] = 1
+
+	pending, queued := mp.Stats()
+	require.Equal(t, 1, pending)
+	require.Equal(t, 1, queued)
+	require.Equal(t, []sdk.Tx{tx1}, selectTxs(mp))
+}
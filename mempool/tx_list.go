@@ -0,0 +1,88 @@
+package mempool
+
+import (
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// evmTx is an Ethereum transaction held by the mempool.
+type evmTx struct {
+	tx    sdk.Tx
+	msg   *evmtypes.MsgEthereumTx
+	ethTx *ethtypes.Transaction
+	// seq is the insertion order of the transaction, used to break ties
+	// between transactions paying the same tip.
+	seq uint64
+}
+
+// txList holds the transactions of a single sender indexed by nonce. The
+// transactions with contiguous nonces starting at the list nonce are pending,
+// while the ones after a nonce gap are queued until the gap is closed.
+type txList struct {
+	// nonce is the lowest nonce of the sender that is not known to be
+	// included in a block.
+	nonce uint64
+	txs   map[uint64]*evmTx
+}
+
+// newTxList creates an empty transaction list starting at the given nonce.
+func newTxList(nonce uint64) *txList {
+	return &txList{
+		nonce: nonce,
+		txs:   make(map[uint64]*evmTx),
+	}
+}
+
+// pendingEnd returns the nonce following the last pending transaction of the
+// list when starting at the given nonce.
+func (l *txList) pendingEnd(from uint64) uint64 {
+	end := from
+	for {
+		if _, ok := l.txs[end]; !ok {
+			return end
+		}
+		end++
+	}
+}
+
+// pendingLen returns the number of pending transactions of the list.
+func (l *txList) pendingLen() int {
+	return int(l.pendingEnd(l.nonce) - l.nonce) //nolint:gosec // G115 // bounded by the list length
+}
+
+// queuedLen returns the number of queued transactions of the list.
+func (l *txList) queuedLen() int {
+	return len(l.txs) - l.pendingLen()
+}
+
+// executable returns the transactions with contiguous nonces starting at the
+// given nonce.
+func (l *txList) executable(from uint64) []*evmTx {
+	end := l.pendingEnd(from)
+	txs := make([]*evmTx, 0, end-from)
+	for nonce := from; nonce < end; nonce++ {
+		txs = append(txs, l.txs[nonce])
+	}
+	return txs
+}
+
+// isReplacement returns true if the new transaction bumps both the fee cap and
+// the tip cap of the old one by at least the given percentage.
+func isReplacement(old, tx *ethtypes.Transaction, priceBump uint64) bool {
+	return bumped(old.GasFeeCap(), tx.GasFeeCap(), priceBump) &&
+		bumped(old.GasTipCap(), tx.GasTipCap(), priceBump)
+}
+
+// bumped returns true if the new price is at least the given percentage above
+// the old one.
+func bumped(old, price *big.Int, priceBump uint64) bool {
+	// threshold = old * (100 + priceBump) / 100
+	threshold := new(big.Int).Mul(old, new(big.Int).SetUint64(100+priceBump))
+	threshold.Div(threshold, big.NewInt(100))
+	return price.Cmp(threshold) >= 0
+}
@@ -0,0 +1,20 @@
+package authz
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidMsgTypeURL is raised when the msg type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid msg type URL: %v"
+	// ErrInvalidExpiration is raised when the expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidStakeAuthorizationType is raised when the stake authorization type is not valid.
+	ErrInvalidStakeAuthorizationType = "invalid stake authorization type: %v"
+	// ErrInvalidMsgs is raised when the messages to execute are not valid.
+	ErrInvalidMsgs = "invalid msgs: %v"
+	// ErrMsgTypeNotAllowed is raised when a msg type cannot be granted or executed through the precompile.
+	ErrMsgTypeNotAllowed = "msg type %s cannot be granted or executed through the authz precompile"
+	// ErrGranteeIsSigner is raised when a message to execute is signed by the grantee itself.
+	ErrGranteeIsSigner = "message %d is signed by the grantee %s; exec can only be used on behalf of a granter"
+)
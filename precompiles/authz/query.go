@@ -0,0 +1,116 @@
+package authz
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantsMethod defines the ABI method name for the authz Grants
+	// query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz
+	// GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the ABI method name for the authz
+	// GranteeGrants query.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants returns the authorizations granted by a granter to a grantee. If a
+// msg type URL is given, only the authorization for that msg type is returned.
+func (p Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.Grants(ctx, req)
+	if err != nil {
+		// return an empty list if there is no authorization for the given msg type
+		if !errors.Is(err, authz.ErrNoAuthorizationFound) {
+			return nil, err
+		}
+		res = &authz.QueryGrantsResponse{Pagination: &query.PageResponse{}}
+	}
+
+	// the grants response does not hold the granter and grantee, as these are
+	// the ones of the request
+	grants := make([]*authz.GrantAuthorization, len(res.Grants))
+	for i, grant := range res.Grants {
+		grants[i] = &authz.GrantAuthorization{
+			Granter:       req.Granter,
+			Grantee:       req.Grantee,
+			Authorization: grant.Authorization,
+			Expiration:    grant.Expiration,
+		}
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(grants, res.Pagination, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GranterGrants returns the authorizations granted by a granter.
+func (p Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranterGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GranteeGrants returns the authorizations granted to a grantee.
+func (p Precompile) GranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranteeGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
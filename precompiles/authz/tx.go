@@ -0,0 +1,223 @@
+package authz
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantGenericAuthorizationMethod defines the ABI method name for the authz
+	// GrantGenericAuthorization transaction.
+	GrantGenericAuthorizationMethod = "grantGenericAuthorization"
+	// GrantSendAuthorizationMethod defines the ABI method name for the authz
+	// GrantSendAuthorization transaction.
+	GrantSendAuthorizationMethod = "grantSendAuthorization"
+	// GrantStakeAuthorizationMethod defines the ABI method name for the authz
+	// GrantStakeAuthorization transaction.
+	GrantStakeAuthorizationMethod = "grantStakeAuthorization"
+	// RevokeMethod defines the ABI method name for the authz Revoke
+	// transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec
+	// transaction.
+	ExecMethod = "exec"
+)
+
+// GrantGenericAuthorization grants the grantee an authorization to execute any
+// message of the given type on behalf of the granter.
+func (p Precompile) GrantGenericAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgGrantGenericAuthorization(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granterHexAddr, granteeHexAddr)
+}
+
+// GrantSendAuthorization grants the grantee an authorization to send coins on
+// behalf of the granter.
+func (p Precompile) GrantSendAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgGrantSendAuthorization(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granterHexAddr, granteeHexAddr)
+}
+
+// GrantStakeAuthorization grants the grantee an authorization to perform a
+// staking operation on behalf of the granter.
+func (p Precompile) GrantStakeAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgGrantStakeAuthorization(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granterHexAddr, granteeHexAddr)
+}
+
+// Revoke revokes the authorization granted to the grantee for the given
+// message type.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgRevoke(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"granter", msg.Granter,
+		"grantee", msg.Grantee,
+		"msg_type_url", msg.MsgTypeUrl,
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	if _, err = p.authzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, granterHexAddr, granteeHexAddr, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes the given messages on behalf of their signers, using the
+// authorizations granted to the grantee. Messages signed by the grantee itself
+// are rejected, so that the precompile cannot be used to execute arbitrary
+// Cosmos messages.
+func (p Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granteeHexAddr, err := NewMsgExec(args, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"grantee", msg.Grantee,
+		"msgs", len(msg.Msgs),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granteeHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granteeHexAddr.String())
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	granters := make([]common.Address, len(msgs))
+	for i, m := range msgs {
+		signers, _, err := p.codec.GetMsgV1Signers(m)
+		if err != nil {
+			return nil, err
+		}
+		if len(signers) != 1 {
+			return nil, authz.ErrAuthorizationNumOfSigners
+		}
+		if bytes.Equal(signers[0], granteeHexAddr.Bytes()) {
+			return nil, fmt.Errorf(ErrGranteeIsSigner, i, granteeHexAddr.String())
+		}
+		granters[i] = common.BytesToAddress(signers[0])
+	}
+
+	res, err := p.authzKeeper.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, m := range msgs {
+		if err = p.EmitExecEvent(ctx, stateDB, granters[i], granteeHexAddr, sdk.MsgTypeURL(m)); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
+
+// grant checks that the granter is the caller, saves the grant and emits the
+// Grant event.
+func (p Precompile) grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *authz.MsgGrant,
+	granterHexAddr, granteeHexAddr common.Address,
+) ([]byte, error) {
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"granter", msg.Granter,
+		"grantee", msg.Grantee,
+		"msg_type_url", authorization.MsgTypeURL(),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	if _, err = p.authzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantEvent(ctx, stateDB, granterHexAddr, granteeHexAddr, authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
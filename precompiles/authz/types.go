@@ -0,0 +1,577 @@
+package authz
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// allowedMsgTypes defines the msg types that can be granted or executed
+// through the precompile. It is an explicit allow-list, as the messages that
+// execute EVM calls cannot run while the precompile is running within the EVM,
+// and the nested authz messages are not inspected by the ante handler when sent
+// from the EVM. Neither can the msg types disabled within authz by the ante
+// handler be granted or executed.
+var allowedMsgTypes = newAllowedMsgTypes(
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawValidatorCommission{}),
+	sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}),
+	sdk.MsgTypeURL(&govv1.MsgVote{}),
+	sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+	sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+)
+
+// EventGrant defines the event data for the authz grant transactions.
+type EventGrant struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeURL string `abi:"msgTypeUrl"`
+}
+
+// EventRevoke defines the event data for the authz Revoke transaction.
+type EventRevoke struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeURL string `abi:"msgTypeUrl"`
+}
+
+// EventExec defines the event data for the authz Exec transaction.
+type EventExec struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeURL string `abi:"msgTypeUrl"`
+}
+
+// GrantAuthorization defines an authorization granted by a granter to a grantee,
+// as returned by the authz queries.
+type GrantAuthorization struct {
+	Granter       common.Address
+	Grantee       common.Address
+	MsgTypeURL    string `abi:"msgTypeUrl"`
+	Authorization []byte
+	Expiration    int64
+}
+
+// GrantStakeAuthorizationInput is a struct to represent the input information
+// for the grantStakeAuthorization transaction. Needed to unpack arguments into
+// the Coin struct.
+type GrantStakeAuthorizationInput struct {
+	Granter           common.Address
+	Grantee           common.Address
+	AuthorizationType uint8
+	AllowedValidators []common.Address
+	DeniedValidators  []common.Address
+	MaxTokens         cmn.Coin
+	Expiration        int64
+}
+
+// NewMsgGrantGenericAuthorization creates a new authz MsgGrant instance for a
+// generic authorization and does sanity checks on the given arguments before
+// populating the message.
+func NewMsgGrantGenericAuthorization(args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	expiration, ok := args[3].(int64)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[3])
+	}
+
+	msg, err := newMsgGrant(granter, grantee, authz.NewGenericAuthorization(msgTypeURL), expiration, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgGrantSendAuthorization creates a new authz MsgGrant instance for a bank
+// send authorization and does sanity checks on the given arguments before
+// populating the message.
+func NewMsgGrantSendAuthorization(args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	coins, err := cmn.ToCoins(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, "spendLimit arg")
+	}
+
+	spendLimit, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, "spendLimit arg")
+	}
+
+	allowList, ok := args[3].([]common.Address)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "allowList", []common.Address{}, args[3])
+	}
+
+	allowedAddrs := make([]sdk.AccAddress, len(allowList))
+	for i, addr := range allowList {
+		allowedAddrs[i] = addr.Bytes()
+	}
+
+	expiration, ok := args[4].(int64)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[4])
+	}
+
+	authorization := banktypes.NewSendAuthorization(spendLimit, allowedAddrs)
+	msg, err := newMsgGrant(granter, grantee, authorization, expiration, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgGrantStakeAuthorization creates a new authz MsgGrant instance for a
+// staking authorization and does sanity checks on the given arguments before
+// populating the message.
+func NewMsgGrantStakeAuthorization(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 7 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	var input GrantStakeAuthorizationInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to GrantStakeAuthorizationInput struct: %s", err)
+	}
+
+	granter, grantee, err := parseGranterGrantee(input.Granter, input.Grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	authzType := stakingtypes.AuthorizationType(input.AuthorizationType)
+	if _, ok := stakingtypes.AuthorizationType_name[int32(authzType)]; !ok || authzType == stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidStakeAuthorizationType, input.AuthorizationType)
+	}
+
+	// a zero max tokens amount defines an authorization without limit
+	var maxTokens *sdk.Coin
+	if input.MaxTokens.Amount != nil && input.MaxTokens.Amount.Sign() > 0 {
+		coin := sdk.Coin{Denom: input.MaxTokens.Denom, Amount: math.NewIntFromBigInt(input.MaxTokens.Amount)}
+		if err := coin.Validate(); err != nil {
+			return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, "maxTokens arg")
+		}
+		maxTokens = &coin
+	}
+
+	allowed := make([]sdk.ValAddress, len(input.AllowedValidators))
+	for i, validator := range input.AllowedValidators {
+		allowed[i] = validator.Bytes()
+	}
+
+	denied := make([]sdk.ValAddress, len(input.DeniedValidators))
+	for i, validator := range input.DeniedValidators {
+		denied[i] = validator.Bytes()
+	}
+
+	authorization, err := stakingtypes.NewStakeAuthorization(allowed, denied, authzType, maxTokens)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg, err := newMsgGrant(granter, grantee, authorization, input.Expiration, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevoke creates a new authz MsgRevoke instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgRevoke(args []interface{}, addrCdc address.Codec) (*authz.MsgRevoke, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	msg := &authz.MsgRevoke{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: msgTypeURL,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgExec creates a new authz MsgExec instance from the JSON encoded messages
+// and does sanity checks on the given arguments before populating the message.
+func NewMsgExec(args []interface{}, cdc codec.Codec, addrCdc address.Codec) (*authz.MsgExec, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	jsonMsgs, ok := args[1].([][]byte)
+	if !ok || len(jsonMsgs) == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgs, "msgs arg")
+	}
+
+	anys := make([]*codectypes.Any, len(jsonMsgs))
+	for i, jsonMsg := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(jsonMsg, &msg); err != nil {
+			return nil, common.Address{}, errorsmod.Wrapf(err, "message %d", i)
+		}
+
+		if msgTypeURL := sdk.MsgTypeURL(msg); !isAllowedMsgType(msgTypeURL) {
+			return nil, common.Address{}, fmt.Errorf(ErrMsgTypeNotAllowed, msgTypeURL)
+		}
+
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		anys[i] = anyMsg
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	msg := &authz.MsgExec{
+		Grantee: granteeAddr,
+		Msgs:    anys,
+	}
+
+	return msg, grantee, nil
+}
+
+// GrantsInput is a struct to represent the input information for
+// the grants query. Needed to unpack arguments into the PageRequest struct.
+type GrantsInput struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeURL string `abi:"msgTypeUrl"`
+	Pagination query.PageRequest
+}
+
+// GranterGrantsInput is a struct to represent the input information for
+// the granterGrants query. Needed to unpack arguments into the PageRequest struct.
+type GranterGrantsInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// GranteeGrantsInput is a struct to represent the input information for
+// the granteeGrants query. Needed to unpack arguments into the PageRequest struct.
+type GranteeGrantsInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// NewGrantsRequest creates a new QueryGrantsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput struct: %s", err)
+	}
+
+	granter, grantee, err := parseGranterGrantee(input.Granter, input.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	if bytes.Equal(input.Pagination.Key, []byte{0}) {
+		input.Pagination.Key = nil
+	}
+
+	return &authz.QueryGrantsRequest{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: input.MsgTypeURL,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewGranterGrantsRequest creates a new QueryGranterGrantsRequest instance and does
+// sanity checks on the given arguments before populating the request.
+func NewGranterGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	if bytes.Equal(input.Pagination.Key, []byte{0}) {
+		input.Pagination.Key = nil
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    granterAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewGranteeGrantsRequest creates a new QueryGranteeGrantsRequest instance and does
+// sanity checks on the given arguments before populating the request.
+func NewGranteeGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	if bytes.Equal(input.Pagination.Key, []byte{0}) {
+		input.Pagination.Key = nil
+	}
+
+	return &authz.QueryGranteeGrantsRequest{
+		Grantee:    granteeAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// GrantsOutput is a struct to represent the key information from
+// a grants, granterGrants or granteeGrants response.
+type GrantsOutput struct {
+	Authorizations []GrantAuthorization
+	PageResponse   query.PageResponse
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the grants of an
+// authz query response.
+func (o *GrantsOutput) FromGrantAuthorizations(grants []*authz.GrantAuthorization, pageRes *query.PageResponse, cdc codec.Codec, addrCdc address.Codec) (*GrantsOutput, error) {
+	o.Authorizations = make([]GrantAuthorization, len(grants))
+	for i, grant := range grants {
+		granter, err := addrCdc.StringToBytes(grant.Granter)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGranter, grant.Granter)
+		}
+
+		grantee, err := addrCdc.StringToBytes(grant.Grantee)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGrantee, grant.Grantee)
+		}
+
+		authorization, err := newGrantAuthorization(common.BytesToAddress(granter), common.BytesToAddress(grantee), grant.Authorization, grant.Expiration, cdc)
+		if err != nil {
+			return nil, err
+		}
+		o.Authorizations[i] = authorization
+	}
+
+	o.setPageResponse(pageRes)
+
+	return o, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (o *GrantsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(o.Authorizations, o.PageResponse)
+}
+
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse.Total = pageRes.Total
+		o.PageResponse.NextKey = pageRes.NextKey
+	}
+}
+
+// newGrantAuthorization returns the ABI representation of an authorization,
+// which holds the JSON encoding of the authorization.
+func newGrantAuthorization(granter, grantee common.Address, authorizationAny *codectypes.Any, expiration *time.Time, cdc codec.Codec) (GrantAuthorization, error) {
+	var authorization authz.Authorization
+	if err := cdc.UnpackAny(authorizationAny, &authorization); err != nil {
+		return GrantAuthorization{}, err
+	}
+
+	bz, err := cdc.MarshalInterfaceJSON(authorization)
+	if err != nil {
+		return GrantAuthorization{}, err
+	}
+
+	var exp int64
+	if expiration != nil {
+		exp = expiration.Unix()
+	}
+
+	return GrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		MsgTypeURL:    authorization.MsgTypeURL(),
+		Authorization: bz,
+		Expiration:    exp,
+	}, nil
+}
+
+// newMsgGrant creates a new authz MsgGrant for the given authorization. A zero
+// expiration defines an authorization that does not expire.
+func newMsgGrant(granter, grantee common.Address, authorization authz.Authorization, expiration int64, addrCdc address.Codec) (*authz.MsgGrant, error) {
+	if msgTypeURL := authorization.MsgTypeURL(); !isAllowedMsgType(msgTypeURL) {
+		return nil, fmt.Errorf(ErrMsgTypeNotAllowed, msgTypeURL)
+	}
+
+	if expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	}
+
+	var exp *time.Time
+	if expiration > 0 {
+		t := time.Unix(expiration, 0).UTC()
+		exp = &t
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	msg := &authz.MsgGrant{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+		Grant:   authz.Grant{Expiration: exp},
+	}
+	if err := msg.SetAuthorization(authorization); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// parseGranterGrantee parses the granter and grantee addresses from the given
+// arguments.
+func parseGranterGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
+
+// newAllowedMsgTypes returns the set of the given msg types.
+func newAllowedMsgTypes(msgTypes ...string) map[string]struct{} {
+	allowed := make(map[string]struct{}, len(msgTypes))
+	for _, msgType := range msgTypes {
+		allowed[msgType] = struct{}{}
+	}
+	return allowed
+}
+
+// isAllowedMsgType returns true if the given msg type can be granted or
+// executed through the precompile.
+func isAllowedMsgType(msgTypeURL string) bool {
+	_, found := allowedMsgTypes[msgTypeURL]
+	return found
+}
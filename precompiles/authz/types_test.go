@@ -0,0 +1,391 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cosmosante "github.com/cosmos/evm/ante/cosmos"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestNewMsgGrantGenericAuthorization(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	expiration := time.Now().Add(time.Hour).Unix()
+
+	expGranterAddr, err := addrCodec.BytesToString(granter.Bytes())
+	require.NoError(t, err)
+	expGranteeAddr, err := addrCodec.BytesToString(grantee.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		expExpiration *time.Time
+	}{
+		{
+			name:    "valid - no expiration",
+			args:    []interface{}{granter, grantee, msgTypeURL, int64(0)},
+			wantErr: false,
+		},
+		{
+			name:          "valid - with expiration",
+			args:          []interface{}{granter, grantee, msgTypeURL, expiration},
+			wantErr:       false,
+			expExpiration: func() *time.Time { t := time.Unix(expiration, 0).UTC(); return &t }(),
+		},
+		{
+			name:    "invalid number of arguments",
+			args:    []interface{}{granter, grantee, msgTypeURL},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 3),
+		},
+		{
+			name:    "invalid granter",
+			args:    []interface{}{common.Address{}, grantee, msgTypeURL, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid granter address",
+		},
+		{
+			name:    "invalid grantee",
+			args:    []interface{}{granter, "not-an-address", msgTypeURL, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid grantee address",
+		},
+		{
+			name:    "empty msg type URL",
+			args:    []interface{}{granter, grantee, "", int64(0)},
+			wantErr: true,
+			errMsg:  "invalid msg type URL",
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granter, grantee, msgTypeURL, int64(-1)},
+			wantErr: true,
+			errMsg:  "invalid expiration",
+		},
+		{
+			name:    "disabled msg type",
+			args:    []interface{}{granter, grantee, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)},
+			wantErr: true,
+			errMsg:  "cannot be granted or executed through the authz precompile",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, granterAddr, granteeAddr, err := NewMsgGrantGenericAuthorization(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granter, granterAddr)
+			require.Equal(t, grantee, granteeAddr)
+			require.Equal(t, expGranterAddr, msg.Granter)
+			require.Equal(t, expGranteeAddr, msg.Grantee)
+			require.Equal(t, tt.expExpiration, msg.Grant.Expiration)
+
+			authorization, err := msg.GetAuthorization()
+			require.NoError(t, err)
+			require.Equal(t, msgTypeURL, authorization.MsgTypeURL())
+		})
+	}
+}
+
+func TestNewMsgGrantStakeAuthorization(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	abi, err := LoadABI()
+	require.NoError(t, err)
+	method := abi.Methods[GrantStakeAuthorizationMethod]
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	validator := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	tests := []struct {
+		name         string
+		args         []interface{}
+		wantErr      bool
+		errMsg       string
+		expMsgType   string
+		expMaxTokens *sdk.Coin
+	}{
+		{
+			name:       "valid - delegate without limit",
+			args:       []interface{}{granter, grantee, uint8(1), []common.Address{validator}, []common.Address{}, cmn.Coin{Denom: "atom", Amount: big.NewInt(0)}, int64(0)},
+			wantErr:    false,
+			expMsgType: sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		},
+		{
+			name:         "valid - undelegate with limit",
+			args:         []interface{}{granter, grantee, uint8(2), []common.Address{}, []common.Address{validator}, cmn.Coin{Denom: "atom", Amount: big.NewInt(100)}, int64(0)},
+			wantErr:      false,
+			expMsgType:   sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+			expMaxTokens: &sdk.Coin{Denom: "atom", Amount: math.NewInt(100)},
+		},
+		{
+			name:    "invalid number of arguments",
+			args:    []interface{}{granter, grantee, uint8(1)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 3),
+		},
+		{
+			name:    "unspecified authorization type",
+			args:    []interface{}{granter, grantee, uint8(0), []common.Address{validator}, []common.Address{}, cmn.Coin{Denom: "atom", Amount: big.NewInt(0)}, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid stake authorization type",
+		},
+		{
+			name:    "unknown authorization type",
+			args:    []interface{}{granter, grantee, uint8(5), []common.Address{validator}, []common.Address{}, cmn.Coin{Denom: "atom", Amount: big.NewInt(0)}, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid stake authorization type",
+		},
+		{
+			name:    "both allowed and denied validators",
+			args:    []interface{}{granter, grantee, uint8(1), []common.Address{validator}, []common.Address{validator}, cmn.Coin{Denom: "atom", Amount: big.NewInt(0)}, int64(0)},
+			wantErr: true,
+			errMsg:  "cannot set both allowed & deny list",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, _, _, err := NewMsgGrantStakeAuthorization(&method, tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			authorization, err := msg.GetAuthorization()
+			require.NoError(t, err)
+			require.Equal(t, tt.expMsgType, authorization.MsgTypeURL())
+
+			stakeAuthorization, ok := authorization.(*stakingtypes.StakeAuthorization)
+			require.True(t, ok)
+			require.Equal(t, tt.expMaxTokens, stakeAuthorization.MaxTokens)
+		})
+	}
+}
+
+func TestNewMsgRevoke(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	expGranterAddr, err := addrCodec.BytesToString(granter.Bytes())
+	require.NoError(t, err)
+	expGranteeAddr, err := addrCodec.BytesToString(grantee.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid",
+			args:    []interface{}{granter, grantee, msgTypeURL},
+			wantErr: false,
+		},
+		{
+			name:    "invalid number of arguments",
+			args:    []interface{}{granter, grantee},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2),
+		},
+		{
+			name:    "invalid granter",
+			args:    []interface{}{"not-an-address", grantee, msgTypeURL},
+			wantErr: true,
+			errMsg:  "invalid granter address",
+		},
+		{
+			name:    "empty msg type URL",
+			args:    []interface{}{granter, grantee, ""},
+			wantErr: true,
+			errMsg:  "invalid msg type URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, _, _, err := NewMsgRevoke(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, &authz.MsgRevoke{Granter: expGranterAddr, Grantee: expGranteeAddr, MsgTypeUrl: msgTypeURL}, msg)
+		})
+	}
+}
+
+func TestNewMsgExec(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	authz.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+
+	sendMsg := banktypes.NewMsgSend(granter.Bytes(), grantee.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))
+	sendMsgJSON, err := cdc.MarshalInterfaceJSON(sendMsg)
+	require.NoError(t, err)
+
+	execMsgJSON, err := cdc.MarshalInterfaceJSON(&authz.MsgExec{Grantee: sendMsg.FromAddress})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid",
+			args:    []interface{}{grantee, [][]byte{sendMsgJSON}},
+			wantErr: false,
+		},
+		{
+			name:    "invalid number of arguments",
+			args:    []interface{}{grantee},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+		},
+		{
+			name:    "invalid grantee",
+			args:    []interface{}{common.Address{}, [][]byte{sendMsgJSON}},
+			wantErr: true,
+			errMsg:  "invalid grantee address",
+		},
+		{
+			name:    "no messages",
+			args:    []interface{}{grantee, [][]byte{}},
+			wantErr: true,
+			errMsg:  "invalid msgs",
+		},
+		{
+			name:    "invalid message JSON",
+			args:    []interface{}{grantee, [][]byte{[]byte("{")}},
+			wantErr: true,
+			errMsg:  "message 0",
+		},
+		{
+			name:    "nested exec",
+			args:    []interface{}{grantee, [][]byte{sendMsgJSON, execMsgJSON}},
+			wantErr: true,
+			errMsg:  "cannot be granted or executed through the authz precompile",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, granteeAddr, err := NewMsgExec(tt.args, cdc, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, grantee, granteeAddr)
+
+			msgs, err := msg.GetMessages()
+			require.NoError(t, err)
+			require.Len(t, msgs, 1)
+			require.Equal(t, sendMsg, msgs[0])
+		})
+	}
+}
+
+func TestAllowedMsgTypes(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	authz.RegisterInterfaces(interfaceRegistry)
+	sdkvesting.RegisterInterfaces(interfaceRegistry)
+	erc20types.RegisterInterfaces(interfaceRegistry)
+	evmtypes.RegisterInterfaces(interfaceRegistry)
+	ibctransfertypes.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+
+	// the msg types disabled by the ante handler are not allowed in the precompile
+	for _, msgTypeURL := range cosmosante.DefaultDisabledAuthzMsgs {
+		require.False(t, isAllowedMsgType(msgTypeURL), msgTypeURL)
+	}
+
+	// the msgs executing EVM calls or nesting authz msgs are not allowed
+	msgs := []sdk.Msg{
+		evmtypes.NewTx(&evmtypes.EvmTxArgs{ChainID: big.NewInt(1), GasLimit: 21000, GasPrice: big.NewInt(1)}),
+		&sdkvesting.MsgCreateVestingAccount{},
+		&erc20types.MsgConvertERC20{},
+		&erc20types.MsgConvertCoin{},
+		&erc20types.MsgRegisterERC20{},
+		&ibctransfertypes.MsgTransfer{},
+		&authz.MsgExec{},
+		&authz.MsgGrant{},
+	}
+
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		t.Run(msgTypeURL, func(t *testing.T) {
+			grantMsg, _, _, err := NewMsgGrantGenericAuthorization([]interface{}{granter, grantee, msgTypeURL, int64(0)}, addrCodec)
+			require.ErrorContains(t, err, fmt.Sprintf(ErrMsgTypeNotAllowed, msgTypeURL))
+			require.Nil(t, grantMsg)
+
+			msgJSON, err := cdc.MarshalInterfaceJSON(msg)
+			require.NoError(t, err)
+
+			execMsg, _, err := NewMsgExec([]interface{}{grantee, [][]byte{msgJSON}}, cdc, addrCodec)
+			require.ErrorContains(t, err, fmt.Sprintf(ErrMsgTypeNotAllowed, msgTypeURL))
+			require.Nil(t, execMsg)
+		})
+	}
+}
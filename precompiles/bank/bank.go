@@ -1,7 +1,7 @@
 //
 // The bank package contains the implementation of the x/bank module precompile.
 // The precompiles returns all bank's information in the original decimals
-// representation stored in the module.
+// representation stored in the module, and allows to transfer any bank coin.
 
 package bank
 
@@ -30,6 +30,17 @@ const (
 
 	// GasSupplyOf defines the gas cost for a single ERC-20 supplyOf query, taken from totalSupply of ERC20
 	GasSupplyOf = 2_477
+
+	// GasSend defines the base gas cost for a bank send transaction
+	GasSend = 4_000
+
+	// GasMultiSend defines the base gas cost for a bank multiSend transaction
+	GasMultiSend = 4_000
+
+	// GasPerCoin defines the gas cost for each coin transferred by a bank send
+	// or multiSend transaction, so that a single coin send costs the same as an
+	// ERC-20 transfer
+	GasPerCoin = 5_000
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -97,23 +108,35 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasTotalSupply
 	case SupplyOfMethod:
 		return GasSupplyOf
+	case SendMethod:
+		return GasSend
+	case MultiSendMethod:
+		return GasMultiSend
 	}
 
 	return 0
 }
 
-// Run executes the precompiled contract bank query methods defined in the ABI.
+// Run executes the precompiled contract bank methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
-	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
 	}
 
-	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// Start the balance change handler before executing the precompile.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
 	switch method.Name {
+	// Bank transactions
+	case SendMethod:
+		bz, err = p.Send(ctx, contract, stateDB, method, args)
+	case MultiSendMethod:
+		bz, err = p.MultiSend(ctx, contract, stateDB, method, args)
 	// Bank queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, contract, method, args)
@@ -135,11 +158,25 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, vm.ErrOutOfGas
 	}
 
+	// Process the native balance changes after the method execution.
+	if err = p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB); err != nil {
+		return nil, err
+	}
+
 	return bz, nil
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
-// It returns false since all bank methods are queries.
-func (Precompile) IsTransaction(_ *abi.Method) bool {
-	return false
+//
+// Available bank transactions are:
+//   - Send
+//   - MultiSend
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case SendMethod,
+		MultiSendMethod:
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,9 @@
+package bank
+
+const (
+	// ErrExtendedDenom is raised when trying to send the extended denom of the EVM coin
+	// instead of its integer denom.
+	ErrExtendedDenom = "cannot send the extended denom %s, use %s instead"
+	// ErrInvalidAmount is raised when the given sdk coins amount is invalid
+	ErrInvalidAmount = "invalid amount %s"
+)
@@ -0,0 +1,140 @@
+package bank
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send
+	// transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send transfers the given coins from the caller to the recipient. The
+// transfer is subject to the same checks as the x/bank MsgSend.
+func (p Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	recipient, coins, err := ParseSendArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.sendCoins(ctx, stateDB, contract.Caller(), recipient, coins); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend transfers coins from the caller to multiple recipients. The
+// transfer is subject to the same checks as the x/bank MsgMultiSend, i.e. there
+// must be a single input, and the sum of the inputs must match the sum of the
+// outputs.
+func (p Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgMultiSend(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Inputs[0].Address)
+	if err != nil {
+		return nil, err
+	}
+
+	senderHexAddr := common.BytesToAddress(sender)
+	if contract.Caller() != senderHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, contract.Caller(), senderHexAddr)
+	}
+
+	for _, output := range msg.Outputs {
+		recipient, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.sendCoins(ctx, stateDB, senderHexAddr, common.BytesToAddress(recipient), output.Coins); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// sendCoins performs the checks of the x/bank MsgSend handler and transfers the
+// coins through the bank keeper. Each transferred coin is charged GasPerCoin and
+// emits a Send event.
+func (p Precompile) sendCoins(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	sender, recipient common.Address,
+	coins sdk.Coins,
+) error {
+	ctx.GasMeter().ConsumeGas(GasPerCoin*uint64(len(coins)), "bank precompile coins transfer")
+
+	// the balance changes of the EVM denom are tracked on its integer
+	// representation, so the extended denom cannot be sent directly
+	evmDenom, extendedDenom := evmtypes.GetEVMCoinDenom(), evmtypes.GetEVMCoinExtendedDenom()
+	if evmDenom != extendedDenom && coins.AmountOf(extendedDenom).IsPositive() {
+		return fmt.Errorf(ErrExtendedDenom, extendedDenom, evmDenom)
+	}
+
+	for _, coin := range coins {
+		if !p.bankKeeper.IsSendEnabledCoin(ctx, coin) {
+			return banktypes.ErrSendDisabled.Wrapf("%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	if p.bankKeeper.BlockedAddr(recipient.Bytes()) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", sdk.AccAddress(recipient.Bytes()))
+	}
+
+	// The keeper can emit more than one set of events for a single transfer
+	// (e.g. x/precisebank for the EVM denom), which would be accounted twice by
+	// the balance handler. Its events are discarded in favour of the ones of a
+	// plain x/bank transfer of the given coins.
+	transferCtx := ctx.WithEventManager(sdk.NewEventManager())
+	if err := p.bankKeeper.SendCoins(transferCtx, sender.Bytes(), recipient.Bytes(), coins); err != nil {
+		return err
+	}
+
+	senderAddr, recipientAddr := sdk.AccAddress(sender.Bytes()), sdk.AccAddress(recipient.Bytes())
+	ctx.EventManager().EmitEvents(sdk.Events{
+		banktypes.NewCoinSpentEvent(senderAddr, coins),
+		banktypes.NewCoinReceivedEvent(recipientAddr, coins),
+		sdk.NewEvent(
+			banktypes.EventTypeTransfer,
+			sdk.NewAttribute(banktypes.AttributeKeyRecipient, recipientAddr.String()),
+			sdk.NewAttribute(banktypes.AttributeKeySender, senderAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	})
+
+	return p.EmitSendEvent(ctx, stateDB, sender, recipient, coins)
+}
@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // Balance contains the amount for a corresponding ERC-20 contract address.
@@ -17,6 +22,24 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// Input defines the account sending coins in a bank MultiSend transaction.
+type Input struct {
+	Addr  common.Address
+	Coins []cmn.Coin
+}
+
+// Output defines an account receiving coins in a bank MultiSend transaction.
+type Output struct {
+	Addr  common.Address
+	Coins []cmn.Coin
+}
+
+// MultiSendInput defines the input arguments of the bank MultiSend transaction.
+type MultiSendInput struct {
+	Inputs  []Input
+	Outputs []Output
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -44,3 +67,86 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// ParseSendArgs parses the call arguments for the bank Send transaction.
+func ParseSendArgs(args []interface{}) (common.Address, sdk.Coins, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	recipient, ok := args[0].(common.Address)
+	if !ok || recipient == (common.Address{}) {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidHexAddress, args[0])
+	}
+
+	coins, err := newSendCoins(args[1])
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return recipient, coins, nil
+}
+
+// NewMsgMultiSend creates a new bank MsgMultiSend from the call arguments of
+// the bank MultiSend transaction, and checks the inputs and outputs as the
+// x/bank MsgMultiSend handler does.
+func NewMsgMultiSend(method *abi.Method, args []interface{}) (*banktypes.MsgMultiSend, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input MultiSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to MultiSendInput: %s", err)
+	}
+
+	switch {
+	case len(input.Inputs) == 0:
+		return nil, banktypes.ErrNoInputs
+	case len(input.Inputs) != 1:
+		return nil, banktypes.ErrMultipleSenders
+	case len(input.Outputs) == 0:
+		return nil, banktypes.ErrNoOutputs
+	}
+
+	coins, err := newSendCoins(input.Inputs[0].Coins)
+	if err != nil {
+		return nil, err
+	}
+	msgInput := banktypes.NewInput(input.Inputs[0].Addr.Bytes(), coins)
+
+	msgOutputs := make([]banktypes.Output, len(input.Outputs))
+	for i, output := range input.Outputs {
+		coins, err := newSendCoins(output.Coins)
+		if err != nil {
+			return nil, err
+		}
+		msgOutputs[i] = banktypes.NewOutput(output.Addr.Bytes(), coins)
+	}
+
+	if err := banktypes.ValidateInputOutputs(msgInput, msgOutputs); err != nil {
+		return nil, err
+	}
+
+	return banktypes.NewMsgMultiSend(msgInput, msgOutputs), nil
+}
+
+// newSendCoins converts the given ABI coins into the amount of a bank transfer,
+// which must be valid and positive.
+func newSendCoins(v interface{}) (sdk.Coins, error) {
+	coins, err := cmn.ToCoins(v)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidAmount, "amount arg")
+	}
+
+	amount, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidAmount, "amount arg")
+	}
+
+	if !amount.IsValid() || !amount.IsAllPositive() {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidCoins, amount.String())
+	}
+
+	return amount, nil
+}
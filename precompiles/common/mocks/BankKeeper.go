@@ -17,6 +17,24 @@ type BankKeeper struct {
 	mock.Mock
 }
 
+// BlockedAddr provides a mock function with given fields: addr
+func (_m *BankKeeper) BlockedAddr(addr types.AccAddress) bool {
+	ret := _m.Called(addr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockedAddr")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.AccAddress) bool); ok {
+		r0 = rf(addr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // GetBalance provides a mock function with given fields: ctx, addr, denom
 func (_m *BankKeeper) GetBalance(ctx context.Context, addr types.AccAddress, denom string) types.Coin {
 	ret := _m.Called(ctx, addr, denom)
@@ -81,6 +99,24 @@ func (_m *BankKeeper) GetSupply(ctx context.Context, denom string) types.Coin {
 	return r0
 }
 
+// IsSendEnabledCoin provides a mock function with given fields: ctx, coin
+func (_m *BankKeeper) IsSendEnabledCoin(ctx context.Context, coin types.Coin) bool {
+	ret := _m.Called(ctx, coin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsSendEnabledCoin")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, types.Coin) bool); ok {
+		r0 = rf(ctx, coin)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IterateAccountBalances provides a mock function with given fields: ctx, account, cb
 func (_m *BankKeeper) IterateAccountBalances(ctx context.Context, account types.AccAddress, cb func(types.Coin) bool) {
 	_m.Called(ctx, account, cb)
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "./IERC20Metadata.sol";
+import "./IERC20Permit.sol";
+import "./IERC3009.sol";
+
+/**
+ * @dev Interface of the ERC20 precompiles, which extends the ERC20 standard
+ * with the metadata, the EIP-2612 permit and the EIP-3009 transfer with
+ * authorization extensions.
+ */
+interface IERC20Precompile is IERC20Metadata, IERC20Permit, IERC3009 {}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @dev Interface of the ERC20 transfer with authorization extension allowing transfers to be made via
+ * signatures, as defined in https://eips.ethereum.org/EIPS/eip-3009[EIP-3009].
+ *
+ * Authorizations use random 32 bytes nonces instead of sequential ones, so that an account can create
+ * several authorizations which can be used in any order.
+ */
+interface IERC3009 {
+    /**
+     * @dev Emitted when the authorization with the given `nonce` of the `authorizer` is used.
+     */
+    event AuthorizationUsed(address indexed authorizer, bytes32 indexed nonce);
+
+    /**
+     * @dev Emitted when the authorization with the given `nonce` of the `authorizer` is canceled.
+     */
+    event AuthorizationCanceled(address indexed authorizer, bytes32 indexed nonce);
+
+    /**
+     * @dev Returns true if the authorization with the given `nonce` of the `authorizer`
+     * has been used or canceled.
+     */
+    function authorizationState(address authorizer, bytes32 nonce) external view returns (bool);
+
+    /**
+     * @dev Transfers `value` tokens from `from` to `to`, given ``from``'s signed authorization.
+     *
+     * Emits an {AuthorizationUsed} and a {Transfer} event.
+     *
+     * Requirements:
+     *
+     * - the current block time must be after `validAfter` and before `validBefore`.
+     * - the authorization with the given `nonce` must not have been used or canceled.
+     * - `v`, `r` and `s` must be a valid `secp256k1` signature from `from`
+     * over the EIP712-formatted function arguments.
+     */
+    function transferWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /**
+     * @dev Receives `value` tokens from `from`, given ``from``'s signed authorization.
+     * Unlike {transferWithAuthorization}, the caller must be the recipient `to`, which
+     * prevents front-running the authorization when it is submitted by a contract.
+     *
+     * Emits an {AuthorizationUsed} and a {Transfer} event.
+     */
+    function receiveWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /**
+     * @dev Cancels the authorization with the given `nonce` of the `authorizer`,
+     * given ``authorizer``'s signed cancellation.
+     *
+     * Emits an {AuthorizationCanceled} event.
+     */
+    function cancelAuthorization(
+        address authorizer,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+}
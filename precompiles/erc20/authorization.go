@@ -0,0 +1,207 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TransferWithAuthorizationMethod defines the ABI method name for the
+	// EIP-3009 transferWithAuthorization transaction.
+	TransferWithAuthorizationMethod = "transferWithAuthorization"
+	// ReceiveWithAuthorizationMethod defines the ABI method name for the
+	// EIP-3009 receiveWithAuthorization transaction.
+	ReceiveWithAuthorizationMethod = "receiveWithAuthorization"
+	// CancelAuthorizationMethod defines the ABI method name for the EIP-3009
+	// cancelAuthorization transaction.
+	CancelAuthorizationMethod = "cancelAuthorization"
+	// AuthorizationStateMethod defines the ABI method name for the EIP-3009
+	// authorizationState query.
+	AuthorizationStateMethod = "authorizationState"
+)
+
+var (
+	// transferWithAuthorizationTypeHash is the EIP-712 type hash of the EIP-3009
+	// transfer authorization.
+	transferWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte("TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+	// receiveWithAuthorizationTypeHash is the EIP-712 type hash of the EIP-3009
+	// receive authorization.
+	receiveWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte("ReceiveWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+	// cancelAuthorizationTypeHash is the EIP-712 type hash of the EIP-3009
+	// authorization cancellation.
+	cancelAuthorizationTypeHash = crypto.Keccak256Hash([]byte("CancelAuthorization(address authorizer,bytes32 nonce)"))
+)
+
+// TransferWithAuthorization executes a transfer from the sender address to the
+// destination address, given the sender's EIP-712 signed authorization. It
+// marks the authorization as used and emits the AuthorizationUsed and Transfer
+// events on success.
+func (p *Precompile) TransferWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.transferWithAuthorization(ctx, contract, stateDB, method, args)
+}
+
+// ReceiveWithAuthorization executes a transfer from the sender address to the
+// caller, given the sender's EIP-712 signed authorization. Requiring the caller
+// to be the destination address prevents front-running the authorization when
+// it is submitted by a contract.
+func (p *Precompile) ReceiveWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.transferWithAuthorization(ctx, contract, stateDB, method, args)
+}
+
+// CancelAuthorization cancels an authorization that has not been used yet,
+// given the authorizer's EIP-712 signed cancellation. It emits the
+// AuthorizationCanceled event on success.
+func (p Precompile) CancelAuthorization(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorizer, nonce, sig, err := ParseCancelAuthorizationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.erc20Keeper.IsAuthorizationUsed(ctx, p.Address(), authorizer, nonce) {
+		return nil, ErrAuthorizationUsed
+	}
+
+	structHash := crypto.Keccak256Hash(
+		cancelAuthorizationTypeHash.Bytes(),
+		common.LeftPadBytes(authorizer.Bytes(), 32),
+		nonce.Bytes(),
+	)
+	if err := p.verifyAuthorizationSigner(ctx, structHash, sig, authorizer); err != nil {
+		return nil, err
+	}
+
+	p.erc20Keeper.SetAuthorizationUsed(ctx, p.Address(), authorizer, nonce)
+
+	if err := p.EmitAuthorizationCanceledEvent(ctx, stateDB, authorizer, nonce); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// AuthorizationState returns true if the authorization with the given nonce of
+// the given authorizer has been used or canceled.
+func (p Precompile) AuthorizationState(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorizer, nonce, err := ParseAuthorizationStateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	used := p.erc20Keeper.IsAuthorizationUsed(ctx, p.Address(), authorizer, nonce)
+
+	return method.Outputs.Pack(used)
+}
+
+// transferWithAuthorization is a common function that handles the EIP-3009
+// TransferWithAuthorization and ReceiveWithAuthorization methods. They differ in
+// the type hash of the signed authorization, and the latter requires the caller
+// to be the destination address.
+func (p *Precompile) transferWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from, to, amount, validAfter, validBefore, nonce, sig, err := ParseTransferWithAuthorizationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	typeHash := transferWithAuthorizationTypeHash
+	if method.Name == ReceiveWithAuthorizationMethod {
+		if contract.Caller() != to {
+			return nil, ErrAuthorizationCallerNotPayee
+		}
+		typeHash = receiveWithAuthorizationTypeHash
+	}
+
+	now := big.NewInt(ctx.BlockTime().Unix())
+	if now.Cmp(validAfter) <= 0 {
+		return nil, ErrAuthorizationNotYetValid
+	}
+	if now.Cmp(validBefore) >= 0 {
+		return nil, ErrAuthorizationExpired
+	}
+
+	if p.erc20Keeper.IsAuthorizationUsed(ctx, p.Address(), from, nonce) {
+		return nil, ErrAuthorizationUsed
+	}
+
+	structHash := crypto.Keccak256Hash(
+		typeHash.Bytes(),
+		common.LeftPadBytes(from.Bytes(), 32),
+		common.LeftPadBytes(to.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(amount)),
+		math.U256Bytes(new(big.Int).Set(validAfter)),
+		math.U256Bytes(new(big.Int).Set(validBefore)),
+		nonce.Bytes(),
+	)
+	if err := p.verifyAuthorizationSigner(ctx, structHash, sig, from); err != nil {
+		return nil, err
+	}
+
+	p.erc20Keeper.SetAuthorizationUsed(ctx, p.Address(), from, nonce)
+
+	if err := p.EmitAuthorizationUsedEvent(ctx, stateDB, from, nonce); err != nil {
+		return nil, err
+	}
+
+	if err := p.executeTransfer(ctx, stateDB, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// verifyAuthorizationSigner checks that the EIP-712 signature of the given
+// struct hash has been produced by the expected signer.
+func (p Precompile) verifyAuthorizationSigner(
+	ctx sdk.Context,
+	structHash common.Hash,
+	sig []byte,
+	expSigner common.Address,
+) error {
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return err
+	}
+
+	signer, err := recoverSigner(typedDataHash(domainSeparator, structHash), sig)
+	if err != nil || signer != expSigner {
+		return ErrAuthorizationInvalidSigner
+	}
+
+	return nil
+}
@@ -26,11 +26,24 @@ var (
 	ErrIncreaseNonPositiveValue = errors.New("cannot increase allowance with non-positive values")
 	ErrNegativeAmount           = errors.New("cannot approve negative values")
 	ErrSpenderIsOwner           = errors.New("spender cannot be the owner")
+	ErrInvalidSignatureValues   = errors.New("invalid signature values")
 
 	// ERC20 errors
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
 	ErrInsufficientAllowance        = errors.New("ERC20: insufficient allowance")
 	ErrTransferAmountExceedsBalance = errors.New("ERC20: transfer amount exceeds balance")
+
+	// EIP-2612 errors
+	ErrPermitExpired        = errors.New("ERC20Permit: expired deadline")
+	ErrPermitInvalidSigner  = errors.New("ERC20Permit: invalid signature")
+	ErrPermitInvalidSpender = errors.New("ERC20Permit: approve to the zero address")
+
+	// EIP-3009 errors
+	ErrAuthorizationNotYetValid    = errors.New("EIP3009: authorization is not yet valid")
+	ErrAuthorizationExpired        = errors.New("EIP3009: authorization is expired")
+	ErrAuthorizationUsed           = errors.New("EIP3009: authorization is used or canceled")
+	ErrAuthorizationInvalidSigner  = errors.New("EIP3009: invalid signature")
+	ErrAuthorizationCallerNotPayee = errors.New("EIP3009: caller must be the payee")
 )
 
 // ConvertErrToERC20Error is a helper function which maps errors raised by the Cosmos SDK stack
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -13,4 +14,9 @@ type Erc20Keeper interface {
 	GetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) (*big.Int, error)
 	SetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address, value *big.Int) error
 	DeleteAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) error
+	GetPermitNonce(ctx sdk.Context, erc20 common.Address, owner common.Address) uint64
+	SetPermitNonce(ctx sdk.Context, erc20 common.Address, owner common.Address, nonce uint64)
+	IsAuthorizationUsed(ctx sdk.Context, erc20 common.Address, authorizer common.Address, nonce common.Hash) bool
+	SetAuthorizationUsed(ctx sdk.Context, erc20 common.Address, authorizer common.Address, nonce common.Hash)
+	GetEthChainConfig(ctx sdk.Context) *params.ChainConfig
 }
@@ -0,0 +1,170 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the EIP-2612 permit
+	// transaction.
+	PermitMethod = "permit"
+	// NoncesMethod defines the ABI method name for the EIP-2612 nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the EIP-2612
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+
+	// domainVersion defines the version of the EIP-712 signing domain of the
+	// ERC-20 precompiles.
+	domainVersion = "1"
+)
+
+var (
+	// domainTypeHash is the EIP-712 type hash of the signing domain.
+	domainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	// permitTypeHash is the EIP-712 type hash of the EIP-2612 permit.
+	permitTypeHash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+)
+
+// Permit sets the given amount as the allowance of the spender address over
+// the owner's tokens, given the owner's EIP-712 signed approval. It consumes
+// the current nonce of the owner and emits the Approval event on success.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, amount, deadline, sig, err := ParsePermitArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, ErrPermitExpired
+	}
+
+	if spender == (common.Address{}) {
+		return nil, ErrPermitInvalidSpender
+	}
+
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+	structHash := crypto.Keccak256Hash(
+		permitTypeHash.Bytes(),
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(amount)),
+		math.U256Bytes(new(big.Int).SetUint64(nonce)),
+		math.U256Bytes(new(big.Int).Set(deadline)),
+	)
+
+	signer, err := recoverSigner(typedDataHash(domainSeparator, structHash), sig)
+	if err != nil || signer != owner {
+		return nil, ErrPermitInvalidSigner
+	}
+
+	p.erc20Keeper.SetPermitNonce(ctx, p.Address(), owner, nonce+1)
+
+	if err := p.setAllowance(ctx, owner, spender, amount); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current EIP-2612 permit nonce of the given owner.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+
+	return method.Outputs.Pack(new(big.Int).SetUint64(nonce))
+}
+
+// DomainSeparator returns the EIP-712 domain separator used in the encoding of
+// the permit and transfer with authorization signatures.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(domainSeparator)
+}
+
+// domainSeparator returns the EIP-712 domain separator of the precompile,
+// which is built from the token name, the domain version, the EVM chain ID and
+// the precompile address.
+func (p Precompile) domainSeparator(ctx sdk.Context) (common.Hash, error) {
+	name, err := p.name(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	chainID := p.erc20Keeper.GetEthChainConfig(ctx).ChainID
+
+	return crypto.Keccak256Hash(
+		domainTypeHash.Bytes(),
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(domainVersion)),
+		math.U256Bytes(new(big.Int).Set(chainID)),
+		common.LeftPadBytes(p.Address().Bytes(), 32),
+	), nil
+}
+
+// typedDataHash returns the EIP-712 hash to be signed for the given domain
+// separator and struct hash.
+func typedDataHash(domainSeparator, structHash common.Hash) []byte {
+	return crypto.Keccak256([]byte("\x19\x01"), domainSeparator.Bytes(), structHash.Bytes())
+}
+
+// recoverSigner returns the address that signed the given hash. Signatures with
+// a malleable S value are rejected.
+func recoverSigner(hash, sig []byte) (common.Address, error) {
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	if !crypto.ValidateSignatureValues(sig[64], r, s, true) {
+		return common.Address{}, ErrInvalidSignatureValues
+	}
+
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -84,14 +84,6 @@ func (p *Precompile) transfer(
 	from, to common.Address,
 	amount *big.Int,
 ) (data []byte, err error) {
-	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(amount)}}
-
-	msg := banktypes.NewMsgSend(from.Bytes(), to.Bytes(), coins)
-
-	if err = msg.Amount.Validate(); err != nil {
-		return nil, err
-	}
-
 	isTransferFrom := method.Name == TransferFromMethod
 	spenderAddr := contract.Caller()
 	newAllowance := big.NewInt(0)
@@ -121,10 +113,43 @@ func (p *Precompile) transfer(
 		}
 	}
 
+	if err = p.executeTransfer(ctx, stateDB, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	// NOTE: if it's a direct transfer, we return here but if used through transferFrom,
+	// we need to emit the approval event with the new allowance.
+	if isTransferFrom {
+		if err = p.EmitApprovalEvent(ctx, stateDB, from, spenderAddr, newAllowance); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// executeTransfer sends the given amount of tokens from the sender to the
+// destination address through the bank module and emits the Transfer event.
+// It is used by the ERC-20 transfers and the EIP-3009 transfers with
+// authorization.
+func (p *Precompile) executeTransfer(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	from, to common.Address,
+	amount *big.Int,
+) error {
+	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(amount)}}
+
+	msg := banktypes.NewMsgSend(from.Bytes(), to.Bytes(), coins)
+
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+
 	msgSrv := NewMsgServerImpl(p.BankKeeper)
-	if err = msgSrv.Send(ctx, msg); err != nil {
+	if err := msgSrv.Send(ctx, msg); err != nil {
 		// This should return an error to avoid the contract from being executed and an event being emitted
-		return nil, ConvertErrToERC20Error(err)
+		return ConvertErrToERC20Error(err)
 	}
 
 	// TODO: Properly handle native balance changes via the balance handler.
@@ -134,24 +159,12 @@ func (p *Precompile) transfer(
 	if p.tokenPair.Denom == evmDenom {
 		convertedAmount, err := utils.Uint256FromBigInt(evmtypes.ConvertAmountTo18DecimalsBigInt(amount))
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		stateDB.SubBalance(from, convertedAmount, tracing.BalanceChangeUnspecified)
 		stateDB.AddBalance(to, convertedAmount, tracing.BalanceChangeUnspecified)
 	}
 
-	if err = p.EmitTransferEvent(ctx, stateDB, from, to, amount); err != nil {
-		return nil, err
-	}
-
-	// NOTE: if it's a direct transfer, we return here but if used through transferFrom,
-	// we need to emit the approval event with the new allowance.
-	if isTransferFrom {
-		if err = p.EmitApprovalEvent(ctx, stateDB, from, spenderAddr, newAllowance); err != nil {
-			return nil, err
-		}
-	}
-
-	return method.Outputs.Pack(true)
+	return p.EmitTransferEvent(ctx, stateDB, from, to, amount)
 }
@@ -21,6 +21,18 @@ type EventApproval struct {
 	Value   *big.Int
 }
 
+// EventAuthorizationUsed defines the event data for the EIP-3009 AuthorizationUsed events.
+type EventAuthorizationUsed struct {
+	Authorizer common.Address
+	Nonce      [32]byte
+}
+
+// EventAuthorizationCanceled defines the event data for the EIP-3009 AuthorizationCanceled events.
+type EventAuthorizationCanceled struct {
+	Authorizer common.Address
+	Nonce      [32]byte
+}
+
 // ParseTransferArgs parses the arguments from the transfer method and returns
 // the destination address (to) and amount.
 func ParseTransferArgs(args []interface{}) (
@@ -127,3 +139,171 @@ func ParseBalanceOfArgs(args []interface{}) (common.Address, error) {
 
 	return account, nil
 }
+
+// ParsePermitArgs parses the permit arguments and returns the owner and spender
+// addresses, the amount, the deadline and the signature of the owner.
+func ParsePermitArgs(args []interface{}) (
+	owner, spender common.Address, amount, deadline *big.Int, sig []byte, err error,
+) {
+	if len(args) != 7 {
+		return common.Address{}, common.Address{}, nil, nil, nil, fmt.Errorf("invalid number of arguments; expected 7; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, nil, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	spender, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, nil, fmt.Errorf("invalid spender address: %v", args[1])
+	}
+
+	amount, ok = args[2].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, nil, fmt.Errorf("invalid amount: %v", args[2])
+	}
+
+	deadline, ok = args[3].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, nil, fmt.Errorf("invalid deadline: %v", args[3])
+	}
+
+	sig, err = parseSignatureArgs(args[4:])
+	if err != nil {
+		return common.Address{}, common.Address{}, nil, nil, nil, err
+	}
+
+	return owner, spender, amount, deadline, sig, nil
+}
+
+// ParseNoncesArgs parses the nonces arguments and returns the owner address.
+func ParseNoncesArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	return owner, nil
+}
+
+// ParseTransferWithAuthorizationArgs parses the arguments of the
+// transferWithAuthorization and receiveWithAuthorization methods and returns the
+// sender address (from), destination address (to), amount, validity window,
+// nonce and the signature of the sender.
+func ParseTransferWithAuthorizationArgs(args []interface{}) (
+	from, to common.Address, amount, validAfter, validBefore *big.Int, nonce common.Hash, sig []byte, err error,
+) {
+	if len(args) != 9 {
+		return common.Address{}, common.Address{}, nil, nil, nil, common.Hash{}, nil, fmt.Errorf("invalid number of arguments; expected 9; got: %d", len(args))
+	}
+
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, nil, common.Hash{}, nil, fmt.Errorf("invalid from address: %v", args[0])
+	}
+
+	to, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, nil, common.Hash{}, nil, fmt.Errorf("invalid to address: %v", args[1])
+	}
+
+	amount, ok = args[2].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, nil, common.Hash{}, nil, fmt.Errorf("invalid amount: %v", args[2])
+	}
+
+	validAfter, ok = args[3].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, nil, common.Hash{}, nil, fmt.Errorf("invalid validAfter: %v", args[3])
+	}
+
+	validBefore, ok = args[4].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, nil, common.Hash{}, nil, fmt.Errorf("invalid validBefore: %v", args[4])
+	}
+
+	nonceBz, ok := args[5].([32]byte)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, nil, common.Hash{}, nil, fmt.Errorf("invalid nonce: %v", args[5])
+	}
+
+	sig, err = parseSignatureArgs(args[6:])
+	if err != nil {
+		return common.Address{}, common.Address{}, nil, nil, nil, common.Hash{}, nil, err
+	}
+
+	return from, to, amount, validAfter, validBefore, common.Hash(nonceBz), sig, nil
+}
+
+// ParseCancelAuthorizationArgs parses the cancelAuthorization arguments and
+// returns the authorizer address, the nonce and the signature of the authorizer.
+func ParseCancelAuthorizationArgs(args []interface{}) (
+	authorizer common.Address, nonce common.Hash, sig []byte, err error,
+) {
+	if len(args) != 5 {
+		return common.Address{}, common.Hash{}, nil, fmt.Errorf("invalid number of arguments; expected 5; got: %d", len(args))
+	}
+
+	authorizer, nonce, err = ParseAuthorizationStateArgs(args[:2])
+	if err != nil {
+		return common.Address{}, common.Hash{}, nil, err
+	}
+
+	sig, err = parseSignatureArgs(args[2:])
+	if err != nil {
+		return common.Address{}, common.Hash{}, nil, err
+	}
+
+	return authorizer, nonce, sig, nil
+}
+
+// ParseAuthorizationStateArgs parses the authorizationState arguments and
+// returns the authorizer address and the nonce.
+func ParseAuthorizationStateArgs(args []interface{}) (
+	authorizer common.Address, nonce common.Hash, err error,
+) {
+	if len(args) != 2 {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	authorizer, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid authorizer address: %v", args[0])
+	}
+
+	nonceBz, ok := args[1].([32]byte)
+	if !ok {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid nonce: %v", args[1])
+	}
+
+	return authorizer, common.Hash(nonceBz), nil
+}
+
+// parseSignatureArgs parses the v, r and s arguments of a signature and returns
+// the signature in the [R || S || V] format, with V being 0 or 1.
+func parseSignatureArgs(args []interface{}) ([]byte, error) {
+	v, ok := args[0].(uint8)
+	if !ok || (v != 27 && v != 28) {
+		return nil, fmt.Errorf("invalid signature v: %v", args[0])
+	}
+
+	r, ok := args[1].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid signature r: %v", args[1])
+	}
+
+	s, ok := args[2].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid signature s: %v", args[2])
+	}
+
+	sig := make([]byte, 0, 65)
+	sig = append(sig, r[:]...)
+	sig = append(sig, s[:]...)
+	return append(sig, v-27), nil
+}
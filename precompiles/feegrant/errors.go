@@ -0,0 +1,12 @@
+package feegrant
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidExpiration is raised when the expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidAllowedMessages is raised when the allowed messages are not valid.
+	ErrInvalidAllowedMessages = "invalid allowed messages: %v"
+)
@@ -0,0 +1,58 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeGrantAllowance defines the event type for the feegrant GrantAllowance transaction.
+	EventTypeGrantAllowance = "GrantAllowance"
+	// EventTypeRevokeAllowance defines the event type for the feegrant RevokeAllowance transaction.
+	EventTypeRevokeAllowance = "RevokeAllowance"
+)
+
+// EmitGrantAllowanceEvent creates a new event emitted on a GrantAllowance transaction.
+func (p Precompile) EmitGrantAllowanceEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address) error {
+	return p.emitFeegrantEvent(ctx, stateDB, EventTypeGrantAllowance, granter, grantee)
+}
+
+// EmitRevokeAllowanceEvent creates a new event emitted on a RevokeAllowance transaction.
+func (p Precompile) EmitRevokeAllowanceEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address) error {
+	return p.emitFeegrantEvent(ctx, stateDB, EventTypeRevokeAllowance, granter, grantee)
+}
+
+// emitFeegrantEvent emits an event of the given type. All the feegrant events
+// only hold the granter and grantee topics and have no data.
+func (p Precompile) emitFeegrantEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, granter, grantee common.Address) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AllowanceMethod defines the ABI method name for the feegrant Allowance
+	// query.
+	AllowanceMethod = "allowance"
+	// AllowancesMethod defines the ABI method name for the feegrant
+	// Allowances query.
+	AllowancesMethod = "allowances"
+	// AllowancesByGranterMethod defines the ABI method name for the feegrant
+	// AllowancesByGranter query.
+	AllowancesByGranterMethod = "allowancesByGranter"
+)
+
+// Allowance returns the fee allowance granted by a granter to a grantee.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewAllowanceRequest(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := NewFeeAllowance(res.Allowance, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(allowance)
+}
+
+// Allowances returns the fee allowances granted to a grantee.
+func (p Precompile) Allowances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewAllowancesRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowancesOutput).FromGrants(res.Allowances, res.Pagination, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// AllowancesByGranter returns the fee allowances granted by a granter.
+func (p Precompile) AllowancesByGranter(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewAllowancesByGranterRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.AllowancesByGranter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowancesOutput).FromGrants(res.Allowances, res.Pagination, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
@@ -0,0 +1,96 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantAllowanceMethod defines the ABI method name for the feegrant
+	// GrantAllowance transaction.
+	GrantAllowanceMethod = "grantAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant
+	// RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+// GrantAllowance grants the grantee an allowance to pay its transaction fees
+// from the granter's balance.
+func (p Precompile) GrantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgGrantAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"granter", msg.Granter,
+		"grantee", msg.Grantee,
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	if _, err = feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper).GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantAllowanceEvent(ctx, stateDB, granterHexAddr, granteeHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RevokeAllowance revokes the fee allowance granted to the grantee.
+func (p Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgRevokeAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"granter", msg.Granter,
+		"grantee", msg.Grantee,
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	if _, err = feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper).RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, granterHexAddr, granteeHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
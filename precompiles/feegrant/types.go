@@ -0,0 +1,335 @@
+package feegrant
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// EventGrantAllowance defines the event data for the feegrant GrantAllowance transaction.
+type EventGrantAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// EventRevokeAllowance defines the event data for the feegrant RevokeAllowance transaction.
+type EventRevokeAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// FeeAllowance defines a fee allowance granted by a granter to a grantee, as
+// returned by the feegrant queries.
+type FeeAllowance struct {
+	Granter   common.Address
+	Grantee   common.Address
+	Allowance []byte
+}
+
+// AllowancesInput is a struct to represent the input information for
+// the allowances query. Needed to unpack arguments into the PageRequest struct.
+type AllowancesInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// AllowancesByGranterInput is a struct to represent the input information for
+// the allowancesByGranter query. Needed to unpack arguments into the PageRequest struct.
+type AllowancesByGranterInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// NewMsgGrantAllowance creates a new feegrant MsgGrantAllowance instance and
+// does sanity checks on the given arguments before populating the message.
+// The granted allowance is a basic allowance, restricted to the given messages
+// if any is provided.
+func NewMsgGrantAllowance(args []interface{}, addrCdc address.Codec) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	coins, err := cmn.ToCoins(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, "spendLimit arg")
+	}
+
+	spendLimit, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, "spendLimit arg")
+	}
+
+	expiration, ok := args[3].(int64)
+	if !ok || expiration < 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[3])
+	}
+
+	allowedMsgs, ok := args[4].([]string)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidAllowedMessages, args[4])
+	}
+
+	basic := &feegrant.BasicAllowance{}
+	// an empty spend limit defines an allowance without limit, which must be nil
+	if !spendLimit.Empty() {
+		basic.SpendLimit = spendLimit
+	}
+	if expiration > 0 {
+		exp := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &exp
+	}
+
+	// the allowance is packed as a proto message, as done by the feegrant CLI
+	var allowance proto.Message = basic
+	if len(allowedMsgs) > 0 {
+		allowance, err = feegrant.NewAllowedMsgAllowance(basic, allowedMsgs)
+		if err != nil {
+			return nil, common.Address{}, common.Address{}, err
+		}
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	allowanceAny, err := codectypes.NewAnyWithValue(allowance)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &feegrant.MsgGrantAllowance{
+		Granter:   granterAddr,
+		Grantee:   granteeAddr,
+		Allowance: allowanceAny,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevokeAllowance creates a new feegrant MsgRevokeAllowance instance and
+// does sanity checks on the given arguments before populating the message.
+func NewMsgRevokeAllowance(args []interface{}, addrCdc address.Codec) (*feegrant.MsgRevokeAllowance, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	msg := &feegrant.MsgRevokeAllowance{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewAllowanceRequest creates a new QueryAllowanceRequest instance and does
+// sanity checks on the given arguments before populating the request.
+func NewAllowanceRequest(args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &feegrant.QueryAllowanceRequest{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}, nil
+}
+
+// NewAllowancesRequest creates a new QueryAllowancesRequest instance and does
+// sanity checks on the given arguments before populating the request.
+func NewAllowancesRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	if bytes.Equal(input.Pagination.Key, []byte{0}) {
+		input.Pagination.Key = nil
+	}
+
+	return &feegrant.QueryAllowancesRequest{
+		Grantee:    granteeAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewAllowancesByGranterRequest creates a new QueryAllowancesByGranterRequest
+// instance and does sanity checks on the given arguments before populating the
+// request.
+func NewAllowancesByGranterRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	if bytes.Equal(input.Pagination.Key, []byte{0}) {
+		input.Pagination.Key = nil
+	}
+
+	return &feegrant.QueryAllowancesByGranterRequest{
+		Granter:    granterAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// AllowancesOutput is a struct to represent the key information from
+// an allowances or allowancesByGranter response.
+type AllowancesOutput struct {
+	Allowances   []FeeAllowance
+	PageResponse query.PageResponse
+}
+
+// FromGrants populates the AllowancesOutput from the grants of a feegrant
+// query response.
+func (o *AllowancesOutput) FromGrants(grants []*feegrant.Grant, pageRes *query.PageResponse, cdc codec.Codec, addrCdc address.Codec) (*AllowancesOutput, error) {
+	o.Allowances = make([]FeeAllowance, len(grants))
+	for i, grant := range grants {
+		allowance, err := NewFeeAllowance(grant, cdc, addrCdc)
+		if err != nil {
+			return nil, err
+		}
+		o.Allowances[i] = allowance
+	}
+
+	if pageRes != nil {
+		o.PageResponse.Total = pageRes.Total
+		o.PageResponse.NextKey = pageRes.NextKey
+	}
+
+	return o, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (o *AllowancesOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(o.Allowances, o.PageResponse)
+}
+
+// NewFeeAllowance returns the ABI representation of a fee grant, which holds
+// the JSON encoding of the allowance.
+func NewFeeAllowance(grant *feegrant.Grant, cdc codec.Codec, addrCdc address.Codec) (FeeAllowance, error) {
+	granter, err := addrCdc.StringToBytes(grant.Granter)
+	if err != nil {
+		return FeeAllowance{}, fmt.Errorf(ErrInvalidGranter, grant.Granter)
+	}
+
+	grantee, err := addrCdc.StringToBytes(grant.Grantee)
+	if err != nil {
+		return FeeAllowance{}, fmt.Errorf(ErrInvalidGrantee, grant.Grantee)
+	}
+
+	var allowance feegrant.FeeAllowanceI
+	if err := cdc.UnpackAny(grant.Allowance, &allowance); err != nil {
+		return FeeAllowance{}, err
+	}
+
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return FeeAllowance{}, fmt.Errorf("cannot proto marshal %T", allowance)
+	}
+
+	bz, err := cdc.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return FeeAllowance{}, err
+	}
+
+	return FeeAllowance{
+		Granter:   common.BytesToAddress(granter),
+		Grantee:   common.BytesToAddress(grantee),
+		Allowance: bz,
+	}, nil
+}
+
+// parseGranterGrantee parses the granter and grantee addresses from the given
+// arguments.
+func parseGranterGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
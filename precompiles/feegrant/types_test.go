@@ -0,0 +1,236 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+)
+
+func TestNewMsgGrantAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	spendLimit := []cmn.Coin{{Denom: "atom", Amount: big.NewInt(1000)}}
+	expSpendLimit := sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(1000)))
+	ethTxTypeURL := sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+	expiration := time.Now().Add(time.Hour).Unix()
+
+	expGranterAddr, err := addrCodec.BytesToString(granter.Bytes())
+	require.NoError(t, err)
+	expGranteeAddr, err := addrCodec.BytesToString(grantee.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		expSpendLimit sdk.Coins
+		expExpiration *time.Time
+		expAllowedMsg []string
+	}{
+		{
+			name:    "valid - no limit and no expiration",
+			args:    []interface{}{granter, grantee, []cmn.Coin{}, int64(0), []string{}},
+			wantErr: false,
+		},
+		{
+			name:          "valid - with spend limit and expiration",
+			args:          []interface{}{granter, grantee, spendLimit, expiration, []string{}},
+			wantErr:       false,
+			expSpendLimit: expSpendLimit,
+			expExpiration: func() *time.Time { t := time.Unix(expiration, 0).UTC(); return &t }(),
+		},
+		{
+			name:          "valid - with allowed messages",
+			args:          []interface{}{granter, grantee, spendLimit, int64(0), []string{ethTxTypeURL}},
+			wantErr:       false,
+			expSpendLimit: expSpendLimit,
+			expAllowedMsg: []string{ethTxTypeURL},
+		},
+		{
+			name:    "invalid number of arguments",
+			args:    []interface{}{granter, grantee, spendLimit, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 4),
+		},
+		{
+			name:    "invalid granter",
+			args:    []interface{}{common.Address{}, grantee, spendLimit, int64(0), []string{}},
+			wantErr: true,
+			errMsg:  "invalid granter address",
+		},
+		{
+			name:    "invalid grantee",
+			args:    []interface{}{granter, "not-an-address", spendLimit, int64(0), []string{}},
+			wantErr: true,
+			errMsg:  "invalid grantee address",
+		},
+		{
+			name:    "invalid spend limit",
+			args:    []interface{}{granter, grantee, "not-coins", int64(0), []string{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidAmount, "spendLimit arg"),
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granter, grantee, spendLimit, int64(-1), []string{}},
+			wantErr: true,
+			errMsg:  "invalid expiration",
+		},
+		{
+			name:    "invalid allowed messages",
+			args:    []interface{}{granter, grantee, spendLimit, int64(0), ethTxTypeURL},
+			wantErr: true,
+			errMsg:  "invalid allowed messages",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, granterAddr, granteeAddr, err := NewMsgGrantAllowance(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granter, granterAddr)
+			require.Equal(t, grantee, granteeAddr)
+			require.Equal(t, expGranterAddr, msg.Granter)
+			require.Equal(t, expGranteeAddr, msg.Grantee)
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			basic, ok := allowance.(*feegrant.BasicAllowance)
+			if len(tt.expAllowedMsg) > 0 {
+				allowedMsgAllowance, isAllowedMsg := allowance.(*feegrant.AllowedMsgAllowance)
+				require.True(t, isAllowedMsg)
+				require.Equal(t, tt.expAllowedMsg, allowedMsgAllowance.AllowedMessages)
+
+				inner, err := allowedMsgAllowance.GetAllowance()
+				require.NoError(t, err)
+				basic, ok = inner.(*feegrant.BasicAllowance)
+			}
+			require.True(t, ok)
+			require.Equal(t, tt.expSpendLimit, basic.SpendLimit)
+			require.Equal(t, tt.expExpiration, basic.Expiration)
+		})
+	}
+}
+
+func TestNewMsgRevokeAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+
+	expGranterAddr, err := addrCodec.BytesToString(granter.Bytes())
+	require.NoError(t, err)
+	expGranteeAddr, err := addrCodec.BytesToString(grantee.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid",
+			args:    []interface{}{granter, grantee},
+			wantErr: false,
+		},
+		{
+			name:    "invalid number of arguments",
+			args:    []interface{}{granter},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+		},
+		{
+			name:    "invalid granter",
+			args:    []interface{}{"not-an-address", grantee},
+			wantErr: true,
+			errMsg:  "invalid granter address",
+		},
+		{
+			name:    "invalid grantee",
+			args:    []interface{}{granter, common.Address{}},
+			wantErr: true,
+			errMsg:  "invalid grantee address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, granterAddr, granteeAddr, err := NewMsgRevokeAllowance(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granter, granterAddr)
+			require.Equal(t, grantee, granteeAddr)
+			require.Equal(t, expGranterAddr, msg.Granter)
+			require.Equal(t, expGranteeAddr, msg.Grantee)
+		})
+	}
+}
+
+func TestNewFeeAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	feegrant.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+
+	basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(1000)))}
+	grant, err := feegrant.NewGrant(granter.Bytes(), grantee.Bytes(), basic)
+	require.NoError(t, err)
+
+	// the grant addresses are encoded with the address codec
+	grant.Granter, err = addrCodec.BytesToString(granter.Bytes())
+	require.NoError(t, err)
+	grant.Grantee, err = addrCodec.BytesToString(grantee.Bytes())
+	require.NoError(t, err)
+
+	allowance, err := NewFeeAllowance(&grant, cdc, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, granter, allowance.Granter)
+	require.Equal(t, grantee, allowance.Grantee)
+
+	var decoded feegrant.FeeAllowanceI
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(allowance.Allowance, &decoded))
+	require.Equal(t, basic, decoded)
+
+	grant.Granter = "invalid"
+	_, err = NewFeeAllowance(&grant, cdc, addrCodec)
+	require.ErrorContains(t, err, "invalid granter address")
+}
@@ -0,0 +1,18 @@
+package ics27
+
+const (
+	// ErrInvalidOwner is raised when the owner address is not valid.
+	ErrInvalidOwner = "invalid owner address: %v"
+	// ErrInvalidConnectionID is raised when the connection identifier is not valid.
+	ErrInvalidConnectionID = "invalid connection ID: %s"
+	// ErrEmptyMsgs is raised when no messages are provided to be executed.
+	ErrEmptyMsgs = "no messages to execute"
+	// ErrInvalidMsg is raised when a message is not a valid protobuf-encoded Any.
+	ErrInvalidMsg = "invalid message at index %d: %v"
+	// ErrInvalidRelativeTimeout is raised when the relative timeout is zero.
+	ErrInvalidRelativeTimeout = "relative timeout must be greater than zero"
+	// ErrNoActiveChannel is raised when the owner has no open interchain account channel on the connection.
+	ErrNoActiveChannel = "no active interchain account channel for port %s on connection %s"
+	// ErrUnsupportedEncoding is raised when the interchain account channel does not use protobuf encoding.
+	ErrUnsupportedEncoding = "unsupported interchain account encoding %s, expected %s"
+)
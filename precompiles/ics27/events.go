@@ -0,0 +1,79 @@
+package ics27
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeInterchainAccountRegistered defines the event type for the ICS27 RegisterInterchainAccount transaction.
+	EventTypeInterchainAccountRegistered = "InterchainAccountRegistered"
+	// EventTypeInterchainAccountTxSent defines the event type for the ICS27 SendTx transaction.
+	EventTypeInterchainAccountTxSent = "InterchainAccountTxSent"
+)
+
+// EmitInterchainAccountRegisteredEvent creates a new event emitted on a RegisterInterchainAccount transaction.
+func (p Precompile) EmitInterchainAccountRegisteredEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID, portID, channelID string,
+) error {
+	event := p.Events[EventTypeInterchainAccountRegistered]
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(connectionID, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	return p.emitEvent(ctx, stateDB, event, owner, packed)
+}
+
+// EmitInterchainAccountTxSentEvent creates a new event emitted on a SendTx transaction.
+func (p Precompile) EmitInterchainAccountTxSentEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID, channelID string,
+	sequence uint64,
+) error {
+	event := p.Events[EventTypeInterchainAccountTxSent]
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(connectionID, channelID, sequence)
+	if err != nil {
+		return err
+	}
+
+	return p.emitEvent(ctx, stateDB, event, owner, packed)
+}
+
+// emitEvent emits the given event with the owner as indexed topic and the
+// given packed data. All the ICS27 events are indexed by the owner only.
+func (p Precompile) emitEvent(ctx sdk.Context, stateDB vm.StateDB, event abi.Event, owner common.Address, data []byte) error {
+	// Prepare the event topics
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
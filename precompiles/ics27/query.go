@@ -0,0 +1,40 @@
+package ics27
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// InterchainAccountMethod defines the ABI method name for the ICS27
+	// InterchainAccount query.
+	InterchainAccountMethod = "interchainAccount"
+)
+
+// InterchainAccount returns the address of the interchain account of the owner
+// on the host chain of the given connection. An empty string is returned if the
+// account is not registered.
+func (p Precompile) InterchainAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	owner, connectionID, err := NewInterchainAccountArgs(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	accountAddress, _ := p.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+
+	return method.Outputs.Pack(accountAddress)
+}
@@ -0,0 +1,144 @@
+package ics27
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the ICS27
+	// RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the ICS27 SendTx
+	// transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount initiates the channel handshake to register an
+// interchain account for the owner on the host chain of the given connection.
+func (p Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, ownerHexAddr, err := NewMsgRegisterInterchainAccount(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"owner", msg.Owner,
+		"connection-id", msg.ConnectionId,
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != ownerHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), ownerHexAddr.String())
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := icacontrollerkeeper.NewMsgServerImpl(&p.icaControllerKeeper).RegisterInterchainAccount(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitInterchainAccountRegisteredEvent(ctx, stateDB, ownerHexAddr, msg.ConnectionId, res.PortId, res.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ChannelId, res.PortId)
+}
+
+// SendTx sends the given messages to be executed by the interchain account of
+// the owner on the host chain of the given connection.
+func (p Precompile) SendTx(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, ownerHexAddr, err := NewMsgSendTx(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"owner", msg.Owner,
+		"connection-id", msg.ConnectionId,
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != ownerHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), ownerHexAddr.String())
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	channelID, err := p.validateActiveChannel(ctx, msg.Owner, msg.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := icacontrollerkeeper.NewMsgServerImpl(&p.icaControllerKeeper).SendTx(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitInterchainAccountTxSentEvent(ctx, stateDB, ownerHexAddr, msg.ConnectionId, channelID, res.Sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
+
+// validateActiveChannel checks that the owner has an open interchain account
+// channel on the given connection and that the channel uses protobuf
+// encoding, as the messages are always encoded with protobuf. It returns the
+// channel identifier.
+func (p Precompile) validateActiveChannel(ctx sdk.Context, owner, connectionID string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	channelID, found := p.icaControllerKeeper.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return "", fmt.Errorf(ErrNoActiveChannel, portID, connectionID)
+	}
+
+	appVersion, found := p.icaControllerKeeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return "", fmt.Errorf(ErrNoActiveChannel, portID, connectionID)
+	}
+
+	metadata, err := icatypes.MetadataFromVersion(appVersion)
+	if err != nil {
+		return "", err
+	}
+
+	if metadata.Encoding != icatypes.EncodingProtobuf {
+		return "", fmt.Errorf(ErrUnsupportedEncoding, metadata.Encoding, icatypes.EncodingProtobuf)
+	}
+
+	return channelID, nil
+}
@@ -0,0 +1,192 @@
+package ics27
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	"cosmossdk.io/core/address"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// EventInterchainAccountRegistered defines the event data for the ICS27 RegisterInterchainAccount transaction.
+type EventInterchainAccountRegistered struct {
+	Owner        common.Address
+	ConnectionId string //nolint:revive,stylecheck // matches the ABI argument name
+	PortId       string //nolint:revive,stylecheck // matches the ABI argument name
+	ChannelId    string //nolint:revive,stylecheck // matches the ABI argument name
+}
+
+// EventInterchainAccountTxSent defines the event data for the ICS27 SendTx transaction.
+type EventInterchainAccountTxSent struct {
+	Owner        common.Address
+	ConnectionId string //nolint:revive,stylecheck // matches the ABI argument name
+	ChannelId    string //nolint:revive,stylecheck // matches the ABI argument name
+	Sequence     uint64
+}
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount
+// instance and does sanity checks on the given arguments before populating the
+// message. Interchain account channels opened through the precompile are always
+// unordered, so a timed out packet does not close the channel.
+func NewMsgRegisterInterchainAccount(args []interface{}, addrCdc address.Codec) (*icacontrollertypes.MsgRegisterInterchainAccount, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	owner, ownerAddr, err := parseOwner(args[0], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	connectionID, err := parseConnectionID(args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	version, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "version", "", args[2])
+	}
+
+	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(connectionID, ownerAddr, version, channeltypes.UNORDERED)
+
+	return msg, owner, nil
+}
+
+// NewMsgSendTx creates a new MsgSendTx instance and does sanity checks on the
+// given arguments before populating the message. The given messages are
+// protobuf-encoded Any messages, which are wrapped in a CosmosTx and encoded
+// with protobuf as the packet data.
+func NewMsgSendTx(args []interface{}, addrCdc address.Codec) (*icacontrollertypes.MsgSendTx, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	owner, ownerAddr, err := parseOwner(args[0], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	connectionID, err := parseConnectionID(args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msgs, ok := args[2].([][]byte)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "msgs", [][]byte{}, args[2])
+	}
+
+	memo, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "memo", "", args[3])
+	}
+
+	relativeTimeout, ok := args[4].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "relativeTimeout", uint64(0), args[4])
+	}
+	if relativeTimeout == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidRelativeTimeout)
+	}
+
+	data, err := NewCosmosTxData(msgs)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	msg := icacontrollertypes.NewMsgSendTx(ownerAddr, connectionID, relativeTimeout, packetData)
+
+	return msg, owner, nil
+}
+
+// NewCosmosTxData returns the protobuf encoding of a CosmosTx holding the given
+// protobuf-encoded Any messages.
+//
+// NOTE: the messages are not resolved against the interface registry, as they
+// are executed on the host chain and may not be known on this chain.
+func NewCosmosTxData(msgs [][]byte) ([]byte, error) {
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf(ErrEmptyMsgs)
+	}
+
+	cosmosTx := icatypes.CosmosTx{
+		Messages: make([]*codectypes.Any, len(msgs)),
+	}
+	for i, bz := range msgs {
+		msgAny := new(codectypes.Any)
+		if err := msgAny.Unmarshal(bz); err != nil {
+			return nil, fmt.Errorf(ErrInvalidMsg, i, err)
+		}
+		if msgAny.TypeUrl == "" {
+			return nil, fmt.Errorf(ErrInvalidMsg, i, "empty type URL")
+		}
+		cosmosTx.Messages[i] = msgAny
+	}
+
+	return cosmosTx.Marshal()
+}
+
+// NewInterchainAccountArgs parses the arguments of the interchainAccount query
+// and returns the owner address as bech32 and the connection identifier.
+func NewInterchainAccountArgs(args []interface{}, addrCdc address.Codec) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	_, ownerAddr, err := parseOwner(args[0], addrCdc)
+	if err != nil {
+		return "", "", err
+	}
+
+	connectionID, err := parseConnectionID(args[1])
+	if err != nil {
+		return "", "", err
+	}
+
+	return ownerAddr, connectionID, nil
+}
+
+// parseOwner parses the owner hex address argument and returns it along with
+// its bech32 representation.
+func parseOwner(arg interface{}, addrCdc address.Codec) (common.Address, string, error) {
+	owner, ok := arg.(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidOwner, arg)
+	}
+
+	ownerAddr, err := addrCdc.BytesToString(owner.Bytes())
+	if err != nil {
+		return common.Address{}, "", fmt.Errorf("failed to decode owner address: %w", err)
+	}
+
+	return owner, ownerAddr, nil
+}
+
+// parseConnectionID parses and validates the connection identifier argument.
+func parseConnectionID(arg interface{}) (string, error) {
+	connectionID, ok := arg.(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, "connectionId", "", arg)
+	}
+
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return "", fmt.Errorf(ErrInvalidConnectionID, connectionID)
+	}
+
+	return connectionID, nil
+}
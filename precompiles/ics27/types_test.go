@@ -0,0 +1,179 @@
+package ics27
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestNewMsgRegisterInterchainAccount(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	expOwnerAddr, err := addrCodec.BytesToString(owner.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid - default version",
+			args:    []interface{}{owner, "connection-0", ""},
+			wantErr: false,
+		},
+		{
+			name:    "invalid number of arguments",
+			args:    []interface{}{owner, "connection-0"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2),
+		},
+		{
+			name:    "invalid owner",
+			args:    []interface{}{common.Address{}, "connection-0", ""},
+			wantErr: true,
+			errMsg:  "invalid owner address",
+		},
+		{
+			name:    "invalid connection ID",
+			args:    []interface{}{owner, "channel-0", ""},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidConnectionID, "channel-0"),
+		},
+		{
+			name:    "invalid version type",
+			args:    []interface{}{owner, "connection-0", 1},
+			wantErr: true,
+			errMsg:  "invalid type for version",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, ownerAddr, err := NewMsgRegisterInterchainAccount(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, owner, ownerAddr)
+			require.Equal(t, expOwnerAddr, msg.Owner)
+			require.Equal(t, "connection-0", msg.ConnectionId)
+			require.Equal(t, channeltypes.UNORDERED, msg.Ordering)
+			require.NoError(t, msg.ValidateBasic())
+		})
+	}
+}
+
+func TestNewMsgSendTx(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	expOwnerAddr, err := addrCodec.BytesToString(owner.Bytes())
+	require.NoError(t, err)
+
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: "cosmos1ica",
+		ToAddress:   "cosmos1recipient",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+	}
+	msgAny, err := types.NewAnyWithValue(bankMsg)
+	require.NoError(t, err)
+	msgBz, err := msgAny.Marshal()
+	require.NoError(t, err)
+
+	emptyAny, err := (&types.Any{Value: []byte{1}}).Marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid",
+			args:    []interface{}{owner, "connection-0", [][]byte{msgBz}, "memo", uint64(600_000_000_000)},
+			wantErr: false,
+		},
+		{
+			name:    "invalid number of arguments",
+			args:    []interface{}{owner, "connection-0", [][]byte{msgBz}, "memo"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 4),
+		},
+		{
+			name:    "invalid owner",
+			args:    []interface{}{"not-an-address", "connection-0", [][]byte{msgBz}, "memo", uint64(1)},
+			wantErr: true,
+			errMsg:  "invalid owner address",
+		},
+		{
+			name:    "empty messages",
+			args:    []interface{}{owner, "connection-0", [][]byte{}, "memo", uint64(1)},
+			wantErr: true,
+			errMsg:  ErrEmptyMsgs,
+		},
+		{
+			name:    "invalid message encoding",
+			args:    []interface{}{owner, "connection-0", [][]byte{{0xff}}, "memo", uint64(1)},
+			wantErr: true,
+			errMsg:  "invalid message at index 0",
+		},
+		{
+			name:    "message without type URL",
+			args:    []interface{}{owner, "connection-0", [][]byte{msgBz, emptyAny}, "memo", uint64(1)},
+			wantErr: true,
+			errMsg:  "invalid message at index 1: empty type URL",
+		},
+		{
+			name:    "zero relative timeout",
+			args:    []interface{}{owner, "connection-0", [][]byte{msgBz}, "memo", uint64(0)},
+			wantErr: true,
+			errMsg:  ErrInvalidRelativeTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, ownerAddr, err := NewMsgSendTx(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, owner, ownerAddr)
+			require.Equal(t, expOwnerAddr, msg.Owner)
+			require.Equal(t, icatypes.EXECUTE_TX, msg.PacketData.Type)
+			require.Equal(t, "memo", msg.PacketData.Memo)
+			require.NoError(t, msg.ValidateBasic())
+
+			var cosmosTx icatypes.CosmosTx
+			require.NoError(t, cosmosTx.Unmarshal(msg.PacketData.Data))
+			require.Len(t, cosmosTx.Messages, 1)
+			require.Equal(t, sdk.MsgTypeURL(bankMsg), cosmosTx.Messages[0].TypeUrl)
+			require.Equal(t, msgAny.Value, cosmosTx.Messages[0].Value)
+		})
+	}
+}
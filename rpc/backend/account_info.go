@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"math/big"
@@ -74,19 +75,29 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 	clientCtx := b.ClientCtx.WithHeight(height)
 
 	// query storage proofs
-	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
-
-	for i, key := range storageKeys {
-		hexKey := common.HexToHash(key)
-		valueBz, proof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+	var (
+		storageHash   common.Hash // NOTE: the IAVL store of the EVM module has no storage hash per account.
+		storageProofs []rpctypes.StorageResult
+	)
+	if b.Cfg.JSONRPC.EnableMPTProofs {
+		storageHash, storageProofs, err = b.mptStorageProofs(ctx, address, storageKeys)
 		if err != nil {
 			return nil, err
 		}
-
-		storageProofs[i] = rpctypes.StorageResult{
-			Key:   key,
-			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
-			Proof: GetHexProofs(proof),
+	} else {
+		storageProofs = make([]rpctypes.StorageResult, len(storageKeys))
+		for i, key := range storageKeys {
+			hexKey := common.HexToHash(key)
+			valueBz, proof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+			if err != nil {
+				return nil, err
+			}
+
+			storageProofs[i] = rpctypes.StorageResult{
+				Key:   key,
+				Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
+				Proof: GetHexProofs(proof),
+			}
 		}
 	}
 
@@ -118,11 +129,40 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		Balance:      (*hexutil.Big)(balance.BigInt()),
 		CodeHash:     common.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Cosmos EVM doesn't have a storage hash. TODO: implement?
+		StorageHash:  storageHash,
 		StorageProof: storageProofs,
 	}, nil
 }
 
+// mptStorageProofs returns the storage hash and the storage proofs of an account
+// against its Ethereum storage trie, computed by the node from the storage of the
+// account. Unlike the IAVL proofs, they can be verified with the Ethereum tooling
+// against the returned storage hash, which is not committed in the app hash: the
+// account proof remains the IAVL proof of the account in the auth store.
+func (b *Backend) mptStorageProofs(ctx context.Context, address common.Address, storageKeys []string) (common.Hash, []rpctypes.StorageResult, error) {
+	res, err := b.QueryClient.StorageProof(ctx, &evmtypes.QueryStorageProofRequest{
+		Address: address.String(),
+		Keys:    storageKeys,
+	})
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	storageProofs := make([]rpctypes.StorageResult, len(res.Proofs))
+	for i, slotProof := range res.Proofs {
+		proof := make([]string, len(slotProof.Proof))
+		for j, node := range slotProof.Proof {
+			proof[j] = hexutil.Encode(node)
+		}
+		storageProofs[i] = rpctypes.StorageResult{
+			Key:   slotProof.Key,
+			Value: (*hexutil.Big)(common.HexToHash(slotProof.Value).Big()),
+			Proof: proof,
+		}
+	}
+	return common.HexToHash(res.StorageHash), storageProofs, nil
+}
+
 // GetStorageAt returns the contract storage at the given address, block number, and key.
 func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
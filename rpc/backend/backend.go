@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -111,13 +112,16 @@ type EVMBackend interface {
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (hexutil.Uint64, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts evmtypes.SimOpts, blockNr rpctypes.BlockNumber) ([]map[string]interface{}, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (*rpctypes.AccessListResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetIndexedLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// TxPool API
@@ -129,6 +133,10 @@ type EVMBackend interface {
 	// Tracing
 	TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, config *rpctypes.TraceCallConfig) (interface{}, error)
+	Preimage(hash common.Hash) (hexutil.Bytes, error)
+	StorageRangeAt(blockNrOrHash rpctypes.BlockNumberOrHash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (rpctypes.StorageRangeResult, error)
+	AccountRange(blockNrOrHash rpctypes.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage bool) (state.Dump, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -327,7 +327,12 @@ func (b *Backend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Heade
 		b.Logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", resBlock.Header.Height, "error", err)
 	}
 
-	ethHeader := rpctypes.EthHeaderFromComet(*resBlock.Header, bloom, baseFee)
+	receiptsRoot, err := b.HeaderReceiptsRoot(resBlock.Header.Height, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	ethHeader := rpctypes.EthHeaderFromComet(*resBlock.Header, bloom, receiptsRoot, baseFee)
 	return ethHeader, nil
 }
 
@@ -360,10 +365,59 @@ func (b *Backend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 		b.Logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", height, "error", err)
 	}
 
-	ethHeader := rpctypes.EthHeaderFromComet(*resHeader.Header, bloom, baseFee)
+	receiptsRoot, err := b.HeaderReceiptsRoot(height, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	ethHeader := rpctypes.EthHeaderFromComet(*resHeader.Header, bloom, receiptsRoot, baseFee)
 	return ethHeader, nil
 }
 
+// BlockReceiptsRoot returns the root of the receipts trie of a block, read from
+// the indexer if it has the block or computed from the block results otherwise.
+func (b *Backend) BlockReceiptsRoot(
+	resBlock *cmtrpctypes.ResultBlock,
+	blockRes *cmtrpctypes.ResultBlockResults,
+) (common.Hash, error) {
+	if root := b.indexedReceiptsRoot(resBlock.Block.Height); root != nil {
+		return *root, nil
+	}
+	root, err := rpctypes.ReceiptsRoot(b.ClientCtx.TxConfig.TxDecoder(), resBlock.Block, blockRes.TxsResults)
+	if err != nil {
+		return common.Hash{}, errors.Wrapf(err, "receipts root of block %d", resBlock.Block.Height)
+	}
+	return root, nil
+}
+
+// HeaderReceiptsRoot returns the root of the receipts trie of the block at the
+// given height, only fetching the block if the indexer doesn't have it.
+func (b *Backend) HeaderReceiptsRoot(height int64, blockRes *cmtrpctypes.ResultBlockResults) (common.Hash, error) {
+	if root := b.indexedReceiptsRoot(height); root != nil {
+		return *root, nil
+	}
+	resBlock, err := b.RPCClient.Block(b.Ctx, &height)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("block not found for height %d", height)
+	}
+	return b.BlockReceiptsRoot(resBlock, blockRes)
+}
+
+// indexedReceiptsRoot returns the receipts root of a block from the indexer,
+// returns nil if the indexer doesn't record it or the block is not indexed.
+func (b *Backend) indexedReceiptsRoot(height int64) *common.Hash {
+	indexer, ok := b.Indexer.(cosmosevmtypes.EVMReceiptsRootIndexer)
+	if !ok {
+		return nil
+	}
+	root, err := indexer.GetReceiptsRoot(height)
+	if err != nil {
+		b.Logger.Debug("failed to query indexed receipts root", "height", height, "error", err.Error())
+		return nil
+	}
+	return root
+}
+
 // BlockBloom query block bloom filter from block results
 func (b *Backend) BlockBloom(blockRes *cmtrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
 	for _, event := range blockRes.FinalizeBlockEvents {
@@ -468,10 +522,15 @@ func (b *Backend) RPCBlockFromCometBlock(
 		gasUsed += uint64(txsResult.GetGasUsed()) // #nosec G115 -- checked for int overflow already
 	}
 
+	receiptsRoot, err := b.BlockReceiptsRoot(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
 	formattedBlock := rpctypes.FormatBlock(
 		block.Header, block.Size(),
 		gasLimit, new(big.Int).SetUint64(gasUsed),
-		ethRPCTxs, bloom, validatorAddr, baseFee,
+		ethRPCTxs, bloom, receiptsRoot, validatorAddr, baseFee,
 	)
 	return formattedBlock, nil
 }
@@ -514,7 +573,12 @@ func (b *Backend) EthBlockFromCometBlock(
 		b.Logger.Error("failed to fetch Base Fee from pruned block. Check node pruning configuration", "height", height, "error", err)
 	}
 
-	ethHeader := rpctypes.EthHeaderFromComet(block.Header, bloom, baseFee)
+	receiptsRoot, err := b.BlockReceiptsRoot(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	ethHeader := rpctypes.EthHeaderFromComet(block.Header, bloom, receiptsRoot, baseFee)
 	msgs := b.EthMsgsFromCometBlock(resBlock, blockRes)
 
 	txs := make([]*ethtypes.Transaction, len(msgs))
@@ -522,13 +586,16 @@ func (b *Backend) EthBlockFromCometBlock(
 		txs[i] = ethMsg.AsTransaction()
 	}
 
-	// TODO: add tx receipts
 	ethBlock := ethtypes.NewBlock(
 		ethHeader,
 		&ethtypes.Body{Transactions: txs, Uncles: nil, Withdrawals: nil},
 		nil,
 		trie.NewStackTrie(nil))
-	return ethBlock, nil
+
+	// the block is built without receipts, restore the receipts root of the header
+	header := ethBlock.Header()
+	header.ReceiptHash = receiptsRoot
+	return ethBlock.WithSeal(header), nil
 }
 
 // GetBlockReceipts returns the receipts for a given block number or hash.
@@ -657,6 +724,11 @@ func (b *Backend) formatTxReceipt(
 		receipt["contractAddress"] = crypto.CreateAddress(from, ethTx.Nonce())
 	}
 
+	// the fees were paid by a fee granter on behalf of the sender
+	if feePayer, ok := evmtypes.TxFeePayerFromEvents(blockRes.TxsResults[txResult.TxIndex].Events, ethMsg.Hash()); ok {
+		receipt["feePayer"] = feePayer
+	}
+
 	if ethTx.Type() >= ethtypes.DynamicFeeTxType {
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
@@ -10,10 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/pkg/errors"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -272,7 +269,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil, nil)
 		if err != nil {
 			return args, err
 		}
@@ -288,7 +285,14 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// The optional state and block overrides are applied on top of the state of the
+// requested block before the estimation.
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -299,6 +303,11 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		return 0, err
 	}
 
+	overridesBz, blockOverridesBz, err := marshalOverrides(overrides, blockOverrides)
+	if err != nil {
+		return 0, err
+	}
+
 	header, err := b.CometHeaderByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -310,6 +319,8 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
+		Overrides:       overridesBz,
+		BlockOverrides:  blockOverridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -319,21 +330,30 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 	if err != nil {
 		return 0, err
 	}
-	if err = handleRevertError(res.VmError, res.Ret); err != nil {
+	if err = evmtypes.HandleRevertError(res.VmError, res.Ret); err != nil {
 		return 0, err
 	}
 	return hexutil.Uint64(res.Gas), nil
 }
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
-// estimated gas used on the operation or an error if fails.
+// estimated gas used on the operation or an error if fails. The optional state
+// and block overrides are applied on top of the state of the requested block
+// and are never persisted.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
+	overridesBz, blockOverridesBz, err := marshalOverrides(overrides, blockOverrides)
+	if err != nil {
+		return nil, err
+	}
 	header, err := b.CometHeaderByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -345,6 +365,8 @@ func (b *Backend) DoCall(
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
+		Overrides:       overridesBz,
+		BlockOverrides:  blockOverridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -371,13 +393,118 @@ func (b *Backend) DoCall(
 		return nil, err
 	}
 
-	if err = handleRevertError(res.VmError, res.Ret); err != nil {
+	if err = evmtypes.HandleRevertError(res.VmError, res.Ret); err != nil {
 		return nil, err
 	}
 
 	return res, nil
 }
 
+// CreateAccessList creates the EIP-2930 access list of the given transaction
+// on top of the state of the requested block. If the transaction itself fails,
+// the vm error is returned in the result together with the generated list.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+) (*rpctypes.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	overridesBz, _, err := marshalOverrides(overrides, nil)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.CometHeaderByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		Overrides:       overridesBz,
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.CreateAccessList(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.AccessListResult{
+		Accesslist: evmtypes.AccessList(res.AccessList).ToEthAccessList(),
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
+// SimulateV1 executes the calls of the given blocks sequentially on top of the
+// state of the requested block and returns the simulated blocks, together with
+// the results of their calls.
+func (b *Backend) SimulateV1(opts evmtypes.SimOpts, blockNr rpctypes.BlockNumber) ([]map[string]interface{}, error) {
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.CometHeaderByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []evmtypes.SimBlockResult
+	if err := json.Unmarshal(res.Data, &blocks); err != nil {
+		return nil, err
+	}
+
+	results := make([]map[string]interface{}, len(blocks))
+	for i, block := range blocks {
+		results[i], err = rpctypes.FormatSimulatedBlock(block, opts.ReturnFullTransactions, b.EvmChainID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
 // GasPrice returns the current gas price based on Cosmos EVM' gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
@@ -411,17 +538,3 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 
 	return (*hexutil.Big)(result), nil
 }
-
-// handleRevertError returns revert related error.
-func handleRevertError(vmError string, ret []byte) error {
-	if len(vmError) > 0 {
-		if vmError != vm.ErrExecutionReverted.Error() {
-			return status.Error(codes.Internal, vmError)
-		}
-		if len(ret) == 0 {
-			return errors.New(vmError)
-		}
-		return evmtypes.NewExecErrorWithReason(ret)
-	}
-	return nil
-}
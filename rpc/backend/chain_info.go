@@ -42,9 +42,16 @@ func (b *Backend) ChainID() (*hexutil.Big, error) {
 	return nil, fmt.Errorf("chain not synced beyond EIP-155 replay-protection fork block")
 }
 
-// ChainConfig returns the latest ethereum chain configuration
+// ChainConfig returns the latest ethereum chain configuration, including the
+// hard fork activations scheduled through governance. It falls back to the
+// chain configuration of the application if the query fails.
 func (b *Backend) ChainConfig() *params.ChainConfig {
-	return evmtypes.GetEthChainConfig()
+	res, err := b.QueryClient.Config(b.Ctx, &evmtypes.QueryConfigRequest{})
+	if err != nil || res.Config == nil {
+		b.Logger.Debug("failed to query the chain config", "error", err)
+		return evmtypes.GetEthChainConfig()
+	}
+	return res.Config.EthereumConfig(nil)
 }
 
 // GlobalMinGasPrice returns MinGasPrice param from FeeMarket
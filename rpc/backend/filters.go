@@ -4,6 +4,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -29,6 +31,25 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetIndexedLogs returns the logs of the block range matching the addresses and
+// the topics from the log index of the custom indexer. It returns false if the
+// log index is disabled or doesn't cover the whole range.
+func (b *Backend) GetIndexedLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, bool, error) {
+	logIndexer, ok := b.Indexer.(cosmosevmtypes.EVMLogIndexer)
+	if !ok {
+		return nil, false, nil
+	}
+	indexed, err := logIndexer.IsLogIndexed(from, to)
+	if err != nil || !indexed {
+		return nil, false, err
+	}
+	logs, err := logIndexer.GetLogs(from, to, addresses, topics, limit)
+	if err != nil {
+		return nil, false, err
+	}
+	return logs, true, nil
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
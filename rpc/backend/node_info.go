@@ -60,26 +60,18 @@ func (b *Backend) Accounts() ([]common.Address, error) {
 // yet received the latest block headers from its pears. In case it is synchronizing:
 // - startingBlock: block number this node started to synchronize from
 // - currentBlock:  block number this node is currently importing
-// - highestBlock:  block number of the highest block header this node has received from peers
-// - pulledStates:  number of state entries processed until now
-// - knownStates:   number of known state entries that still need to be pulled
+// - highestBlock:  estimated block number of the network head
 func (b *Backend) Syncing() (interface{}, error) {
 	status, err := b.ClientCtx.Client.Status(b.Ctx)
 	if err != nil {
 		return false, err
 	}
 
-	if !status.SyncInfo.CatchingUp {
+	syncStatus := rpctypes.NewSyncStatus(status.SyncInfo, time.Now())
+	if syncStatus == nil {
 		return false, nil
 	}
-
-	return map[string]interface{}{
-		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight), //nolint:gosec // G115 // won't exceed uint64
-		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),   //nolint:gosec // G115 // won't exceed uint64
-		// "highestBlock":  nil, // NA
-		// "pulledStates":  nil, // NA
-		// "knownStates":   nil, // NA
-	}, nil
+	return syncStatus, nil
 }
 
 // SetEtherbase sets the etherbase of the miner
@@ -1,13 +1,18 @@
 package backend
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -229,3 +234,262 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// TraceCall executes the given call on top of the state of the requested block
+// with the tracer of the provided configuration and returns the tracer dependent
+// result. The optional state and block overrides of the configuration are
+// applied before the execution and are never persisted.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.CometBlockByNumber(blockNr)
+	if err != nil {
+		b.Logger.Debug("block not found", "number", blockNr)
+		return nil, err
+	}
+	if blk == nil || blk.Block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNr)
+	}
+
+	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.Ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	if config != nil {
+		traceCallRequest.TraceConfig = b.convertConfig(&config.TraceConfig)
+		traceCallRequest.Overrides, traceCallRequest.BlockOverrides, err = marshalOverrides(config.StateOverrides, config.BlockOverrides)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the call is traced on top of the state at the end of the requested block
+	traceResult, err := b.QueryClient.TraceCall(rpctypes.ContextWithHeight(blk.Block.Height), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
+// Preimage returns the SHA3 preimage of the hash recorded by the node during
+// the EVM execution.
+func (b *Backend) Preimage(hash common.Hash) (hexutil.Bytes, error) {
+	res, err := b.QueryClient.Preimage(b.Ctx, &evmtypes.QueryPreimageRequest{Hash: hash.Hex()})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			// the error message imitates geth behavior
+			return nil, errors.New("unknown preimage")
+		}
+		return nil, err
+	}
+	return res.Preimage, nil
+}
+
+// StorageRangeAt returns the storage of the account at the beginning of the
+// transaction with the given index in the block, starting from the storage slot
+// with the keccak256 hash of its key at or after keyStart, as geth does.
+func (b *Backend) StorageRangeAt(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+	if maxResult < 0 {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("invalid max result %d", maxResult)
+	}
+
+	blk, err := b.CometBlockByNumber(blockNum)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+	if blk == nil || blk.Block == nil {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("block %d not found", blockNum)
+	}
+
+	// the ethereum transactions of the block preceding the requested one
+	var predecessors []*evmtypes.MsgEthereumTx
+	for _, txBz := range blk.Block.Txs {
+		if len(predecessors) == txIndex {
+			break
+		}
+		tx, err := b.ClientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.Logger.Debug("failed to decode transaction in block", "height", blk.Block.Height, "error", err.Error())
+			continue
+		}
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			if len(predecessors) == txIndex {
+				break
+			}
+			predecessors = append(predecessors, ethMsg)
+		}
+	}
+	if len(predecessors) < txIndex {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("transaction index %d out of range for block %d", txIndex, blk.Block.Height)
+	}
+
+	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return rpctypes.StorageRangeResult{}, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.Ctx, &blk.Block.Height)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	// the key start is a prefix of the hashed key, like a path of the storage trie
+	var keyStartHash common.Hash
+	copy(keyStartHash[:], keyStart)
+
+	req := &evmtypes.QueryStorageRangeAtRequest{
+		Address:         address.Hex(),
+		KeyStart:        keyStartHash.Hex(),
+		MaxResult:       uint64(maxResult),
+		Predecessors:    predecessors,
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := blk.Block.Height - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+	res, err := b.QueryClient.StorageRangeAt(rpctypes.ContextWithHeight(contextHeight), req)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	result := rpctypes.StorageRangeResult{
+		Storage: make(rpctypes.StorageMap, len(res.Storage)),
+	}
+	for _, entry := range res.Storage {
+		key := common.HexToHash(entry.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(entry.Value),
+		}
+	}
+	if res.NextKey != "" {
+		nextKey := common.HexToHash(res.NextKey)
+		result.NextKey = &nextKey
+	}
+
+	return result, nil
+}
+
+// AccountRange returns the dump of the contract accounts at the given block,
+// starting from the start address. The externally owned accounts are not
+// included, since they are stored by the auth module.
+func (b *Backend) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage bool,
+) (state.Dump, error) {
+	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return state.Dump{}, err
+	}
+	if maxResults < 0 {
+		return state.Dump{}, fmt.Errorf("invalid max results %d", maxResults)
+	}
+
+	header, err := b.CometHeaderByNumber(blockNum)
+	if err != nil {
+		return state.Dump{}, err
+	}
+
+	req := &evmtypes.QueryAccountRangeRequest{
+		MaxResults: uint64(maxResults),
+		NoCode:     nocode,
+		NoStorage:  nostorage,
+	}
+	if len(start) > 0 {
+		req.Start = common.BytesToAddress(start).Hex()
+	}
+
+	res, err := b.QueryClient.AccountRange(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return state.Dump{}, err
+	}
+
+	dump := state.Dump{
+		Accounts: make(map[string]state.DumpAccount, len(res.Accounts)),
+	}
+	if header != nil && header.Header != nil {
+		dump.Root = common.BytesToHash(header.Header.AppHash).Hex()
+	}
+	for _, acc := range res.Accounts {
+		account := state.DumpAccount{
+			Balance:  acc.Balance,
+			Nonce:    acc.Nonce,
+			CodeHash: common.HexToHash(acc.CodeHash).Bytes(),
+			Code:     acc.Code,
+		}
+		if acc.StorageRoot != "" {
+			account.Root = common.HexToHash(acc.StorageRoot).Bytes()
+		}
+		if len(acc.Storage) > 0 {
+			account.Storage = make(map[common.Hash]string, len(acc.Storage))
+			for _, entry := range acc.Storage {
+				// the values are dumped without leading zeros, like in go-ethereum
+				value := bytes.TrimLeft(common.HexToHash(entry.Value).Bytes(), "\x00")
+				account.Storage[common.HexToHash(entry.Key)] = common.Bytes2Hex(value)
+			}
+		}
+		dump.Accounts[common.HexToAddress(acc.Address).Hex()] = account
+	}
+	if res.Next != "" {
+		dump.Next = common.HexToAddress(res.Next).Bytes()
+	}
+
+	return dump, nil
+}
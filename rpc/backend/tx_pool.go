@@ -1,43 +1,189 @@
 package backend
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const (
+	// txPoolPending is the key of the executable transactions on the txpool namespace responses
+	txPoolPending = "pending"
+	// txPoolQueued is the key of the non-executable (nonce gapped) transactions on the txpool namespace responses
+	txPoolQueued = "queued"
 )
 
+// txPoolSet groups the Ethereum transactions held by the mempool by sender and nonce.
+type txPoolSet map[common.Address]map[uint64]*evmtypes.MsgEthereumTx
+
 // Content returns the transactions contained within the transaction pool
 func (b *Backend) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
-	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
-	}
-	return content, nil
+	return b.content(nil)
 }
 
 // ContentFrom returns the transactions contained within the transaction pool
-func (b *Backend) ContentFrom(_ common.Address) (map[string]map[string]map[string]*types.RPCTransaction, error) {
-	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
-	}
-	return content, nil
+func (b *Backend) ContentFrom(address common.Address) (map[string]map[string]map[string]*types.RPCTransaction, error) {
+	return b.content(&address)
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an easily inspectable list.
 func (b *Backend) Inspect() (map[string]map[string]map[string]string, error) {
+	pending, queued, err := b.txPoolContent(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// format mirrors the go-ethereum txpool_inspect summary of a transaction
+	format := func(msg *evmtypes.MsgEthereumTx) string {
+		tx := msg.AsTransaction()
+		if to := tx.To(); to != nil {
+			return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+		}
+		return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+	}
+
 	inspect := map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+		txPoolPending: make(map[string]map[string]string, len(pending)),
+		txPoolQueued:  make(map[string]map[string]string, len(queued)),
+	}
+	for key, set := range map[string]txPoolSet{txPoolPending: pending, txPoolQueued: queued} {
+		for account, txs := range set {
+			dump := make(map[string]string, len(txs))
+			for nonce, msg := range txs {
+				dump[fmt.Sprintf("%d", nonce)] = format(msg)
+			}
+			inspect[key][account.Hex()] = dump
+		}
 	}
 	return inspect, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
 func (b *Backend) Status() (map[string]hexutil.Uint, error) {
+	pending, queued, err := b.txPoolContent(nil)
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		txPoolPending: hexutil.Uint(pending.len()),
+		txPoolQueued:  hexutil.Uint(queued.len()),
 	}, nil
 }
+
+// content returns the pending and queued transactions of the pool rendered as
+// RPC transactions. If an address is provided, only the transactions sent from
+// it are returned.
+func (b *Backend) content(address *common.Address) (map[string]map[string]map[string]*types.RPCTransaction, error) {
+	pending, queued, err := b.txPoolContent(address)
+	if err != nil {
+		return nil, err
+	}
+
+	content := map[string]map[string]map[string]*types.RPCTransaction{
+		txPoolPending: make(map[string]map[string]*types.RPCTransaction, len(pending)),
+		txPoolQueued:  make(map[string]map[string]*types.RPCTransaction, len(queued)),
+	}
+	for key, set := range map[string]txPoolSet{txPoolPending: pending, txPoolQueued: queued} {
+		for account, txs := range set {
+			dump := make(map[string]*types.RPCTransaction, len(txs))
+			for nonce, msg := range txs {
+				// use zero block values since it's not included in a block yet
+				rpcTx, err := types.NewTransactionFromMsg(msg, common.Hash{}, 0, 0, nil, b.EvmChainID)
+				if err != nil {
+					return nil, err
+				}
+				dump[fmt.Sprintf("%d", nonce)] = rpcTx
+			}
+			content[key][account.Hex()] = dump
+		}
+	}
+	return content, nil
+}
+
+// txPoolContent decodes the Ethereum transactions held in the mempool and
+// splits them per sender into pending and queued sets. The pending set holds
+// the transactions with contiguous nonces starting at the sender's committed
+// nonce, while the queued set holds the ones after a nonce gap. Transactions
+// with an already used nonce are discarded. If an address is provided, only the
+// transactions sent from it are returned.
+func (b *Backend) txPoolContent(address *common.Address) (pending, queued txPoolSet, err error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signer := ethtypes.LatestSignerForChainID(b.EvmChainID)
+	bySender := make(txPoolSet)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSenderLegacy(signer)
+			if err != nil {
+				b.Logger.Debug("failed to get sender of pending transaction", "hash", ethMsg.Hash(), "error", err.Error())
+				continue
+			}
+			if address != nil && sender != *address {
+				continue
+			}
+
+			bySender.add(sender, ethMsg.AsTransaction().Nonce(), ethMsg)
+		}
+	}
+
+	pending, queued = make(txPoolSet), make(txPoolSet)
+	for sender, txs := range bySender {
+		next, err := b.getAccountNonce(sender, false, 0, b.Logger)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nonces := make([]uint64, 0, len(txs))
+		for nonce := range txs {
+			nonces = append(nonces, nonce)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+		for _, nonce := range nonces {
+			switch {
+			case nonce < next:
+				// already included in a block, will be removed on the next recheck
+				continue
+			case nonce == next:
+				pending.add(sender, nonce, txs[nonce])
+				next++
+			default:
+				queued.add(sender, nonce, txs[nonce])
+			}
+		}
+	}
+	return pending, queued, nil
+}
+
+// add adds the transaction to the set under the given sender and nonce.
+func (s txPoolSet) add(sender common.Address, nonce uint64, msg *evmtypes.MsgEthereumTx) {
+	if s[sender] == nil {
+		s[sender] = make(map[uint64]*evmtypes.MsgEthereumTx)
+	}
+	s[sender][nonce] = msg
+}
+
+// len returns the total amount of transactions in the set.
+func (s txPoolSet) len() int {
+	count := 0
+	for _, txs := range s {
+		count += len(txs)
+	}
+	return count
+}
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
@@ -291,3 +292,25 @@ func GetHexProofs(proof *crypto.ProofOps) []string {
 	}
 	return proofs
 }
+
+// marshalOverrides returns the JSON encoding of the optional state and block
+// overrides of a call request. Nil overrides are encoded as empty bytes.
+func marshalOverrides(overrides *types.StateOverride, blockOverrides *types.BlockOverrides) ([]byte, []byte, error) {
+	var (
+		overridesBz      []byte
+		blockOverridesBz []byte
+		err              error
+	)
+
+	if overrides != nil {
+		if overridesBz, err = json.Marshal(overrides); err != nil {
+			return nil, nil, err
+		}
+	}
+	if blockOverrides != nil {
+		if blockOverridesBz, err = json.Marshal(blockOverrides); err != nil {
+			return nil, nil, err
+		}
+	}
+	return overridesBz, blockOverridesBz, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/rlp"
 	stderrors "github.com/pkg/errors"
 
@@ -102,6 +103,59 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *rpctypes.TraceConfig) (
 	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
+// TraceCall lets you trace a given eth_call. It collects the structured logs
+// created during the execution of EVM on top of the state of the requested
+// block, after applying the optional state and block overrides, and returns
+// them as a JSON object.
+func (a *API) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args, "block number or hash", blockNrOrHash)
+
+	blockNum, err := a.backend.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return a.backend.TraceCall(args, blockNum, config)
+}
+
+// Preimage returns the preimage of a SHA3 hash recorded by the node, if
+// preimage recording is enabled.
+func (a *API) Preimage(hash common.Hash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_preimage", "hash", hash)
+	return a.backend.Preimage(hash)
+}
+
+// StorageRangeAt returns the storage of the contract at the beginning of the
+// transaction with the given index in the block. The storage is ordered by the
+// keccak256 hash of the slot keys, starting from keyStart, and the returned next
+// key is the hashed key of the first slot of the next page.
+func (a *API) StorageRangeAt(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	txIndex int,
+	contractAddress common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "block number or hash", blockNrOrHash, "index", txIndex, "address", contractAddress)
+	return a.backend.StorageRangeAt(blockNrOrHash, txIndex, contractAddress, keyStart, maxResult)
+}
+
+// AccountRange returns a dump of the contract accounts at the given block,
+// starting from the start address. The incompletes parameter is ignored since
+// the accounts are indexed by address.
+func (a *API) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage, _ bool,
+) (state.Dump, error) {
+	a.logger.Debug("debug_accountRange", "block number or hash", blockNrOrHash, "start", start, "max results", maxResults)
+	return a.backend.AccountRange(blockNrOrHash, start, maxResults, nocode, nostorage)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
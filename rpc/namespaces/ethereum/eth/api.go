@@ -2,7 +2,6 @@ package eth
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -68,14 +67,16 @@ type EthereumAPI interface {
 	//
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
-	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, override *rpctypes.StateOverride) (hexutil.Bytes, error)
+	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (hexutil.Bytes, error)
 
 	// Chain Information
 	//
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (hexutil.Uint64, error)
+	SimulateV1(opts evmtypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash, overrides *rpctypes.StateOverride) (*rpctypes.AccessListResult, error)
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
@@ -265,23 +266,20 @@ func (e *PublicAPI) GetProof(address common.Address,
 ///                           EVM/Smart Contract Execution				          ///
 ///////////////////////////////////////////////////////////////////////////////
 
-// Call performs a raw contract call.
+// Call performs a raw contract call. The optional state and block overrides
+// are applied on top of the state of the requested block.
 func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
-	override *rpctypes.StateOverride,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
 ) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args, "block number or hash", blockNrOrHash)
 
-	if override != nil {
-		e.logger.Debug("eth_call", "error", "overrides are unsupported in call queries")
-		return nil, fmt.Errorf("overrides are unsupported in call queries")
-	}
-
 	blockNum, err := e.backend.BlockNumberFromComet(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.backend.DoCall(args, blockNum)
+	data, err := e.backend.DoCall(args, blockNum, overrides, blockOverrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -289,6 +287,43 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes a series of blocks of calls on top of the state of the
+// requested block, which defaults to the latest one. The state changes of every
+// call are visible to the following ones and the optional state and block
+// overrides are applied at the start of every block.
+func (e *PublicAPI) SimulateV1(opts evmtypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_simulateV1", "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		if blockNum, err = e.backend.BlockNumberFromComet(*blockNrOrHash); err != nil {
+			return nil, err
+		}
+	}
+	return e.backend.SimulateV1(opts, blockNum)
+}
+
+// CreateAccessList returns the EIP-2930 access list of the given transaction,
+// together with the gas used when executing it with that list. The transaction
+// is executed on top of the state of the requested block, which defaults to
+// the pending one.
+func (e *PublicAPI) CreateAccessList(args evmtypes.TransactionArgs,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+	overrides *rpctypes.StateOverride,
+) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args, "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthPendingBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		if blockNum, err = e.backend.BlockNumberFromComet(*blockNrOrHash); err != nil {
+			return nil, err
+		}
+	}
+	return e.backend.CreateAccessList(args, blockNum, overrides)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -311,9 +346,16 @@ func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// The optional state and block overrides are applied on top of the state of the
+// requested block.
+func (e *PublicAPI) EstimateGas(
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
+) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides, blockOverrides)
 }
 
 func (e *PublicAPI) FeeHistory(
@@ -393,9 +435,7 @@ func (e *PublicAPI) Mining() bool {
 // yet received the latest block headers from its pears. In case it is synchronizing:
 // - startingBlock: block number this node started to synchronize from
 // - currentBlock:  block number this node is currently importing
-// - highestBlock:  block number of the highest block header this node has received from peers
-// - pulledStates:  number of state entries processed until now
-// - knownStates:   number of known state entries that still need to be pulled
+// - highestBlock:  estimated block number of the network head
 func (e *PublicAPI) Syncing() (interface{}, error) {
 	e.logger.Debug("eth_syncing")
 	return e.backend.Syncing()
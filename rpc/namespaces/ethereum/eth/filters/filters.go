@@ -169,6 +169,16 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
+	// serve the range from the log index when it covers it, which doesn't need
+	// to scan the blocks
+	indexedLogs, indexed, err := f.backend.GetIndexedLogs(int64(from), int64(to), f.criteria.Addresses, f.criteria.Topics, logLimit) //#nosec G115
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch logs from the log index: %w", err)
+	}
+	if indexed {
+		return indexedLogs, nil
+	}
+
 	for height := from; height <= to; height++ {
 		h := int64(height) //#nosec G115
 		blockRes, err := f.backend.CometBlockResultByNumber(&h)
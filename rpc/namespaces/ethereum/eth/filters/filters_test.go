@@ -41,6 +41,11 @@ func (m *MockBackend) GetLogsByHeight(i *int64) ([][]*ethtypes.Log, error) {
 	panic("implement me")
 }
 
+func (m *MockBackend) GetIndexedLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, bool, error) {
+	args := m.Called(from, to, addresses, topics, limit)
+	return args.Get(0).([]*ethtypes.Log), args.Bool(1), args.Error(2)
+}
+
 func (m *MockBackend) BloomStatus() (uint64, uint64) {
 	panic("implement me")
 }
@@ -114,6 +119,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *MockBackend {
 				backend := &MockBackend{}
 				backend.On("HeaderByNumber", mock.Anything).Return(fakeHeader, nil)
+				backend.On("GetIndexedLogs", blockHeight, blockHeight, mock.Anything, mock.Anything, 1000).Return([]*ethtypes.Log(nil), false, nil)
 				backend.On("CometBlockResultByNumber", &blockHeight).Return((*cmtrpctypes.ResultBlockResults)(nil), errors.New("block result error"))
 				return backend
 			},
@@ -130,6 +136,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *MockBackend {
 				backend := &MockBackend{}
 				backend.On("HeaderByNumber", mock.Anything).Return(fakeHeader, nil)
+				backend.On("GetIndexedLogs", blockHeight, blockHeight, mock.Anything, mock.Anything, 1000).Return([]*ethtypes.Log(nil), false, nil)
 				backend.On("CometBlockResultByNumber", &blockHeight).Return(fakeBlockRes, nil)
 				backend.On("BlockBloom", fakeBlockRes).Return(ethtypes.Bloom{}, errors.New("bloom error"))
 				return backend
@@ -202,6 +209,34 @@ func TestFilter(t *testing.T) {
 			},
 			expErr: "invalid block range params",
 		},
+		{
+			name:   "indexed range is served from the log index",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(50)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().GetIndexedLogs(int64(1), int64(50), mock.Anything, mock.Anything, 15).
+					Return([]*ethtypes.Log{{BlockNumber: 42, Index: 3}}, true, nil)
+			},
+			expLogs: []*ethtypes.Log{{BlockNumber: 42, Index: 3}},
+		},
+		{
+			name:   "range is bound by the block range cap",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+			},
+			expErr: "maximum [from, to] blocks distance: 50",
+		},
+		{
+			name:   "log index error returns error",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(10)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().GetIndexedLogs(int64(1), int64(10), mock.Anything, mock.Anything, 15).
+					Return(nil, false, errors.New("query returned more than 15 results"))
+			},
+			expErr: "query returned more than 15 results",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -198,6 +198,75 @@ func (_c *Backend_GetBlockByNumber_Call) RunAndReturn(run func(rpctypes.BlockNum
 	return _c
 }
 
+// GetIndexedLogs provides a mock function with given fields: from, to, addresses, topics, limit
+func (_m *Backend) GetIndexedLogs(from int64, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*types.Log, bool, error) {
+	ret := _m.Called(from, to, addresses, topics, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIndexedLogs")
+	}
+
+	var r0 []*types.Log
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int64, int64, []common.Address, [][]common.Hash, int) ([]*types.Log, bool, error)); ok {
+		return rf(from, to, addresses, topics, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64, []common.Address, [][]common.Hash, int) []*types.Log); ok {
+		r0 = rf(from, to, addresses, topics, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64, []common.Address, [][]common.Hash, int) bool); ok {
+		r1 = rf(from, to, addresses, topics, limit)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(int64, int64, []common.Address, [][]common.Hash, int) error); ok {
+		r2 = rf(from, to, addresses, topics, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Backend_GetIndexedLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexedLogs'
+type Backend_GetIndexedLogs_Call struct {
+	*mock.Call
+}
+
+// GetIndexedLogs is a helper method to define mock.On call
+//   - from int64
+//   - to int64
+//   - addresses []common.Address
+//   - topics [][]common.Hash
+//   - limit int
+func (_e *Backend_Expecter) GetIndexedLogs(from interface{}, to interface{}, addresses interface{}, topics interface{}, limit interface{}) *Backend_GetIndexedLogs_Call {
+	return &Backend_GetIndexedLogs_Call{Call: _e.mock.On("GetIndexedLogs", from, to, addresses, topics, limit)}
+}
+
+func (_c *Backend_GetIndexedLogs_Call) Run(run func(from int64, to int64, addresses []common.Address, topics [][]common.Hash, limit int)) *Backend_GetIndexedLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].([]common.Address), args[3].([][]common.Hash), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *Backend_GetIndexedLogs_Call) Return(_a0 []*types.Log, _a1 bool, _a2 error) *Backend_GetIndexedLogs_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Backend_GetIndexedLogs_Call) RunAndReturn(run func(int64, int64, []common.Address, [][]common.Hash, int) ([]*types.Log, bool, error)) *Backend_GetIndexedLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLogs provides a mock function with given fields: blockHash
 func (_m *Backend) GetLogs(blockHash common.Hash) ([][]*types.Log, error) {
 	ret := _m.Called(blockHash)
@@ -0,0 +1,262 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	// flatCallTracer is the name of the native tracer that produces Parity-style
+	// flat call traces.
+	flatCallTracer = "flatCallTracer"
+	// flatCallTracerConfig converts the errors to the Parity format and keeps
+	// the calls into precompiled contracts, including the Cosmos precompiles.
+	flatCallTracerConfig = `{"convertParityErrors":true,"includePrecompiles":true}`
+
+	traceTypeCreate  = "create"
+	traceTypeSuicide = "suicide"
+
+	replayTraceTypeTrace     = "trace"
+	replayTraceTypeVMTrace   = "vmTrace"
+	replayTraceTypeStateDiff = "stateDiff"
+)
+
+// Backend defines the methods required by the trace API backend
+type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
+	CometBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error)
+	TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
+	TraceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	RPCBlockRangeCap() int32
+}
+
+// API offers the OpenEthereum-compatible `trace` namespace. The traces are
+// flat call traces produced by the keeper tracing path.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new API definition for the trace namespace.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Transaction returns the flat call traces of the given transaction.
+func (api *API) Transaction(hash common.Hash) ([]*Trace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+
+	res, err := api.backend.TraceTransaction(hash, newFlatCallTraceConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*Trace
+	if err := decodeTraces(res, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+// Block returns the flat call traces of all the transactions of the given block.
+func (api *API) Block(blockNr rpctypes.BlockNumber) ([]*Trace, error) {
+	api.logger.Debug("trace_block", "number", blockNr)
+
+	height, err := api.resolveHeight(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	return api.traceBlock(height)
+}
+
+// ReplayTransaction replays the given transaction and returns its output
+// together with the requested traces. Only the `trace` trace type is supported.
+func (api *API) ReplayTransaction(hash common.Hash, traceTypes []string) (*ReplayResults, error) {
+	api.logger.Debug("trace_replayTransaction", "hash", hash, "types", traceTypes)
+
+	withTrace := false
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case replayTraceTypeTrace:
+			withTrace = true
+		case replayTraceTypeVMTrace, replayTraceTypeStateDiff:
+			return nil, fmt.Errorf("trace type %s is not supported", traceType)
+		default:
+			return nil, fmt.Errorf("invalid trace type %s", traceType)
+		}
+	}
+
+	traces, err := api.Transaction(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	results := &ReplayResults{Output: hexutil.Bytes{}}
+	if len(traces) > 0 && traces[0].Result != nil && traces[0].Result.Output != nil {
+		results.Output = *traces[0].Result.Output
+	}
+	if withTrace {
+		results.Trace = make([]*ReplayTrace, len(traces))
+		for i, trace := range traces {
+			results.Trace[i] = &ReplayTrace{
+				Action:       trace.Action,
+				Error:        trace.Error,
+				Result:       trace.Result,
+				Subtraces:    trace.Subtraces,
+				TraceAddress: trace.TraceAddress,
+				Type:         trace.Type,
+			}
+		}
+	}
+	return results, nil
+}
+
+// Filter returns the flat call traces of the given block range matching the
+// sender and recipient addresses of the filter. The `after` and `count`
+// arguments paginate the matching traces.
+func (api *API) Filter(args FilterArgs) ([]*Trace, error) {
+	api.logger.Debug("trace_filter", "args", args)
+
+	fromBlock, toBlock := rpctypes.EthLatestBlockNumber, rpctypes.EthLatestBlockNumber
+	if args.FromBlock != nil {
+		fromBlock = *args.FromBlock
+	}
+	if args.ToBlock != nil {
+		toBlock = *args.ToBlock
+	}
+	from, err := api.resolveHeight(fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := api.resolveHeight(toBlock)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from block %d is after to block %d", from, to)
+	}
+	if blockRangeCap := int64(api.backend.RPCBlockRangeCap()); blockRangeCap > 0 && to-from+1 > blockRangeCap {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockRangeCap)
+	}
+
+	fromAddrs := make(map[common.Address]struct{}, len(args.FromAddress))
+	for _, addr := range args.FromAddress {
+		fromAddrs[addr] = struct{}{}
+	}
+	toAddrs := make(map[common.Address]struct{}, len(args.ToAddress))
+	for _, addr := range args.ToAddress {
+		toAddrs[addr] = struct{}{}
+	}
+
+	var after uint64
+	if args.After != nil {
+		after = *args.After
+	}
+
+	traces := []*Trace{}
+	for height := from; height <= to; height++ {
+		blockTraces, err := api.traceBlock(height)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if !trace.matches(fromAddrs, toAddrs) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) == *args.Count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+// traceBlock returns the flat call traces of all the transactions of the block
+// at the given height.
+func (api *API) traceBlock(height int64) ([]*Trace, error) {
+	resBlock, err := api.backend.CometBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+
+	results, err := api.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), newFlatCallTraceConfig(), resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []*Trace{}
+	for i, result := range results {
+		if result == nil {
+			continue
+		}
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %d of block %d: %s", i, height, result.Error)
+		}
+
+		var txTraces []*Trace
+		if err := decodeTraces(result.Result, &txTraces); err != nil {
+			return nil, err
+		}
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// resolveHeight returns the height of the given block number, resolving the
+// latest and pending tags to the latest block height and the earliest tag to
+// the first block.
+func (api *API) resolveHeight(blockNr rpctypes.BlockNumber) (int64, error) {
+	switch blockNr {
+	case rpctypes.EthEarliestBlockNumber:
+		return 1, nil
+	case rpctypes.EthLatestBlockNumber, rpctypes.EthPendingBlockNumber:
+		latest, err := api.backend.BlockNumber()
+		if err != nil {
+			return 0, err
+		}
+		return int64(latest), nil //nolint:gosec // G115 // won't exceed int64
+	default:
+		if blockNr < 0 {
+			return 0, fmt.Errorf("invalid block number %d", blockNr)
+		}
+		return blockNr.Int64(), nil
+	}
+}
+
+func newFlatCallTraceConfig() *rpctypes.TraceConfig {
+	return &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: flatCallTracer},
+		TracerConfig: json.RawMessage(flatCallTracerConfig),
+	}
+}
+
+// decodeTraces decodes the generic tracer result into the given traces.
+func decodeTraces(result interface{}, traces *[]*Trace) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, traces)
+}
@@ -0,0 +1,284 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	comettypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+type MockBackend struct {
+	mock.Mock
+}
+
+func (m *MockBackend) BlockNumber() (hexutil.Uint64, error) {
+	args := m.Called()
+	return args.Get(0).(hexutil.Uint64), args.Error(1)
+}
+
+func (m *MockBackend) CometBlockByNumber(blockNum rpctypes.BlockNumber) (*cmtrpctypes.ResultBlock, error) {
+	args := m.Called(blockNum)
+	return args.Get(0).(*cmtrpctypes.ResultBlock), args.Error(1)
+}
+
+func (m *MockBackend) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error) {
+	args := m.Called(hash, config)
+	return args.Get(0), args.Error(1)
+}
+
+func (m *MockBackend) TraceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *cmtrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error) {
+	args := m.Called(height, config, block)
+	return args.Get(0).([]*evmtypes.TxTraceResult), args.Error(1)
+}
+
+func (m *MockBackend) RPCBlockRangeCap() int32 {
+	args := m.Called()
+	return args.Get(0).(int32)
+}
+
+var (
+	sender   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	created  = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	staking  = common.HexToAddress(evmtypes.StakingPrecompileAddress)
+)
+
+// callTraces returns the generic flat traces of a call from the sender to the
+// contract, which calls into the staking precompile.
+func callTraces(t *testing.T, height int64) interface{} {
+	t.Helper()
+	return decodeJSON(t, fmt.Sprintf(`[
+		{"action":{"callType":"call","from":"%s","gas":"0x5208","input":"0x","to":"%s","value":"0x0"},"blockHash":null,"blockNumber":%d,"result":{"gasUsed":"0x100","output":"0x01"},"subtraces":1,"traceAddress":[],"transactionHash":null,"transactionPosition":0,"type":"call"},
+		{"action":{"callType":"call","from":"%s","gas":"0x100","input":"0x","to":"%s","value":"0x0"},"blockHash":null,"blockNumber":%d,"error":"Reverted","result":{"gasUsed":"0x10","output":"0x"},"subtraces":0,"traceAddress":[0],"transactionHash":null,"transactionPosition":0,"type":"call"}
+	]`, sender.Hex(), contract.Hex(), height, contract.Hex(), staking.Hex(), height))
+}
+
+// createTraces returns the generic flat traces of a contract creation.
+func createTraces(t *testing.T, height int64) interface{} {
+	t.Helper()
+	return decodeJSON(t, fmt.Sprintf(`[
+		{"action":{"creationMethod":"create","from":"%s","gas":"0x5208","init":"0x00","value":"0x0"},"blockHash":null,"blockNumber":%d,"result":{"address":"%s","code":"0x","gasUsed":"0x100"},"subtraces":0,"traceAddress":[],"transactionHash":null,"transactionPosition":1,"type":"create"}
+	]`, sender.Hex(), height, created.Hex()))
+}
+
+func decodeJSON(t *testing.T, data string) interface{} {
+	t.Helper()
+	var res interface{}
+	require.NoError(t, json.Unmarshal([]byte(data), &res))
+	return res
+}
+
+func registerBlock(t *testing.T, backend *MockBackend, height int64, results ...interface{}) {
+	t.Helper()
+	block := &cmtrpctypes.ResultBlock{Block: comettypes.MakeBlock(height, nil, nil, nil)}
+	backend.On("CometBlockByNumber", rpctypes.BlockNumber(height)).Return(block, nil)
+
+	txResults := make([]*evmtypes.TxTraceResult, len(results))
+	for i, result := range results {
+		txResults[i] = &evmtypes.TxTraceResult{Result: result}
+	}
+	backend.On("TraceBlock", rpctypes.BlockNumber(height), newFlatCallTraceConfig(), block).Return(txResults, nil)
+}
+
+func TestTransaction(t *testing.T) {
+	backend := new(MockBackend)
+	api := NewAPI(log.NewNopLogger(), backend)
+	hash := common.HexToHash("0x01")
+	backend.On("TraceTransaction", hash, newFlatCallTraceConfig()).Return(callTraces(t, 5), nil)
+
+	traces, err := api.Transaction(hash)
+	require.NoError(t, err)
+	require.Len(t, traces, 2)
+	require.Equal(t, "call", traces[0].Action.CallType)
+	require.Equal(t, uint64(5), traces[0].BlockNumber)
+	require.Equal(t, 1, traces[0].Subtraces)
+	require.Equal(t, staking, *traces[1].Action.To)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+	require.Equal(t, "Reverted", traces[1].Error)
+}
+
+func TestReplayTransaction(t *testing.T) {
+	hash := common.HexToHash("0x01")
+
+	testCases := []struct {
+		name       string
+		traceTypes []string
+		expTraces  int
+		expPass    bool
+	}{
+		{"pass - with trace", []string{"trace"}, 2, true},
+		{"pass - without trace", []string{}, 0, true},
+		{"fail - vm trace not supported", []string{"trace", "vmTrace"}, 0, false},
+		{"fail - invalid trace type", []string{"invalid"}, 0, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := new(MockBackend)
+			api := NewAPI(log.NewNopLogger(), backend)
+			backend.On("TraceTransaction", hash, newFlatCallTraceConfig()).Return(callTraces(t, 5), nil)
+
+			res, err := api.ReplayTransaction(hash, tc.traceTypes)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, hexutil.Bytes{0x01}, res.Output)
+			require.Len(t, res.Trace, tc.expTraces)
+			require.Nil(t, res.StateDiff)
+			require.Nil(t, res.VMTrace)
+		})
+	}
+}
+
+func TestBlock(t *testing.T) {
+	backend := new(MockBackend)
+	api := NewAPI(log.NewNopLogger(), backend)
+	registerBlock(t, backend, 1, callTraces(t, 1))
+	registerBlock(t, backend, 5, callTraces(t, 5), createTraces(t, 5))
+	backend.On("BlockNumber").Return(hexutil.Uint64(5), nil)
+
+	traces, err := api.Block(rpctypes.EthEarliestBlockNumber)
+	require.NoError(t, err)
+	require.Len(t, traces, 2)
+	require.Equal(t, uint64(1), traces[0].BlockNumber)
+
+	traces, err = api.Block(rpctypes.EthLatestBlockNumber)
+	require.NoError(t, err)
+	require.Len(t, traces, 3)
+	require.Equal(t, traceTypeCreate, traces[2].Type)
+	require.Equal(t, uint64(1), traces[2].TransactionPosition)
+
+	traces, err = api.Block(rpctypes.EthPendingBlockNumber)
+	require.NoError(t, err)
+	require.Len(t, traces, 3)
+
+	_, err = api.Block(rpctypes.BlockNumber(-3))
+	require.ErrorContains(t, err, "invalid block number")
+}
+
+func TestBlockTraceError(t *testing.T) {
+	backend := new(MockBackend)
+	api := NewAPI(log.NewNopLogger(), backend)
+	block := &cmtrpctypes.ResultBlock{Block: comettypes.MakeBlock(5, nil, nil, nil)}
+	backend.On("CometBlockByNumber", rpctypes.BlockNumber(5)).Return(block, nil)
+	backend.On("TraceBlock", rpctypes.BlockNumber(5), newFlatCallTraceConfig(), block).
+		Return([]*evmtypes.TxTraceResult{{Error: "execution timeout"}}, nil)
+
+	_, err := api.Block(5)
+	require.ErrorContains(t, err, "execution timeout")
+}
+
+func TestFilter(t *testing.T) {
+	blockNumber := func(n int64) *rpctypes.BlockNumber {
+		bn := rpctypes.BlockNumber(n)
+		return &bn
+	}
+	uint64Ptr := func(n uint64) *uint64 { return &n }
+
+	testCases := []struct {
+		name       string
+		args       FilterArgs
+		expHeights []uint64
+		expTypes   []string
+		expPass    bool
+	}{
+		{
+			"pass - all traces of the range",
+			FilterArgs{FromBlock: blockNumber(1), ToBlock: blockNumber(2)},
+			[]uint64{1, 1, 2},
+			[]string{"call", "call", "create"},
+			true,
+		},
+		{
+			"pass - default range is the latest block",
+			FilterArgs{},
+			[]uint64{2},
+			[]string{"create"},
+			true,
+		},
+		{
+			"pass - by from address",
+			FilterArgs{FromBlock: blockNumber(1), ToBlock: blockNumber(2), FromAddress: []common.Address{contract}},
+			[]uint64{1},
+			[]string{"call"},
+			true,
+		},
+		{
+			"pass - by to address of a precompile call",
+			FilterArgs{FromBlock: blockNumber(1), ToBlock: blockNumber(2), ToAddress: []common.Address{staking}},
+			[]uint64{1},
+			[]string{"call"},
+			true,
+		},
+		{
+			"pass - by to address of a created contract",
+			FilterArgs{FromBlock: blockNumber(1), ToBlock: blockNumber(2), FromAddress: []common.Address{sender}, ToAddress: []common.Address{created}},
+			[]uint64{2},
+			[]string{"create"},
+			true,
+		},
+		{
+			"pass - after and count",
+			FilterArgs{FromBlock: blockNumber(1), ToBlock: blockNumber(2), After: uint64Ptr(1), Count: uint64Ptr(1)},
+			[]uint64{1},
+			[]string{"call"},
+			true,
+		},
+		{
+			"pass - earliest to latest",
+			FilterArgs{FromBlock: blockNumber(int64(rpctypes.EthEarliestBlockNumber)), ToBlock: blockNumber(int64(rpctypes.EthLatestBlockNumber))},
+			[]uint64{1, 1, 2},
+			[]string{"call", "call", "create"},
+			true,
+		},
+		{
+			"fail - from block after to block",
+			FilterArgs{FromBlock: blockNumber(2), ToBlock: blockNumber(1)},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - block range cap exceeded",
+			FilterArgs{FromBlock: blockNumber(1), ToBlock: blockNumber(20)},
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := new(MockBackend)
+			api := NewAPI(log.NewNopLogger(), backend)
+			backend.On("BlockNumber").Return(hexutil.Uint64(2), nil)
+			backend.On("RPCBlockRangeCap").Return(int32(10))
+			registerBlock(t, backend, 1, callTraces(t, 1))
+			registerBlock(t, backend, 2, createTraces(t, 2))
+
+			traces, err := api.Filter(tc.args)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, traces, len(tc.expTypes))
+			for i, trace := range traces {
+				require.Equal(t, tc.expHeights[i], trace.BlockNumber)
+				require.Equal(t, tc.expTypes[i], trace.Type)
+			}
+		})
+	}
+}
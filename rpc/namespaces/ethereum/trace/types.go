@@ -0,0 +1,120 @@
+package trace
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// Action is the action of a Parity-style flat trace. Depending on the trace
+// type, it describes a call, a contract creation or a self-destruct.
+type Action struct {
+	CallType       string          `json:"callType,omitempty"`
+	CreationMethod string          `json:"creationMethod,omitempty"`
+	From           *common.Address `json:"from,omitempty"`
+	To             *common.Address `json:"to,omitempty"`
+	Gas            *hexutil.Uint64 `json:"gas,omitempty"`
+	Input          *hexutil.Bytes  `json:"input,omitempty"`
+	Init           *hexutil.Bytes  `json:"init,omitempty"`
+	Value          *hexutil.Big    `json:"value,omitempty"`
+	SelfDestructed *common.Address `json:"address,omitempty"`
+	RefundAddress  *common.Address `json:"refundAddress,omitempty"`
+	Balance        *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// Result is the result of a Parity-style flat trace.
+type Result struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// Trace is a Parity-style flat call trace, as returned by trace_transaction,
+// trace_block and trace_filter.
+type Trace struct {
+	Action              Action       `json:"action"`
+	BlockHash           *common.Hash `json:"blockHash"`
+	BlockNumber         uint64       `json:"blockNumber"`
+	Error               string       `json:"error,omitempty"`
+	Result              *Result      `json:"result,omitempty"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash"`
+	TransactionPosition uint64       `json:"transactionPosition"`
+	Type                string       `json:"type"`
+}
+
+// ReplayTrace is a flat call trace of a replayed transaction, which doesn't
+// include the block and transaction information.
+type ReplayTrace struct {
+	Action       Action  `json:"action"`
+	Error        string  `json:"error,omitempty"`
+	Result       *Result `json:"result,omitempty"`
+	Subtraces    int     `json:"subtraces"`
+	TraceAddress []int   `json:"traceAddress"`
+	Type         string  `json:"type"`
+}
+
+// ReplayResults is the result of trace_replayTransaction. Only the `trace`
+// trace type is supported, so StateDiff and VMTrace are always empty.
+type ReplayResults struct {
+	Output          hexutil.Bytes  `json:"output"`
+	StateDiff       interface{}    `json:"stateDiff"`
+	Trace           []*ReplayTrace `json:"trace"`
+	VMTrace         interface{}    `json:"vmTrace"`
+	TransactionHash *common.Hash   `json:"transactionHash,omitempty"`
+}
+
+// FilterArgs are the arguments of trace_filter.
+type FilterArgs struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	After       *uint64               `json:"after"`
+	Count       *uint64               `json:"count"`
+}
+
+// sender returns the address the trace originates from.
+func (t *Trace) sender() *common.Address {
+	if t.Type == traceTypeSuicide {
+		return t.Action.SelfDestructed
+	}
+	return t.Action.From
+}
+
+// recipient returns the address the trace is directed to. For contract
+// creations it is the address of the created contract.
+func (t *Trace) recipient() *common.Address {
+	switch t.Type {
+	case traceTypeCreate:
+		if t.Result != nil {
+			return t.Result.Address
+		}
+		return nil
+	case traceTypeSuicide:
+		return t.Action.RefundAddress
+	default:
+		return t.Action.To
+	}
+}
+
+// matches returns true if the sender of the trace is one of the given from
+// addresses and its recipient one of the given to addresses. An empty set
+// matches any address.
+func (t *Trace) matches(from, to map[common.Address]struct{}) bool {
+	return matchesAddress(t.sender(), from) && matchesAddress(t.recipient(), to)
+}
+
+func matchesAddress(addr *common.Address, set map[common.Address]struct{}) bool {
+	if len(set) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	_, ok := set[*addr]
+	return ok
+}
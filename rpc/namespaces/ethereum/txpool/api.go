@@ -11,7 +11,7 @@ import (
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The content is read from the unconfirmed transactions held by the CometBFT mempool.
 type PublicAPI struct {
 	logger  log.Logger
 	backend backend.EVMBackend
@@ -161,8 +161,13 @@ func (s *RPCStream) start(
 			}
 
 			baseFee := types.BaseFeeFromEvents(data.ResultFinalizeBlock.Events)
+			receiptsRoot, err := types.ReceiptsRoot(s.txDecoder, data.Block, data.ResultFinalizeBlock.TxResults)
+			if err != nil {
+				s.logger.Error("failed to compute receipts root", "height", data.Block.Height, "error", err.Error())
+				continue
+			}
 			// TODO: fetch bloom from events
-			header := types.EthHeaderFromComet(data.Block.Header, ethtypes.Bloom{}, baseFee)
+			header := types.EthHeaderFromComet(data.Block.Header, ethtypes.Bloom{}, receiptsRoot, baseFee)
 			s.headerStream.Add(RPCHeader{EthHeader: header, Hash: common.BytesToHash(data.Block.Header.Hash())})
 
 		case ev, ok := <-chLogs:
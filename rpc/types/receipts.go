@@ -0,0 +1,92 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockReceipts returns the consensus fields of the receipts of the eth txs of
+// a block, the way they are served by eth_getTransactionReceipt:
+// - the txs that failed with an unexpected error are skipped, like in the tx indexer
+// - the cumulative gas used includes the gas of all the previous cosmos txs of the block
+// - the txs that exceeded the block gas limit are charged their gas limit and have no logs
+func BlockReceipts(txDecoder sdk.TxDecoder, block *cmttypes.Block, txResults []*abci.ExecTxResult) (ethtypes.Receipts, error) {
+	var (
+		receipts ethtypes.Receipts
+		blockGas uint64
+	)
+	for txIndex, txBz := range block.Txs {
+		result := txResults[txIndex]
+		prevBlockGas := blockGas
+		blockGas += uint64(result.GasUsed) //nolint:gosec // G115 // gas used is never negative
+
+		if !TxSucessOrExpectedFailure(result) {
+			continue
+		}
+
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			continue
+		}
+
+		var txs *ParsedTxs
+		if result.Code == abci.CodeTypeOK {
+			if txs, err = ParseTxResult(result, tx); err != nil {
+				return nil, errorsmod.Wrapf(err, "parse result of tx %d", txIndex)
+			}
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			receipt := &ethtypes.Receipt{
+				Type:   ethMsg.AsTransaction().Type(),
+				Status: ethtypes.ReceiptStatusSuccessful,
+			}
+			if txs == nil {
+				// exceeds block gas limit scenario, the gas limit is charged by the ante handler
+				cumulativeGasUsed += ethMsg.GetGas()
+				receipt.Status = ethtypes.ReceiptStatusFailed
+			} else {
+				parsedTx := txs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					continue
+				}
+				cumulativeGasUsed += parsedTx.GasUsed
+				if parsedTx.Failed {
+					receipt.Status = ethtypes.ReceiptStatusFailed
+				}
+				// like in the served receipts, a tx without log event has no logs
+				receipt.Logs, _ = evmtypes.TxLogsFromEvents(result.Events, msgIndex)
+			}
+
+			receipt.CumulativeGasUsed = prevBlockGas + cumulativeGasUsed
+			receipt.Bloom = ethtypes.CreateBloom(receipt)
+			receipts = append(receipts, receipt)
+		}
+	}
+	return receipts, nil
+}
+
+// ReceiptsRoot returns the root hash of the receipts trie of a block.
+func ReceiptsRoot(txDecoder sdk.TxDecoder, block *cmttypes.Block, txResults []*abci.ExecTxResult) (common.Hash, error) {
+	receipts, err := BlockReceipts(txDecoder, block, txResults)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)), nil
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testTx is a minimal sdk.Tx wrapping the given messages.
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func newTestEthMsg(nonce, gas uint64) *evmtypes.MsgEthereumTx {
+	msg := &evmtypes.MsgEthereumTx{}
+	msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     nonce,
+		Gas:       gas,
+		GasFeeCap: big.NewInt(1),
+		GasTipCap: big.NewInt(1),
+	}))
+	return msg
+}
+
+func TestBlockReceipts(t *testing.T) {
+	msg1, msg2, msg3 := newTestEthMsg(0, 30000), newTestEthMsg(1, 30000), newTestEthMsg(2, 50000)
+	txs := map[string]sdk.Tx{
+		"invalid":  testTx{msgs: []sdk.Msg{msg1}},
+		"multi":    testTx{msgs: []sdk.Msg{msg1, msg2}},
+		"gaslimit": testTx{msgs: []sdk.Msg{msg3}},
+	}
+	decoder := func(bz []byte) (sdk.Tx, error) {
+		tx, ok := txs[string(bz)]
+		if !ok {
+			return nil, errors.New("unknown tx")
+		}
+		return tx, nil
+	}
+
+	ethLog := &ethtypes.Log{
+		Address: common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		Topics:  []common.Hash{common.HexToHash("0x01")},
+		Data:    []byte{0x02},
+	}
+	logBz, err := json.Marshal(evmtypes.NewLogFromEth(ethLog))
+	require.NoError(t, err)
+
+	block := cmttypes.MakeBlock(1, []cmttypes.Tx{[]byte("invalid"), []byte("unknown"), []byte("multi"), []byte("gaslimit")}, nil, nil)
+	txResults := []*abci.ExecTxResult{
+		{Code: 15, GasUsed: 100},
+		{Code: 0, GasUsed: 200},
+		{
+			Code:    0,
+			GasUsed: 42000,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyEthereumTxHash, Value: msg1.Hash().Hex()},
+					{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+					{Key: evmtypes.AttributeKeyTxGasUsed, Value: "21000"},
+				}},
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyEthereumTxHash, Value: msg2.Hash().Hex()},
+					{Key: evmtypes.AttributeKeyTxIndex, Value: "1"},
+					{Key: evmtypes.AttributeKeyTxGasUsed, Value: "21000"},
+					{Key: evmtypes.AttributeKeyEthereumTxFailed, Value: "execution reverted"},
+				}},
+				{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyTxLog, Value: string(logBz)},
+				}},
+				{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{}},
+			},
+		},
+		{Code: 11, GasUsed: 50000, Log: ExceedBlockGasLimitError + " 50000"},
+	}
+
+	receipts, err := BlockReceipts(decoder, block, txResults)
+	require.NoError(t, err)
+	require.Len(t, receipts, 3)
+
+	// the gas of the txs skipped in the block is still cumulated
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipts[0].Status)
+	require.Equal(t, uint64(300+21000), receipts[0].CumulativeGasUsed)
+	require.Len(t, receipts[0].Logs, 1)
+	require.Equal(t, ethLog.Address, receipts[0].Logs[0].Address)
+	require.True(t, receipts[0].Bloom.Test(ethLog.Address.Bytes()))
+
+	require.Equal(t, ethtypes.ReceiptStatusFailed, receipts[1].Status)
+	require.Equal(t, uint64(300+42000), receipts[1].CumulativeGasUsed)
+	require.Empty(t, receipts[1].Logs)
+
+	// the tx exceeding the block gas limit is charged its gas limit
+	require.Equal(t, ethtypes.ReceiptStatusFailed, receipts[2].Status)
+	require.Equal(t, uint64(300+42000+50000), receipts[2].CumulativeGasUsed)
+	require.Equal(t, uint8(ethtypes.DynamicFeeTxType), receipts[2].Type)
+
+	root, err := ReceiptsRoot(decoder, block, txResults)
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)), root)
+	require.NotEqual(t, ethtypes.EmptyRootHash, root)
+
+	root, err = ReceiptsRoot(decoder, cmttypes.MakeBlock(1, nil, nil, nil), nil)
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.EmptyRootHash, root)
+}
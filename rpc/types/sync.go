@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// SyncStatus is the sync progress of a node catching up with the network,
+// using the same field names as go-ethereum.
+type SyncStatus struct {
+	// StartingBlock is the block number this node started to synchronize from
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	// CurrentBlock is the block number this node is currently importing
+	CurrentBlock hexutil.Uint64 `json:"currentBlock"`
+	// HighestBlock is the estimated block number of the network head
+	HighestBlock hexutil.Uint64 `json:"highestBlock"`
+}
+
+// SyncingResult is the notification sent to the syncing subscribers while the
+// node is catching up. Once the node is synced, false is sent instead.
+type SyncingResult struct {
+	Syncing bool        `json:"syncing"`
+	Status  *SyncStatus `json:"status"`
+}
+
+// NewSyncStatus returns the sync progress of the node from the CometBFT sync
+// info, or nil if the node is not catching up.
+//
+// CometBFT doesn't expose the height of the network head, so the highest block
+// is estimated from the time elapsed since the latest block, using the average
+// block time of the blocks stored by the node.
+func NewSyncStatus(info cmtrpctypes.SyncInfo, now time.Time) *SyncStatus {
+	if !info.CatchingUp {
+		return nil
+	}
+
+	current := uint64(info.LatestBlockHeight) //nolint:gosec // G115 // won't exceed uint64
+	highest := current
+
+	blocks := info.LatestBlockHeight - info.EarliestBlockHeight
+	elapsed := info.LatestBlockTime.Sub(info.EarliestBlockTime)
+	if behind := now.Sub(info.LatestBlockTime); blocks > 0 && elapsed > 0 && behind > 0 {
+		blockTime := elapsed / time.Duration(blocks)
+		if blockTime > 0 {
+			highest += uint64(behind / blockTime) //nolint:gosec // G115 // positive duration
+		}
+	}
+
+	return &SyncStatus{
+		StartingBlock: hexutil.Uint64(info.EarliestBlockHeight), //nolint:gosec // G115 // won't exceed uint64
+		CurrentBlock:  hexutil.Uint64(current),
+		HighestBlock:  hexutil.Uint64(highest),
+	}
+}
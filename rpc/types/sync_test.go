@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+func TestNewSyncStatus(t *testing.T) {
+	now := time.Unix(10_000, 0)
+
+	testCases := []struct {
+		msg       string
+		info      cmtrpctypes.SyncInfo
+		expStatus *SyncStatus
+	}{
+		{
+			"not catching up",
+			cmtrpctypes.SyncInfo{LatestBlockHeight: 100, CatchingUp: false},
+			nil,
+		},
+		{
+			"catching up without block times",
+			cmtrpctypes.SyncInfo{EarliestBlockHeight: 1, LatestBlockHeight: 100, CatchingUp: true},
+			&SyncStatus{StartingBlock: 1, CurrentBlock: 100, HighestBlock: 100},
+		},
+		{
+			"catching up with the estimated network head",
+			cmtrpctypes.SyncInfo{
+				EarliestBlockHeight: 1,
+				EarliestBlockTime:   time.Unix(0, 0),
+				LatestBlockHeight:   101,
+				LatestBlockTime:     time.Unix(500, 0),
+				CatchingUp:          true,
+			},
+			// 5s block time and 9500s behind
+			&SyncStatus{StartingBlock: 1, CurrentBlock: 101, HighestBlock: 2001},
+		},
+		{
+			"catching up with a single block",
+			cmtrpctypes.SyncInfo{
+				EarliestBlockHeight: 1,
+				EarliestBlockTime:   time.Unix(0, 0),
+				LatestBlockHeight:   1,
+				LatestBlockTime:     time.Unix(0, 0),
+				CatchingUp:          true,
+			},
+			&SyncStatus{StartingBlock: 1, CurrentBlock: 1, HighestBlock: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			require.Equal(t, tc.expStatus, NewSyncStatus(tc.info, now))
+		})
+	}
+}
@@ -60,21 +60,15 @@ type RPCTransaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
-}
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of header fields to override during the execution of
+// a message call.
+type BlockOverrides = evmtypes.BlockOverrides
 
 type FeeHistoryResult struct {
 	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
@@ -89,6 +83,15 @@ type SignTransactionResult struct {
 	Tx  *ethtypes.Transaction `json:"tx"`
 }
 
+// AccessListResult returns an optional access list
+// It's the result of the `eth_createAccessList` RPC call.
+// It contains an error if the transaction itself failed.
+type AccessListResult struct {
+	Accesslist *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
 type OneFeeHistory struct {
 	BaseFee, NextBaseFee *big.Int   // base fee for each block
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
@@ -100,3 +103,27 @@ type TraceConfig struct {
 	evmtypes.TraceConfig
 	TracerConfig json.RawMessage `json:"tracerConfig"`
 }
+
+// TraceCallConfig is the config for the `debug_traceCall` RPC call. It extends
+// the TraceConfig with the state and block overrides applied before tracing.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// StorageRangeResult is the result of the `debug_storageRangeAt` RPC call. The
+// storage entries are keyed by the hash of their slot, like in go-ethereum.
+type StorageRangeResult struct {
+	Storage StorageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"` // nil if Storage includes the last key in the storage
+}
+
+// StorageMap maps the hash of the storage slots to their entries.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry is a storage slot of an account and its value.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
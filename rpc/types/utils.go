@@ -52,8 +52,9 @@ func RawTxToEthTx(clientCtx client.Context, txBz cmttypes.Tx) ([]*evmtypes.MsgEt
 }
 
 // EthHeaderFromComet is an util function that returns an Ethereum Header
-// from a CometBFT Header.
-func EthHeaderFromComet(header cmttypes.Header, bloom ethtypes.Bloom, baseFee *big.Int) *ethtypes.Header {
+// from a CometBFT Header. The state root is the CometBFT app hash, which
+// commits to the state resulting from the previous block.
+func EthHeaderFromComet(header cmttypes.Header, bloom ethtypes.Bloom, receiptsRoot common.Hash, baseFee *big.Int) *ethtypes.Header {
 	txHash := ethtypes.EmptyRootHash
 	if len(header.DataHash) != 0 {
 		txHash = common.BytesToHash(header.DataHash)
@@ -66,7 +67,7 @@ func EthHeaderFromComet(header cmttypes.Header, bloom ethtypes.Bloom, baseFee *b
 		Coinbase:    common.BytesToAddress(header.ProposerAddress),
 		Root:        common.BytesToHash(header.AppHash),
 		TxHash:      txHash,
-		ReceiptHash: ethtypes.EmptyRootHash,
+		ReceiptHash: receiptsRoot,
 		Bloom:       bloom,
 		Difficulty:  big.NewInt(0),
 		Number:      big.NewInt(header.Height),
@@ -74,7 +75,7 @@ func EthHeaderFromComet(header cmttypes.Header, bloom ethtypes.Bloom, baseFee *b
 		GasUsed:     0,
 		Time:        time,
 		Extra:       []byte{},
-		MixDigest:   common.Hash{},
+		MixDigest:   evmtypes.PrevRandao(header.Hash()),
 		Nonce:       ethtypes.BlockNonce{},
 		BaseFee:     baseFee,
 	}
@@ -108,7 +109,7 @@ func BlockMaxGasFromConsensusParams(goCtx context.Context, clientCtx client.Cont
 func FormatBlock(
 	header cmttypes.Header, size int, gasLimit int64,
 	gasUsed *big.Int, transactions []interface{}, bloom ethtypes.Bloom,
-	validatorAddr common.Address, baseFee *big.Int,
+	receiptsRoot common.Hash, validatorAddr common.Address, baseFee *big.Int,
 ) map[string]interface{} {
 	var transactionsRoot common.Hash
 	if len(transactions) == 0 {
@@ -126,7 +127,7 @@ func FormatBlock(
 		"logsBloom":        bloom,
 		"stateRoot":        hexutil.Bytes(header.AppHash),
 		"miner":            validatorAddr,
-		"mixHash":          common.Hash{},
+		"mixHash":          evmtypes.PrevRandao(header.Hash()),
 		"difficulty":       (*hexutil.Big)(big.NewInt(0)),
 		"extraData":        "0x",
 		"size":             hexutil.Uint64(size),     //nolint:gosec // G115 // size won't exceed uint64
@@ -134,7 +135,7 @@ func FormatBlock(
 		"gasUsed":          (*hexutil.Big)(gasUsed),
 		"timestamp":        hexutil.Uint64(header.Time.Unix()), //nolint:gosec // G115 // won't exceed uint64
 		"transactionsRoot": transactionsRoot,
-		"receiptsRoot":     ethtypes.EmptyRootHash,
+		"receiptsRoot":     receiptsRoot,
 
 		"uncles":          []common.Hash{},
 		"transactions":    transactions,
@@ -148,6 +149,61 @@ func FormatBlock(
 	return result
 }
 
+// FormatSimulatedBlock creates an ethereum block from a block simulated by
+// eth_simulateV1, together with the results of its calls.
+func FormatSimulatedBlock(block evmtypes.SimBlockResult, fullTx bool, chainID *big.Int) (map[string]interface{}, error) {
+	header := block.Header
+	blockHash := header.Hash()
+
+	transactions := make([]interface{}, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		if !fullTx {
+			transactions[i] = tx.Hash()
+			continue
+		}
+
+		msg := &evmtypes.MsgEthereumTx{}
+		msg.FromEthereumTx(tx)
+		// the simulated transactions are unsigned
+		msg.From = block.Senders[i].Bytes()
+		rpcTx, err := NewRPCTransaction(msg, blockHash, header.Number.Uint64(), uint64(i), header.BaseFee, chainID)
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = rpcTx
+	}
+
+	result := map[string]interface{}{
+		"number":           (*hexutil.Big)(header.Number),
+		"hash":             blockHash,
+		"parentHash":       header.ParentHash,
+		"nonce":            header.Nonce,
+		"sha3Uncles":       header.UncleHash,
+		"logsBloom":        header.Bloom,
+		"stateRoot":        header.Root,
+		"miner":            header.Coinbase,
+		"mixHash":          header.MixDigest,
+		"difficulty":       (*hexutil.Big)(header.Difficulty),
+		"extraData":        hexutil.Bytes(header.Extra),
+		"size":             hexutil.Uint64(header.Size()),
+		"gasLimit":         hexutil.Uint64(header.GasLimit),
+		"gasUsed":          hexutil.Uint64(header.GasUsed),
+		"timestamp":        hexutil.Uint64(header.Time),
+		"transactionsRoot": header.TxHash,
+		"receiptsRoot":     header.ReceiptHash,
+		"uncles":           []common.Hash{},
+		"transactions":     transactions,
+		"totalDifficulty":  (*hexutil.Big)(big.NewInt(0)),
+		"calls":            block.Calls,
+	}
+
+	if header.BaseFee != nil {
+		result["baseFeePerGas"] = (*hexutil.Big)(header.BaseFee)
+	}
+
+	return result, nil
+}
+
 // NewTransactionFromMsg returns a transaction that will serialize to the RPC
 // representation, with the given location metadata set (if available).
 func NewTransactionFromMsg(
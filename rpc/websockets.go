@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -25,6 +26,7 @@ import (
 
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 
 	"cosmossdk.io/log"
@@ -34,6 +36,10 @@ import (
 
 const (
 	maxMessageSize = 1 << 20 // 1 MiB is the max message size for the websocket server
+
+	// syncingCheckInterval is the interval at which the node sync status is
+	// checked for the syncing subscriptions
+	syncingCheckInterval = 5 * time.Second
 )
 
 type WebsocketsServer interface {
@@ -659,8 +665,70 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// subscribeSyncing notifies the subscriber with the sync status of the node
+// when it starts or stops catching up, and with the sync progress while it's
+// catching up. The current status is sent right after subscribing.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(syncingCheckInterval)
+		defer ticker.Stop()
+
+		var (
+			notified bool
+			last     *rpctypes.SyncStatus
+		)
+		for {
+			status, err := api.clientCtx.Client.Status(ctx)
+			if err != nil {
+				api.logger.Debug("failed to fetch node status", "error", err.Error())
+			} else if syncStatus := rpctypes.NewSyncStatus(status.SyncInfo, time.Now()); !notified || syncStatusChanged(last, syncStatus) {
+				var result interface{} = false
+				if syncStatus != nil {
+					result = &rpctypes.SyncingResult{Syncing: true, Status: syncStatus}
+				}
+
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       result,
+					},
+				}
+
+				if err := wsConn.WriteJSON(res); err != nil {
+					api.logger.Debug("error writing sync status, will drop peer", "error", err.Error())
+
+					try(func() {
+						if err != websocket.ErrCloseSent {
+							_ = wsConn.Close()
+						}
+					}, api.logger, "closing websocket peer sub")
+					return
+				}
+				notified, last = true, syncStatus
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return cancel, nil
+}
+
+// syncStatusChanged returns true if the node started or stopped catching up,
+// or imported new blocks while catching up.
+func syncStatusChanged(last, current *rpctypes.SyncStatus) bool {
+	if last == nil || current == nil {
+		return last != current
+	}
+	return last.CurrentBlock != current.CurrentBlock
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
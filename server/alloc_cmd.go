@@ -0,0 +1,91 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cosmos/cosmos-db"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	srvflags "github.com/cosmos/evm/server/flags"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// AllocExporter is a function that loads the application at the given height
+// (-1 means the latest height) and writes its EVM state to w as a geth genesis
+// allocation.
+type AllocExporter func(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	appOpts types.AppOptions,
+	w io.Writer,
+) error
+
+// NewExportAllocCmd creates a new Cobra command to export the EVM state as a
+// geth genesis allocation.
+func NewExportAllocCmd(allocExporter AllocExporter, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-alloc",
+		Short: "Export the EVM state to a geth genesis allocation file",
+		Long: `Export the code, storage, balance and nonce of every EVM account to a geth genesis
+allocation file, that can be loaded into other EVM tools such as geth, Anvil or Hardhat.
+The accounts are streamed from the application state at the given height.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			db, err := cosmosevmserverconfig.OpenReadOnlyDB(config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			traceWriterFile, _ := cmd.Flags().GetString(srvflags.TraceStore)
+			traceWriter, err := openTraceWriter(traceWriterFile)
+			if err != nil {
+				return err
+			}
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+
+			out := cmd.OutOrStdout()
+			if outputDocument != "" {
+				f, err := os.Create(filepath.Clean(outputDocument))
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+
+			w := bufio.NewWriter(out)
+			if err := allocExporter(serverCtx.Logger, db, traceWriter, height, serverCtx.Viper, w); err != nil {
+				return fmt.Errorf("error exporting EVM state: %w", err)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported allocation is written to the given file instead of STDOUT")
+	cmd.Flags().String(srvflags.TraceStore, "", "Enable KVStore tracing to an output file")
+
+	return cmd
+}
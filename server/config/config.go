@@ -63,6 +63,14 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultMempoolPriceBump is the default minimum price bump percentage to replace an already
+	// existing transaction with the same nonce in the mempool
+	DefaultMempoolPriceBump uint64 = 10
+
+	// DefaultMempoolAccountQueue is the default maximum number of non-executable transactions
+	// held in the mempool per account
+	DefaultMempoolAccountQueue uint64 = 64
+
 	// DefaultEVMChainID is the default EVM Chain ID if one is not provided
 	DefaultEVMChainID = 262144
 
@@ -118,9 +126,21 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// IndexerBackendKV stores the indexed EVM txs in a key-value db
+	IndexerBackendKV = "kv"
+
+	// IndexerBackendSQLite stores the indexed EVM txs, receipts and logs in relational tables of an SQLite db
+	IndexerBackendSQLite = "sqlite"
+
+	// DefaultIndexerBackend is the default backend of the custom indexer
+	DefaultIndexerBackend = IndexerBackendKV
 )
 
-var evmTracers = []string{"json", "markdown", "struct", "access_list"}
+var (
+	evmTracers      = []string{"json", "markdown", "struct", "access_list"}
+	indexerBackends = []string{IndexerBackendKV, IndexerBackendSQLite}
+)
 
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
@@ -143,6 +163,12 @@ type EVMConfig struct {
 	EnablePreimageRecording bool `mapstructure:"cache-preimage"`
 	// EVMChainID defines the EIP-155 replay-protection chain ID.
 	EVMChainID uint64 `mapstructure:"evm-chain-id"`
+	// MempoolPriceBump defines the minimum price bump percentage to replace an already existing
+	// transaction with the same nonce in the mempool.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// MempoolAccountQueue defines the maximum number of non-executable (nonce gapped)
+	// transactions held in the mempool per account.
+	MempoolAccountQueue uint64 `mapstructure:"mempool-account-queue"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -187,6 +213,10 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableLogIndexer defines if the custom indexer also indexes the logs by address and topic.
+	EnableLogIndexer bool `mapstructure:"enable-log-indexer"`
+	// IndexerBackend defines the storage backend of the custom indexer.
+	IndexerBackend string `mapstructure:"indexer-backend"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -195,6 +225,9 @@ type JSONRPCConfig struct {
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// EnableMPTProofs defines if `eth_getProof` serves the storage hash and storage proofs of the
+	// Ethereum storage trie of the account, computed on demand, instead of the IAVL proofs.
+	EnableMPTProofs bool `mapstructure:"enable-mpt-proofs"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -212,6 +245,8 @@ func DefaultEVMConfig() *EVMConfig {
 		MaxTxGasWanted:          DefaultMaxTxGasWanted,
 		EVMChainID:              DefaultEVMChainID,
 		EnablePreimageRecording: DefaultEnablePreimageRecording,
+		MempoolPriceBump:        DefaultMempoolPriceBump,
+		MempoolAccountQueue:     DefaultMempoolAccountQueue,
 	}
 }
 
@@ -231,7 +266,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -261,10 +296,13 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		EnableLogIndexer:         false,
+		IndexerBackend:           DefaultIndexerBackend,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 		WSOrigins:                GetDefaultWSOrigins(),
 		EnableProfiling:          DefaultEnableProfiling,
+		EnableMPTProofs:          false,
 	}
 }
 
@@ -306,6 +344,14 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.EnableLogIndexer && !c.EnableIndexer {
+		return errors.New("JSON-RPC log indexer cannot be enabled without the indexer")
+	}
+
+	if c.EnableIndexer && !strings.StringInSlice(c.IndexerBackend, indexerBackends) {
+		return fmt.Errorf("invalid JSON-RPC indexer backend %s, available backends: %v", c.IndexerBackend, indexerBackends)
+	}
+
 	if c.BatchRequestLimit < 0 {
 		return errors.New("JSON-RPC batch request limit cannot be negative")
 	}
@@ -69,3 +69,55 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRPCConfigValidateIndexer(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *serverconfig.JSONRPCConfig)
+		expErr   string
+	}{
+		{
+			"pass - kv indexer with log index",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.EnableIndexer = true
+				cfg.EnableLogIndexer = true
+			},
+			"",
+		},
+		{
+			"pass - sqlite indexer",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.EnableIndexer = true
+				cfg.IndexerBackend = serverconfig.IndexerBackendSQLite
+			},
+			"",
+		},
+		{
+			"fail - log index without indexer",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.EnableLogIndexer = true
+			},
+			"log indexer cannot be enabled without the indexer",
+		},
+		{
+			"fail - unknown indexer backend",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.EnableIndexer = true
+				cfg.IndexerBackend = "postgres"
+			},
+			"invalid JSON-RPC indexer backend postgres",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+			err := cfg.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
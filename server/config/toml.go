@@ -16,12 +16,22 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
-# EnablePreimageRecording enables tracking of SHA3 preimages in the VM
+# EnablePreimageRecording enables tracking of SHA3 preimages in the VM. The preimages seen while
+# executing the blocks are stored in the node-local data/evmpreimages.db, served by debug_preimage
+# and used by the dump-storage command to resolve the storage slots of the mappings.
 cache-preimage = {{ .EVM.EnablePreimageRecording }}
 
 # EVMChainID is the EIP-155 compatible replay protection chain ID. This is separate from the Cosmos chain ID.
 evm-chain-id = {{ .EVM.EVMChainID }}
 
+# MempoolPriceBump defines the minimum price bump percentage to replace an already existing transaction
+# with the same nonce in the mempool.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
+# MempoolAccountQueue defines the maximum number of non-executable (nonce gapped) transactions held in
+# the mempool per account. These transactions are promoted once the missing nonces are received.
+mempool-account-queue = {{ .EVM.MempoolAccountQueue }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -86,6 +96,24 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableLogIndexer enables the secondary index of the EVM logs by address and topic in the custom
+# indexer, so that eth_getLogs queries over the indexed blocks don't scan the block results.
+# Queries served by the index are still bound by the block-range-cap. Requires enable-indexer.
+# The sqlite indexer backend always stores the logs, but only serves eth_getLogs from them when enabled.
+enable-log-indexer = {{ .JSONRPC.EnableLogIndexer }}
+
+# IndexerBackend defines the storage of the custom indexer. Available backends: kv, sqlite.
+# The sqlite backend stores the EVM txs, receipts and logs in relational tables of the
+# data/evmindexer.sqlite file, which can be queried with SQL.
+indexer-backend = "{{ .JSONRPC.IndexerBackend }}"
+
+# EnableMPTProofs makes eth_getProof serve the storage hash and the EIP-1186 storage proofs of the
+# Ethereum Merkle-Patricia storage trie of the account, so that they can be verified by Ethereum
+# tooling. The trie is computed on demand from the storage of the account, which is costly for
+# contracts with a large storage. The account proof remains the IAVL proof of the auth store.
+# The StorageProof gRPC query used to build the proofs is only served when enabled.
+enable-mpt-proofs = {{ .JSONRPC.EnableMPTProofs }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -54,9 +54,12 @@ const (
 	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
+	JSONRPCEnableLogIndexer     = "json-rpc.enable-log-indexer"
+	JSONRPCIndexerBackend       = "json-rpc.indexer-backend"
 	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
 	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
 	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCEnableMPTProofs      = "json-rpc.enable-mpt-proofs"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -70,6 +73,8 @@ const (
 	EVMMaxTxGasWanted          = "evm.max-tx-gas-wanted"
 	EVMEnablePreimageRecording = "evm.cache-preimage"
 	EVMChainID                 = "evm.evm-chain-id"
+	EVMMempoolPriceBump        = "evm.mempool-price-bump"
+	EVMMempoolAccountQueue     = "evm.mempool-account-queue"
 )
 
 // TLS flags
@@ -10,6 +10,8 @@ import (
 	cmtstore "github.com/cometbft/cometbft/store"
 
 	"github.com/cosmos/evm/indexer"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	srvflags "github.com/cosmos/evm/server/flags"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -26,6 +28,10 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		The indexer backend is read from the json-rpc.indexer-backend config.
+		If the log indexer is enabled, the logs are indexed too and the traverse starts from the first or the latest
+		block of the log index instead, so that it backfills the log index of the blocks indexed before it was enabled.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -43,12 +49,28 @@ func NewIndexTxCmd() *cobra.Command {
 			cfg := serverCtx.Config
 			home := cfg.RootDir
 			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			evmCfg, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			idxer, err := OpenEVMTxIndexer(home, evmCfg.JSONRPC, server.GetAppDBBackend(serverCtx.Viper), logger.With("module", "evmindex"), clientCtx)
 			if err != nil {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+
+			var firstIndexedBlock, lastIndexedBlock func() (int64, error)
+			switch idxer := idxer.(type) {
+			case *indexer.KVIndexer:
+				firstIndexedBlock, lastIndexedBlock = idxer.FirstIndexedBlock, idxer.LastIndexedBlock
+				if evmCfg.JSONRPC.EnableLogIndexer {
+					firstIndexedBlock, lastIndexedBlock = idxer.FirstLogIndexedBlock, idxer.LastLogIndexedBlock
+				}
+			case *indexer.SQLIndexer:
+				firstIndexedBlock, lastIndexedBlock = idxer.FirstIndexedBlock, idxer.LastIndexedBlock
+			default:
+				return fmt.Errorf("unsupported indexer %T", idxer)
+			}
 
 			// open local CometBFT db, because the local rpc won't be available.
 			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
@@ -83,7 +105,7 @@ func NewIndexTxCmd() *cobra.Command {
 
 			switch args[0] {
 			case "backward":
-				first, err := idxer.FirstIndexedBlock()
+				first, err := firstIndexedBlock()
 				if err != nil {
 					return err
 				}
@@ -97,7 +119,7 @@ func NewIndexTxCmd() *cobra.Command {
 					}
 				}
 			case "forward":
-				latest, err := idxer.LastIndexedBlock()
+				latest, err := lastIndexedBlock()
 				if err != nil {
 					return err
 				}
@@ -117,5 +139,7 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Index the logs by address and topic for `eth_getLogs` queries")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, cosmosevmserverconfig.DefaultIndexerBackend, "Sets the storage backend of the indexer (kv|sqlite)")
 	return cmd
 }
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net"
@@ -14,6 +15,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "modernc.org/sqlite" // register the sqlite driver of the SQL indexer
 
 	abciserver "github.com/cometbft/cometbft/abci/server"
 	tcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
@@ -34,6 +36,7 @@ import (
 	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -197,13 +200,18 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Enable the log index of the custom tx indexer for `eth_getLogs` queries")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, cosmosevmserverconfig.DefaultIndexerBackend, "Sets the storage backend of the custom tx indexer (kv|sqlite)")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableMPTProofs, false, "Serve the storage proofs of the Ethereum storage trie of the account in `eth_getProof`")
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
-	cmd.Flags().Bool(srvflags.EVMEnablePreimageRecording, cosmosevmserverconfig.DefaultEnablePreimageRecording, "Enables tracking of SHA3 preimages in the EVM (not implemented yet)")                      //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMEnablePreimageRecording, cosmosevmserverconfig.DefaultEnablePreimageRecording, "Enables tracking of SHA3 preimages in the EVM, served by `debug_preimage`")                //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMChainID, cosmosevmserverconfig.DefaultEVMChainID, "the EIP-155 compatible replay protection chain ID")
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, cosmosevmserverconfig.DefaultMempoolPriceBump, "the minimum price bump percentage to replace a transaction with the same nonce in the mempool") //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolAccountQueue, cosmosevmserverconfig.DefaultMempoolAccountQueue, "the maximum number of non-executable transactions held in the mempool per account")       //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -437,14 +445,12 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var idxer cosmosevmtypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+		idxer, err = OpenEVMTxIndexer(home, config.JSONRPC, server.GetAppDBBackend(svrCtx.Viper), idxLogger, clientCtx)
 		if err != nil {
 			logger.Error("failed to open evm indexer DB", "error", err.Error())
 			return err
 		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -504,6 +510,53 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenPreimageDB opens the db of the SHA3 preimages recorded by the EVM, using the same db backend as the main app
+func OpenPreimageDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmpreimages", backendType, dataDir)
+}
+
+// OpenSQLiteIndexerDB opens the SQLite database of the custom EVM indexer.
+func OpenSQLiteIndexerDB(rootDir string) (*sql.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	if err := os.MkdirAll(dataDir, 0o755); err != nil {
+		return nil, err
+	}
+	dsn := fmt.Sprintf("file:%s?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)", filepath.Join(dataDir, "evmindexer.sqlite"))
+	return sql.Open("sqlite", dsn)
+}
+
+// OpenEVMTxIndexer opens the database of the configured indexer backend and
+// creates the custom EVM indexer on it.
+func OpenEVMTxIndexer(
+	rootDir string,
+	cfg cosmosevmserverconfig.JSONRPCConfig,
+	backendType dbm.BackendType,
+	logger log.Logger,
+	clientCtx client.Context,
+) (cosmosevmtypes.EVMTxIndexer, error) {
+	switch cfg.IndexerBackend {
+	case cosmosevmserverconfig.IndexerBackendKV:
+		idxDB, err := OpenIndexerDB(rootDir, backendType)
+		if err != nil {
+			return nil, err
+		}
+		return indexer.NewKVIndexer(idxDB, logger, clientCtx).WithLogIndex(cfg.EnableLogIndexer), nil
+	case cosmosevmserverconfig.IndexerBackendSQLite:
+		idxDB, err := OpenSQLiteIndexerDB(rootDir)
+		if err != nil {
+			return nil, err
+		}
+		idxer, err := indexer.NewSQLIndexer(idxDB, logger, clientCtx)
+		if err != nil {
+			return nil, err
+		}
+		return idxer.WithLogIndex(cfg.EnableLogIndexer), nil
+	default:
+		return nil, fmt.Errorf("unknown indexer backend %s", cfg.IndexerBackend)
+	}
+}
+
 // openTraceWriter opens a trace writer if a trace store file is specified.
 // Parameters:
 // - traceWriterFile: The path to the trace store file. If this is an empty string, no file will be opened.
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cosmos/cosmos-db"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	srvflags "github.com/cosmos/evm/server/flags"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// StorageDumper is a function that loads the application at the given height
+// (-1 means the latest height) and writes the storage of the EVM account to w,
+// resolving the storage slots with the recorded SHA3 preimages.
+type StorageDumper func(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	appOpts types.AppOptions,
+	address common.Address,
+	w io.Writer,
+) error
+
+// NewDumpStorageCmd creates a new Cobra command to dump the storage of an EVM
+// account.
+func NewDumpStorageCmd(storageDumper StorageDumper, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump-storage [address]",
+		Short: "Dump the storage of an EVM account",
+		Long: `Dump the storage slots of an EVM account as JSON. When the SHA3 preimages are recorded
+by the node (evm.cache-preimage), the slots which are the hash of a recorded preimage are
+dumped with it. The slots of the values of the Solidity mappings are dumped with the mapping
+key and the slot of the mapping.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid address %s", args[0])
+			}
+			address := common.HexToAddress(args[0])
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			db, err := cosmosevmserverconfig.OpenReadOnlyDB(config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			traceWriterFile, _ := cmd.Flags().GetString(srvflags.TraceStore)
+			traceWriter, err := openTraceWriter(traceWriterFile)
+			if err != nil {
+				return err
+			}
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+
+			w := bufio.NewWriter(cmd.OutOrStdout())
+			if err := storageDumper(serverCtx.Logger, db, traceWriter, height, serverCtx.Viper, address, w); err != nil {
+				return fmt.Errorf("error dumping EVM storage: %w", err)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Dump the storage at a particular height (-1 means latest height)")
+	cmd.Flags().String(srvflags.TraceStore, "", "Enable KVStore tracing to an output file")
+
+	return cmd
+}
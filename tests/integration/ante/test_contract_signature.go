@@ -0,0 +1,189 @@
+package ante
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	cosmosante "github.com/cosmos/evm/ante/cosmos"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	// hashSignerCode is the runtime code of a contract whose isValidSignature
+	// returns the EIP-1271 magic value if the signature is the signed hash.
+	hashSignerCode = common.FromHex(
+		"6044356020146064356004351416602157" + // len(signature) == 32 && signature == hash
+			"63ffffffff60e01b60005260206000f3" + // return 0xffffffff
+			"5b631626ba7e60e01b60005260206000f3", // return 0x1626ba7e
+	)
+	// infiniteLoopCode is the runtime code of a contract that never returns.
+	infiniteLoopCode = common.FromHex("5b600056")
+)
+
+func (s *EvmUnitAnteTestSuite) TestContractSigVerification() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	txConfig := unitNetwork.GetEncodingConfig().TxConfig
+
+	gasLimit := uint64(500_000)
+	gasPrice := unitNetwork.App.GetFeeMarketKeeper().GetBaseFee(unitNetwork.GetContext()).TruncateInt().AddRaw(1)
+	fees := sdk.NewCoins(sdk.NewCoin(unitNetwork.GetBaseDenom(), gasPrice.MulRaw(int64(gasLimit)))) //#nosec G115
+
+	testCases := []struct {
+		name        string
+		code        []byte
+		signature   func(hash common.Hash) []byte
+		simulate    bool
+		minGas      uint64
+		expectedErr error
+	}{
+		{
+			name:      "success: contract accepts the signature",
+			code:      hashSignerCode,
+			signature: func(hash common.Hash) []byte { return hash.Bytes() },
+		},
+		{
+			name:        "fail: contract rejects the signature",
+			code:        hashSignerCode,
+			signature:   func(hash common.Hash) []byte { return ethcrypto.Keccak256(hash.Bytes()) },
+			expectedErr: errortypes.ErrUnauthorized,
+		},
+		{
+			name:        "fail: contract exceeds the verification gas limit",
+			code:        infiniteLoopCode,
+			signature:   func(hash common.Hash) []byte { return hash.Bytes() },
+			expectedErr: errortypes.ErrUnauthorized,
+		},
+		{
+			name:        "fail: signer is not a contract",
+			signature:   func(hash common.Hash) []byte { return hash.Bytes() },
+			expectedErr: errortypes.ErrorInvalidSigner,
+		},
+		{
+			name:      "success: simulation without signature",
+			code:      hashSignerCode,
+			signature: func(common.Hash) []byte { return nil },
+			simulate:  true,
+		},
+		{
+			name:      "success: simulation charges the gas limit of a failed verification",
+			code:      infiniteLoopCode,
+			signature: func(common.Hash) []byte { return nil },
+			simulate:  true,
+			minGas:    cosmosante.DefaultContractSigVerificationGasLimit,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := unitNetwork.GetContext()
+			evmKeeper := unitNetwork.App.GetEVMKeeper()
+
+			// set up the signer account with the test code
+			signer := utiltx.GenerateAddress()
+			account := statedb.Account{
+				Balance:  uint256.MustFromBig(fees.AmountOf(unitNetwork.GetBaseDenom()).MulRaw(10).BigInt()),
+				CodeHash: ethcrypto.Keccak256(nil),
+			}
+			if tc.code != nil {
+				account.CodeHash = ethcrypto.Keccak256(tc.code)
+				evmKeeper.SetCode(ctx, account.CodeHash, tc.code)
+			}
+			s.Require().NoError(evmKeeper.SetAccount(ctx, signer, account))
+
+			signerAcc := unitNetwork.App.GetAccountKeeper().GetAccount(ctx, signer.Bytes())
+			s.Require().NotNil(signerAcc)
+
+			option, err := codectypes.NewAnyWithValue(&cosmosevmtypes.ExtensionOptionContractSignatureTx{})
+			s.Require().NoError(err)
+
+			txBuilder := txConfig.NewTxBuilder()
+			builder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+			s.Require().True(ok)
+			builder.SetExtensionOptions(option)
+			txBuilder.SetGasLimit(gasLimit)
+			txBuilder.SetFeeAmount(fees)
+			s.Require().NoError(txBuilder.SetMsgs(&banktypes.MsgSend{
+				FromAddress: signerAcc.GetAddress().String(),
+				ToAddress:   keyring.GetAccAddr(0).String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(unitNetwork.GetBaseDenom(), sdkmath.NewInt(1))),
+			}))
+
+			sig := signing.SignatureV2{
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+				Sequence: signerAcc.GetSequence(),
+			}
+			s.Require().NoError(txBuilder.SetSignatures(sig))
+
+			// sign the body and auth info bytes transmitted over the wire, with
+			// an unknown non-critical body field which is lost on re-encoding
+			txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+			s.Require().NoError(err)
+			var txRaw txtypes.TxRaw
+			s.Require().NoError(txRaw.Unmarshal(txBytes))
+			txRaw.BodyBytes = protowire.AppendVarint(protowire.AppendTag(txRaw.BodyBytes, 1025, protowire.VarintType), 1)
+			signDoc := txtypes.SignDoc{
+				BodyBytes:     txRaw.BodyBytes,
+				AuthInfoBytes: txRaw.AuthInfoBytes,
+				ChainId:       ctx.ChainID(),
+				AccountNumber: signerAcc.GetAccountNumber(),
+			}
+			signBytes, err := signDoc.Marshal()
+			s.Require().NoError(err)
+
+			sig.Data = &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+				Signature: tc.signature(ethcrypto.Keccak256Hash(signBytes)),
+			}
+			s.Require().NoError(txBuilder.SetSignatures(sig))
+
+			txBytes, err = txConfig.TxEncoder()(txBuilder.GetTx())
+			s.Require().NoError(err)
+			var signedTxRaw txtypes.TxRaw
+			s.Require().NoError(signedTxRaw.Unmarshal(txBytes))
+			signedTxRaw.BodyBytes = txRaw.BodyBytes
+			txBytes, err = signedTxRaw.Marshal()
+			s.Require().NoError(err)
+			tx, err := txConfig.TxDecoder()(txBytes)
+			s.Require().NoError(err)
+
+			// Function under test
+			newCtx, err := unitNetwork.App.GetAnteHandler()(ctx.WithTxBytes(txBytes), tx, tc.simulate)
+
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(signerAcc.GetSequence()+1, unitNetwork.App.GetAccountKeeper().GetAccount(newCtx, signer.Bytes()).GetSequence())
+			s.Require().LessOrEqual(newCtx.GasMeter().GasConsumed(), gasLimit)
+			s.Require().Greater(newCtx.GasMeter().GasConsumed(), tc.minGas)
+		})
+	}
+}
@@ -3,18 +3,28 @@ package ante
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
 	evmante "github.com/cosmos/evm/ante/evm"
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	commonfactory "github.com/cosmos/evm/testutil/integration/base/factory"
 	testfactory "github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	"github.com/cosmos/evm/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -216,3 +226,364 @@ func (s *EvmUnitAnteTestSuite) TestConsumeGasAndEmitEvent() {
 		})
 	}
 }
+
+func (s *EvmUnitAnteTestSuite) TestGetFeePayer() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	factory := testfactory.New(unitNetwork, grpcHandler)
+
+	sender := keyring.GetKey(0)
+	feePayer := keyring.GetAccAddr(1)
+
+	testCases := []struct {
+		name          string
+		expectedError string
+		feePayer      func() *string
+		feePayerSig   func(txHash common.Hash) []byte
+		expFeePayer   sdktypes.AccAddress
+	}{
+		{
+			name:        "success: no fee payer",
+			feePayer:    func() *string { return nil },
+			expFeePayer: nil,
+		},
+		{
+			name: "success: fee payer set",
+			feePayer: func() *string {
+				feePayer := feePayer.String()
+				return &feePayer
+			},
+			feePayerSig: signFeePayer(sender.Priv, feePayer),
+			expFeePayer: feePayer,
+		},
+		{
+			name:          "fail: invalid fee payer address",
+			expectedError: "failed to parse feePayer from ExtensionOptionFeePayerTx",
+			feePayer: func() *string {
+				feePayer := "invalid"
+				return &feePayer
+			},
+			feePayerSig: signFeePayer(sender.Priv, feePayer),
+		},
+		{
+			name:          "fail: fee payer not signed",
+			expectedError: "fee payer signature length",
+			feePayer: func() *string {
+				feePayer := feePayer.String()
+				return &feePayer
+			},
+		},
+		{
+			name:          "fail: fee payer signed by another account",
+			expectedError: "instead of the sender",
+			feePayer: func() *string {
+				feePayer := feePayer.String()
+				return &feePayer
+			},
+			feePayerSig: signFeePayer(keyring.GetKey(1).Priv, feePayer),
+		},
+		{
+			name:          "fail: signature of another fee payer",
+			expectedError: "instead of the sender",
+			feePayer: func() *string {
+				feePayer := feePayer.String()
+				return &feePayer
+			},
+			feePayerSig: signFeePayer(sender.Priv, sender.AccAddr),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(s.EthTxType), s.ChainID, tc.name), func() {
+			txArgs := getTxByType("transfer", keyring.GetAddr(1))
+			txArgs.GasLimit = 21000
+			tx := buildEthTxWithFeePayer(factory, unitNetwork, sender.Priv, txArgs, tc.feePayer(), tc.feePayerSig)
+			ethMsg, ok := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+			s.Require().True(ok)
+
+			// Function under test
+			feePayer, err := evmante.GetFeePayer(tx, ethMsg)
+
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expFeePayer, feePayer)
+		})
+	}
+}
+
+func (s *EvmUnitAnteTestSuite) TestUseFeeAllowance() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	granter := keyring.GetAccAddr(0)
+	grantee := keyring.GetAccAddr(1)
+	fees := sdktypes.NewCoins(sdktypes.NewCoin(unitNetwork.GetBaseDenom(), sdkmath.NewInt(1000)))
+	msgs := []sdktypes.Msg{&evmtypes.MsgEthereumTx{}}
+
+	testCases := []struct {
+		name           string
+		expectedError  string
+		feegrantKeeper func() anteinterfaces.FeegrantKeeper
+		allowance      feegrant.FeeAllowanceI
+		expSpendLimit  sdktypes.Coins
+	}{
+		{
+			name:          "fail: fee grants are not enabled",
+			expectedError: "fee grants are not enabled",
+			feegrantKeeper: func() anteinterfaces.FeegrantKeeper {
+				return nil
+			},
+		},
+		{
+			name:          "fail: no allowance granted",
+			expectedError: "does not allow to pay fees for",
+			feegrantKeeper: func() anteinterfaces.FeegrantKeeper {
+				return unitNetwork.App.GetFeeGrantKeeper()
+			},
+		},
+		{
+			name:          "fail: spend limit lower than the fees",
+			expectedError: "does not allow to pay fees for",
+			feegrantKeeper: func() anteinterfaces.FeegrantKeeper {
+				return unitNetwork.App.GetFeeGrantKeeper()
+			},
+			allowance: &feegrant.BasicAllowance{
+				SpendLimit: sdktypes.NewCoins(sdktypes.NewCoin(unitNetwork.GetBaseDenom(), sdkmath.NewInt(999))),
+			},
+		},
+		{
+			name:          "fail: allowance restricted to other messages",
+			expectedError: "does not allow to pay fees for",
+			feegrantKeeper: func() anteinterfaces.FeegrantKeeper {
+				return unitNetwork.App.GetFeeGrantKeeper()
+			},
+			allowance: func() feegrant.FeeAllowanceI {
+				allowance, err := feegrant.NewAllowedMsgAllowance(
+					&feegrant.BasicAllowance{},
+					[]string{sdktypes.MsgTypeURL(&banktypes.MsgSend{})},
+				)
+				s.Require().NoError(err)
+				return allowance
+			}(),
+		},
+		{
+			name: "success: fees deducted from the allowance",
+			feegrantKeeper: func() anteinterfaces.FeegrantKeeper {
+				return unitNetwork.App.GetFeeGrantKeeper()
+			},
+			allowance: &feegrant.BasicAllowance{
+				SpendLimit: sdktypes.NewCoins(sdktypes.NewCoin(unitNetwork.GetBaseDenom(), sdkmath.NewInt(1500))),
+			},
+			expSpendLimit: sdktypes.NewCoins(sdktypes.NewCoin(unitNetwork.GetBaseDenom(), sdkmath.NewInt(500))),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(s.EthTxType), s.ChainID, tc.name), func() {
+			ctx := unitNetwork.GetContext()
+			feegrantKeeper := unitNetwork.App.GetFeeGrantKeeper()
+			if tc.allowance != nil {
+				s.Require().NoError(feegrantKeeper.GrantAllowance(ctx, granter, grantee, tc.allowance))
+			}
+
+			// Function under test
+			err := evmante.UseFeeAllowance(
+				ctx,
+				tc.feegrantKeeper(),
+				fees,
+				granter,
+				grantee,
+				msgs,
+			)
+
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+			} else {
+				s.Require().NoError(err)
+
+				allowance, err := feegrantKeeper.GetAllowance(ctx, granter, grantee)
+				s.Require().NoError(err)
+				basic, ok := allowance.(*feegrant.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(tc.expSpendLimit, basic.SpendLimit)
+			}
+
+			// Reset the context
+			err = unitNetwork.NextBlock()
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *EvmUnitAnteTestSuite) TestAnteHandlerWithFeePayer() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	factory := testfactory.New(unitNetwork, grpcHandler)
+
+	granter := keyring.GetKey(0)
+
+	testCases := []struct {
+		name          string
+		expectedError string
+		malleate      func(ctx sdktypes.Context, grantee sdktypes.AccAddress)
+		unsigned      bool
+	}{
+		{
+			name:          "fail: no allowance granted",
+			expectedError: "does not allow to pay fees for",
+			malleate:      func(sdktypes.Context, sdktypes.AccAddress) {},
+		},
+		{
+			name:          "fail: fee payer not signed by the sender",
+			expectedError: "fee payer signature length",
+			malleate: func(ctx sdktypes.Context, grantee sdktypes.AccAddress) {
+				err := unitNetwork.App.GetFeeGrantKeeper().GrantAllowance(
+					ctx, granter.AccAddr, grantee, &feegrant.BasicAllowance{},
+				)
+				s.Require().NoError(err)
+			},
+			unsigned: true,
+		},
+		{
+			name: "success: fees paid by the granter",
+			malleate: func(ctx sdktypes.Context, grantee sdktypes.AccAddress) {
+				err := unitNetwork.App.GetFeeGrantKeeper().GrantAllowance(
+					ctx, granter.AccAddr, grantee, &feegrant.BasicAllowance{},
+				)
+				s.Require().NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(s.EthTxType), s.ChainID, tc.name), func() {
+			ctx := unitNetwork.GetContext()
+
+			// the sender holds no funds to pay for the fees
+			grantee := keyring.GetKey(keyring.AddKey())
+			tc.malleate(ctx, grantee.AccAddr)
+
+			prevGranterBalance := unitNetwork.App.GetEVMKeeper().GetBalance(ctx, granter.Addr)
+
+			txArgs := getTxByType("call", granter.Addr)
+			txArgs.GasLimit = 100000
+			feePayer := granter.AccAddr.String()
+			feePayerSig := signFeePayer(grantee.Priv, granter.AccAddr)
+			if tc.unsigned {
+				feePayerSig = nil
+			}
+			tx := buildEthTxWithFeePayer(factory, unitNetwork, grantee.Priv, txArgs, &feePayer, feePayerSig)
+
+			// Function under test
+			_, err := unitNetwork.App.GetAnteHandler()(ctx, tx, false)
+
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+			} else {
+				s.Require().NoError(err)
+
+				granterBalance := unitNetwork.App.GetEVMKeeper().GetBalance(ctx, granter.Addr)
+				s.Require().True(granterBalance.Lt(prevGranterBalance), "expected the fees to be deducted from the granter")
+				s.Require().True(unitNetwork.App.GetEVMKeeper().GetBalance(ctx, grantee.Addr).IsZero())
+				s.Require().Equal(granter.AccAddr, unitNetwork.App.GetEVMKeeper().GetTxFeePayerTransient(ctx))
+			}
+
+			// Reset the context
+			err = unitNetwork.NextBlock()
+			s.Require().NoError(err)
+		})
+	}
+}
+
+// signFeePayer returns a function signing the fee payer of an Ethereum tx
+// with the given private key.
+func signFeePayer(privKey cryptotypes.PrivKey, feePayer sdktypes.AccAddress) func(common.Hash) []byte {
+	return func(txHash common.Hash) []byte {
+		ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+		if !ok {
+			panic("expected an ethsecp256k1 private key")
+		}
+		key, err := ethPrivKey.ToECDSA()
+		if err != nil {
+			panic(err)
+		}
+		sig, err := ethcrypto.Sign(types.FeePayerSignHash(txHash, feePayer).Bytes(), key)
+		if err != nil {
+			panic(err)
+		}
+		return sig
+	}
+}
+
+// buildEthTxWithFeePayer builds a signed Ethereum tx holding the
+// ExtensionOptionFeePayerTx extension option with the given fee payer, if any,
+// and the fee payer signature returned by feePayerSig, if any.
+func buildEthTxWithFeePayer(
+	factory testfactory.TxFactory,
+	unitNetwork *network.UnitTestNetwork,
+	privKey cryptotypes.PrivKey,
+	txArgs evmtypes.EvmTxArgs,
+	feePayer *string,
+	feePayerSig func(txHash common.Hash) []byte,
+) sdktypes.Tx {
+	signedMsg, err := factory.GenerateSignedMsgEthereumTx(privKey, txArgs)
+	if err != nil {
+		panic(err)
+	}
+
+	txBuilder := unitNetwork.GetEncodingConfig().TxConfig.NewTxBuilder()
+	tx, err := signedMsg.BuildTx(txBuilder, unitNetwork.GetBaseDenom())
+	if err != nil {
+		panic(err)
+	}
+	if feePayer == nil {
+		return tx
+	}
+
+	ethOption, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{})
+	if err != nil {
+		panic(err)
+	}
+	extOpt := &types.ExtensionOptionFeePayerTx{FeePayer: *feePayer}
+	if feePayerSig != nil {
+		extOpt.FeePayerSig = feePayerSig(signedMsg.Hash())
+	}
+	feePayerOption, err := codectypes.NewAnyWithValue(extOpt)
+	if err != nil {
+		panic(err)
+	}
+
+	builder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		panic("tx builder does not support extension options")
+	}
+	builder.SetExtensionOptions(ethOption, feePayerOption)
+
+	return builder.GetTx()
+}
@@ -0,0 +1,325 @@
+package eip712
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/ethereum/eip712"
+	"github.com/cosmos/evm/testutil/constants"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TestSchemaEIP712 tests the schema-driven EIP-712 encoding of the messages which
+// cannot be represented by walking their JSON payload.
+func (s *TestSuite) TestSchemaEIP712() {
+	if s.useLegacyEIP712TypedData {
+		s.T().Skip("the schema-driven encoding does not depend on the legacy typed data")
+	}
+	s.SetupTest()
+
+	signModes := []signing.SignMode{
+		signing.SignMode_SIGN_MODE_DIRECT,
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	}
+
+	fee := txtypes.Fee{
+		Amount:   s.makeCoins(s.denom, math.NewInt(2000)),
+		GasLimit: 20000,
+	}
+
+	testCases := []struct {
+		title string
+		msgs  func(signer sdk.AccAddress) []sdk.Msg
+	}{
+		{
+			title: "Succeeds - Heterogeneous messages",
+			msgs: func(signer sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{
+					banktypes.NewMsgSend(signer, s.createTestAddress(), s.makeCoins(s.denom, math.NewInt(1))),
+					govtypesv1.NewMsgVote(signer, 5, govtypesv1.VoteOption_VOTE_OPTION_YES, ""),
+				}
+			},
+		},
+		{
+			title: "Succeeds - Message with empty fields",
+			msgs: func(signer sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{
+					banktypes.NewMsgSend(signer, s.createTestAddress(), sdk.Coins{}),
+				}
+			},
+		},
+		{
+			title: "Succeeds - Heterogeneous messages nested in an Any",
+			msgs: func(signer sdk.AccAddress) []sdk.Msg {
+				granter := s.createTestAddress()
+				msgExec := authz.NewMsgExec(signer, []sdk.Msg{
+					banktypes.NewMsgSend(granter, s.createTestAddress(), s.makeCoins(s.denom, math.NewInt(1))),
+					stakingtypes.NewMsgDelegate(
+						granter.String(),
+						sdk.ValAddress(s.createTestAddress()).String(),
+						sdk.NewCoin(s.denom, math.NewInt(1)),
+					),
+				})
+				return []sdk.Msg{&msgExec}
+			},
+		},
+		{
+			title: "Succeeds - Anys nested inside Anys",
+			msgs: func(signer sdk.AccAddress) []sdk.Msg {
+				innerExec := authz.NewMsgExec(s.createTestAddress(), []sdk.Msg{
+					banktypes.NewMsgSend(s.createTestAddress(), s.createTestAddress(), s.makeCoins(s.denom, math.NewInt(1))),
+				})
+				msgExec := authz.NewMsgExec(signer, []sdk.Msg{&innerExec})
+				return []sdk.Msg{&msgExec}
+			},
+		},
+		{
+			title: "Succeeds - Governance proposal with messages",
+			msgs: func(signer sdk.AccAddress) []sdk.Msg {
+				proposal, err := govtypesv1.NewMsgSubmitProposal(
+					[]sdk.Msg{
+						banktypes.NewMsgSend(s.createTestAddress(), s.createTestAddress(), s.makeCoins(s.denom, math.NewInt(1))),
+						&govtypesv1.MsgUpdateParams{Authority: s.createTestAddress().String(), Params: govtypesv1.DefaultParams()},
+					},
+					s.makeCoins(s.denom, math.NewInt(100)),
+					signer.String(),
+					"metadata",
+					"title",
+					"summary",
+					false,
+				)
+				s.Require().NoError(err)
+				return []sdk.Msg{proposal}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, signMode := range signModes {
+			s.Run(tc.title, func() {
+				privKey, pubKey := s.createTestKeyPair()
+				signer := sdk.AccAddress(pubKey.Address())
+
+				txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(fee.GasLimit)
+				txBuilder.SetFeeAmount(fee.Amount)
+				s.Require().NoError(txBuilder.SetMsgs(tc.msgs(signer)...))
+
+				txSig := signing.SignatureV2{
+					PubKey:   pubKey,
+					Data:     &signing.SingleSignatureData{SignMode: signMode},
+					Sequence: 1,
+				}
+				s.Require().NoError(txBuilder.SetSignatures(txSig))
+
+				signerData := authsigning.SignerData{
+					ChainID:       constants.ExampleChainID.ChainID,
+					AccountNumber: 1,
+					Sequence:      1,
+					PubKey:        pubKey,
+					Address:       signer.String(),
+				}
+
+				bz, err := authsigning.GetSignBytesAdapter(
+					s.clientCtx.CmdContext,
+					s.clientCtx.TxConfig.SignModeHandler(),
+					signMode,
+					signerData,
+					txBuilder.GetTx(),
+				)
+				s.Require().NoError(err)
+
+				s.verifySchemaEIP712RoundTrip(*privKey, *pubKey, bz)
+			})
+		}
+	}
+}
+
+// skippedEIP712Msgs are the registered messages which are not expected to be
+// signed through a Cosmos sign doc, with the reason they are skipped.
+var skippedEIP712Msgs = map[string]string{
+	"/cosmos.evm.vm.v1.MsgEthereumTx": "signed as an Ethereum transaction, never as a Cosmos sign doc",
+}
+
+// TestSchemaEIP712AllMessages tests that every message registered in the app can be
+// signed with EIP-712, except for the messages listed in skippedEIP712Msgs.
+func (s *TestSuite) TestSchemaEIP712AllMessages() {
+	if s.useLegacyEIP712TypedData {
+		s.T().Skip("the schema-driven encoding does not depend on the legacy typed data")
+	}
+	s.SetupTest()
+
+	registry := s.config.InterfaceRegistry
+	typeURLs := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	s.Require().NotEmpty(typeURLs)
+
+	var tested int
+	for _, typeURL := range typeURLs {
+		if _, ok := skippedEIP712Msgs[typeURL]; ok {
+			continue
+		}
+
+		privKey, pubKey := s.createTestKeyPair()
+		signer := sdk.AccAddress(pubKey.Address())
+
+		msgAny, ok := s.newSignedByMsg(typeURL, signer)
+		s.Require().True(ok, "cannot build a message of type %s signed by a single account", typeURL)
+
+		body := txtypes.TxBody{Messages: []*codectypes.Any{msgAny}}
+		bodyBytes, err := body.Marshal()
+		s.Require().NoError(err)
+
+		authInfo := txtypes.AuthInfo{
+			SignerInfos: []*txtypes.SignerInfo{{Sequence: 1}},
+			Fee: &txtypes.Fee{
+				Amount:   s.makeCoins(s.denom, math.NewInt(2000)),
+				GasLimit: 20000,
+			},
+		}
+		authInfoBytes, err := authInfo.Marshal()
+		s.Require().NoError(err)
+
+		signDoc := txtypes.SignDoc{
+			BodyBytes:     bodyBytes,
+			AuthInfoBytes: authInfoBytes,
+			ChainId:       constants.ExampleChainID.ChainID,
+			AccountNumber: 1,
+		}
+		signDocBytes, err := signDoc.Marshal()
+		s.Require().NoError(err)
+
+		s.Run(typeURL, func() {
+			s.verifySchemaEIP712RoundTrip(*privKey, *pubKey, signDocBytes)
+		})
+		tested++
+	}
+
+	s.Require().Equal(len(typeURLs)-len(skippedEIP712Msgs), tested)
+}
+
+// newSignedByMsg returns an empty message of the given type, with its signer
+// fields set to the given address. It returns false if the signer fields of the
+// message cannot be set.
+func (s *TestSuite) newSignedByMsg(typeURL string, signer sdk.AccAddress) (*codectypes.Any, bool) {
+	registry := s.config.InterfaceRegistry
+
+	// the signers of a multi send are the nested inputs
+	if typeURL == sdk.MsgTypeURL(&banktypes.MsgMultiSend{}) {
+		coins := s.makeCoins(s.denom, math.NewInt(1))
+		msg := banktypes.NewMsgMultiSend(
+			banktypes.NewInput(signer, coins),
+			[]banktypes.Output{banktypes.NewOutput(signer, coins)},
+		)
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		s.Require().NoError(err)
+		return msgAny, true
+	}
+
+	desc, err := registry.FindDescriptorByName(protoreflect.FullName(typeURL[1:]))
+	s.Require().NoError(err)
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	s.Require().True(ok)
+
+	signerFields, _ := proto.GetExtension(msgDesc.Options(), msgv1.E_Signer).([]string)
+	if len(signerFields) != 1 {
+		return nil, false
+	}
+	field := msgDesc.Fields().ByName(protoreflect.Name(signerFields[0]))
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return nil, false
+	}
+
+	// the signer is either an account or a validator operator
+	for _, address := range []string{signer.String(), sdk.ValAddress(signer).String()} {
+		msg := dynamicpb.NewMessage(msgDesc)
+		msg.Set(field, protoreflect.ValueOfString(address))
+
+		bz, err := proto.Marshal(msg)
+		s.Require().NoError(err)
+
+		msgAny := &codectypes.Any{TypeUrl: typeURL, Value: bz}
+		var sdkMsg sdk.Msg
+		if err := registry.UnpackAny(msgAny, &sdkMsg); err != nil {
+			return nil, false
+		}
+		if signers, _, err := s.config.Codec.GetMsgV1Signers(sdkMsg); err == nil && len(signers) == 1 {
+			return msgAny, true
+		}
+	}
+
+	return nil, false
+}
+
+// verifySchemaEIP712RoundTrip checks that the schema-driven EIP-712 representation of the sign doc
+// survives a JSON round-trip, as done by a wallet. It then signs the EIP-712 representation selected
+// for the sign doc and verifies the signature against the sign doc.
+func (s *TestSuite) verifySchemaEIP712RoundTrip(privKey ethsecp256k1.PrivKey, pubKey ethsecp256k1.PubKey, signDocBytes []byte) {
+	typedData, err := eip712.SchemaGetEIP712TypedDataForMsg(signDocBytes)
+	s.Require().NoError(err)
+
+	// the encoding is deterministic
+	again, err := eip712.SchemaGetEIP712TypedDataForMsg(signDocBytes)
+	s.Require().NoError(err)
+	s.Require().Equal(typedData, again)
+
+	schemaRawData := s.walletRawData(typedData)
+
+	schemaEIP712Bytes, err := eip712.SchemaGetEIP712BytesForMsg(signDocBytes)
+	s.Require().NoError(err)
+	s.Require().Equal(schemaRawData, schemaEIP712Bytes)
+
+	selectedTypedData, err := eip712.SelectEIP712TypedDataForMsg(signDocBytes)
+	s.Require().NoError(err)
+	rawData := s.walletRawData(selectedTypedData)
+
+	sig, err := privKey.Sign(rawData)
+	s.Require().NoError(err)
+
+	s.Require().True(pubKey.VerifySignature(signDocBytes, sig))
+
+	// The encoding is selected by the payload, so a signature over any other encoding is rejected.
+	if !bytes.Equal(rawData, schemaRawData) {
+		schemaSig, err := privKey.Sign(schemaRawData)
+		s.Require().NoError(err)
+		s.Require().False(pubKey.VerifySignature(signDocBytes, schemaSig))
+	}
+
+	// Verify against modified bytes to ensure it does not pass unexpectedly (sanity check).
+	modified := make([]byte, len(signDocBytes))
+	copy(modified, signDocBytes)
+	modified[0] = (signDocBytes[0] + 10) % 255
+	s.Require().False(pubKey.VerifySignature(modified, sig))
+}
+
+// walletRawData returns the EIP-712 bytes to sign for the given typed data after a JSON
+// round-trip, as done by a wallet.
+func (s *TestSuite) walletRawData(typedData apitypes.TypedData) []byte {
+	typedDataJSON, err := json.Marshal(typedData)
+	s.Require().NoError(err)
+
+	var walletTypedData apitypes.TypedData
+	s.Require().NoError(json.Unmarshal(typedDataJSON, &walletTypedData))
+
+	_, rawData, err := apitypes.TypedDataAndHash(walletTypedData)
+	s.Require().NoError(err)
+
+	return []byte(rawData)
+}
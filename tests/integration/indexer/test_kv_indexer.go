@@ -171,6 +171,8 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 				last, err := idxer.LastIndexedBlock()
 				require.NoError(t, err)
 				require.Equal(t, int64(-1), last)
+
+				requireReceiptsRoot(t, idxer, clientCtx, nil, nil)
 			} else {
 				first, err := idxer.FirstIndexedBlock()
 				require.NoError(t, err)
@@ -186,7 +188,52 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 				res2, err := idxer.GetByBlockAndIndex(1, 0)
 				require.NoError(t, err)
 				require.Equal(t, res1, res2)
+
+				requireReceiptsRoot(t, idxer, clientCtx, tc.block.Txs[0], res1)
 			}
 		})
 	}
+
+	t.Run("sponsored tx", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+		testSponsoredTx(t, idxer, clientCtx)
+	})
+}
+
+func TestKVIndexerLogs(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	txBz, txHash := buildEthTx(t, clientCtx)
+	blockLogs := newTestLogs(txHash)
+
+	t.Run("disabled log index", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+		indexLogBlocks(t, idxer, txBz, txHash, blockLogs)
+
+		indexed, err := idxer.IsLogIndexed(1, 3)
+		require.NoError(t, err)
+		require.False(t, indexed)
+
+		first, err := idxer.FirstLogIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), first)
+	})
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx).WithLogIndex(true)
+	indexLogBlocks(t, idxer, txBz, txHash, blockLogs)
+
+	first, err := idxer.FirstLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err := idxer.LastLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), last)
+
+	testLogIndexer(t, idxer, blockLogs)
+
+	// the logs are not served once the log index is disabled
+	indexed, err := idxer.WithLogIndex(false).IsLogIndexed(1, 3)
+	require.NoError(t, err)
+	require.False(t, indexed)
 }
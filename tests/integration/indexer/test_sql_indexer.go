@@ -0,0 +1,216 @@
+package indexer
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite" // register the sqlite driver
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestSQLIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	txBz, txHash := buildEthTx(t, clientCtx)
+
+	newIndexer := func(t *testing.T) (*indexer.SQLIndexer, *sql.DB) {
+		t.Helper()
+		db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "evmindexer.sqlite"))
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+		idxer, err := indexer.NewSQLIndexer(db, log.NewNopLogger(), clientCtx)
+		require.NoError(t, err)
+		return idxer, db
+	}
+
+	testCases := []struct {
+		name        string
+		blockResult []*abci.ExecTxResult
+		expResult   *cosmosevmtypes.TxResult
+	}{
+		{
+			"success",
+			[]*abci.ExecTxResult{
+				{
+					Code:    0,
+					GasUsed: 21000,
+					Events: []abci.Event{
+						{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+							{Key: "amount", Value: "1000"},
+							{Key: "txGasUsed", Value: "21000"},
+							{Key: "txHash", Value: ""},
+							{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+						}},
+					},
+				},
+			},
+			&cosmosevmtypes.TxResult{Height: 1, GasUsed: 21000, CumulativeGasUsed: 21000},
+		},
+		{
+			"success, exceed block gas limit",
+			[]*abci.ExecTxResult{
+				{
+					Code:   11,
+					Log:    "out of gas in location: block gas meter; gasWanted: 21000",
+					Events: []abci.Event{},
+				},
+			},
+			&cosmosevmtypes.TxResult{Height: 1, GasUsed: 21000, CumulativeGasUsed: 21000, Failed: true},
+		},
+		{
+			"fail, failed eth tx",
+			[]*abci.ExecTxResult{
+				{
+					Code:   15,
+					Log:    "nonce mismatch",
+					Events: []abci.Event{},
+				},
+			},
+			nil,
+		},
+		{
+			"fail, invalid events",
+			[]*abci.ExecTxResult{
+				{
+					Code:   0,
+					Events: []abci.Event{},
+				},
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idxer, db := newIndexer(t)
+			block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+
+			require.NoError(t, idxer.IndexBlock(block, tc.blockResult))
+			// indexing a block again replaces its rows
+			require.NoError(t, idxer.IndexBlock(block, tc.blockResult))
+
+			first, err := idxer.FirstIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, int64(1), first)
+			last, err := idxer.LastIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, int64(1), last)
+
+			requireReceiptsRoot(t, idxer, clientCtx, txBz, tc.expResult)
+
+			res1, err := idxer.GetByTxHash(txHash)
+			if tc.expResult == nil {
+				require.ErrorContains(t, err, "tx not found")
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expResult, res1)
+			res2, err := idxer.GetByBlockAndIndex(1, 0)
+			require.NoError(t, err)
+			require.Equal(t, res1, res2)
+
+			// the txs and receipts can be queried with SQL
+			var (
+				to, value string
+				status    uint64
+			)
+			err = db.QueryRow(
+				`SELECT t.to_address, t.value, r.status FROM transactions t JOIN receipts r ON r.tx_hash = t.hash WHERE t.hash = ?`,
+				txHash.Hex(),
+			).Scan(&to, &value, &status)
+			require.NoError(t, err)
+			require.Equal(t, "0x0000000000000000000000000000000000000001", to)
+			require.Equal(t, "1000", value)
+			require.Equal(t, !tc.expResult.Failed, status == 1)
+		})
+	}
+
+	t.Run("empty db", func(t *testing.T) {
+		idxer, _ := newIndexer(t)
+		last, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), last)
+
+		_, err = idxer.GetByBlockAndIndex(1, 0)
+		require.ErrorContains(t, err, "tx not found")
+	})
+
+	t.Run("sponsored tx", func(t *testing.T) {
+		idxer, _ := newIndexer(t)
+		testSponsoredTx(t, idxer, clientCtx)
+	})
+
+	t.Run("disabled log index", func(t *testing.T) {
+		idxer, db := newIndexer(t)
+		indexLogBlocks(t, idxer, txBz, txHash, newTestLogs(txHash))
+
+		// the logs are stored for the SQL queries
+		var count int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM logs`).Scan(&count))
+		require.Equal(t, 3, count)
+
+		// but not served to eth_getLogs
+		indexed, err := idxer.IsLogIndexed(1, 3)
+		require.NoError(t, err)
+		require.False(t, indexed)
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		idxer, db := newIndexer(t)
+		idxer.WithLogIndex(true)
+		blockLogs := newTestLogs(txHash)
+		indexLogBlocks(t, idxer, txBz, txHash, blockLogs)
+
+		var count int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM logs WHERE address = ?`, "0x000000000000000000000000000000000000000a").Scan(&count))
+		require.Equal(t, 2, count)
+
+		testLogIndexer(t, idxer, blockLogs)
+
+		// the logs are not served once the log index is disabled
+		indexed, err := idxer.WithLogIndex(false).IsLogIndexed(1, 3)
+		require.NoError(t, err)
+		require.False(t, indexed)
+	})
+
+	t.Run("reindex removes stale rows", func(t *testing.T) {
+		idxer, db := newIndexer(t)
+		blockLogs := newTestLogs(txHash)
+		indexLogBlocks(t, idxer, txBz, txHash, blockLogs)
+
+		// the logs of the first block are gone once it is indexed again without them
+		blockLogs[1] = nil
+		indexLogBlocks(t, idxer, txBz, txHash, blockLogs)
+
+		var count int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM logs`).Scan(&count))
+		require.Equal(t, 1, count)
+
+		// the tx is gone once its block is indexed again without it
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: 3}}
+		require.NoError(t, idxer.IndexBlock(block, nil))
+
+		_, err := idxer.GetByTxHash(txHash)
+		require.ErrorContains(t, err, "tx not found")
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM transactions`).Scan(&count))
+		require.Zero(t, count)
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM receipts`).Scan(&count))
+		require.Zero(t, count)
+	})
+}
@@ -0,0 +1,312 @@
+package indexer
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/testutil/constants"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+var (
+	logAddrA  = common.HexToAddress("0xa")
+	logAddrB  = common.HexToAddress("0xb")
+	logTopic1 = common.HexToHash("0x1")
+	logTopic2 = common.HexToHash("0x2")
+)
+
+// buildEthTx returns an encoded cosmos tx wrapping a signed eth transfer, and the eth tx hash.
+func buildEthTx(t *testing.T, clientCtx client.Context) ([]byte, common.Hash) {
+	t.Helper()
+	tx := newSignedEthTx(t)
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+	return txBz, tx.AsTransaction().Hash()
+}
+
+// buildSponsoredEthTx returns an encoded cosmos tx wrapping a signed eth transfer
+// which fees are paid by a fee payer, and the eth tx hash.
+func buildSponsoredEthTx(t *testing.T, clientCtx client.Context) ([]byte, common.Hash) {
+	t.Helper()
+	tx := newSignedEthTx(t)
+	txBuilder := clientCtx.TxConfig.NewTxBuilder()
+	_, err := tx.BuildTx(txBuilder, constants.ExampleAttoDenom)
+	require.NoError(t, err)
+
+	ethOption, err := codectypes.NewAnyWithValue(&types.ExtensionOptionsEthereumTx{})
+	require.NoError(t, err)
+	feePayerOption, err := codectypes.NewAnyWithValue(&cosmosevmtypes.ExtensionOptionFeePayerTx{
+		FeePayer: sdk.AccAddress(common.BigToAddress(big.NewInt(2)).Bytes()).String(),
+	})
+	require.NoError(t, err)
+	builder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+	require.True(t, ok)
+	builder.SetExtensionOptions(ethOption, feePayerOption)
+
+	txBz, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return txBz, tx.AsTransaction().Hash()
+}
+
+// newSignedEthTx returns a signed eth transfer tx.
+func newSignedEthTx(t *testing.T) *types.MsgEthereumTx {
+	t.Helper()
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{Nonce: 0, To: &to, Amount: big.NewInt(1000), GasLimit: 21000})
+	tx.From = from.Bytes()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	return tx
+}
+
+// testSponsoredTx checks that an eth tx which fees are paid by a fee payer is
+// indexed, together with its receipt.
+func testSponsoredTx(t *testing.T, idxer cosmosevmtypes.EVMTxIndexer, clientCtx client.Context) {
+	t.Helper()
+	txBz, txHash := buildSponsoredEthTx(t, clientCtx)
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	blockResult := []*abci.ExecTxResult{
+		{
+			Code:    0,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		},
+	}
+	require.NoError(t, idxer.IndexBlock(block, blockResult))
+
+	res, err := idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, &cosmosevmtypes.TxResult{Height: 1, GasUsed: 21000, CumulativeGasUsed: 21000}, res)
+
+	rootIdxer, ok := idxer.(cosmosevmtypes.EVMReceiptsRootIndexer)
+	require.True(t, ok)
+	requireReceiptsRoot(t, rootIdxer, clientCtx, txBz, res)
+}
+
+// requireReceiptsRoot checks the indexed receipts root of the block 1, holding
+// the given eth tx without logs. A nil result means the tx is not indexed.
+func requireReceiptsRoot(t *testing.T, idxer cosmosevmtypes.EVMReceiptsRootIndexer, clientCtx client.Context, txBz []byte, res *cosmosevmtypes.TxResult) {
+	t.Helper()
+	var receipts ethtypes.Receipts
+	if res != nil {
+		tx, err := clientCtx.TxConfig.TxDecoder()(txBz)
+		require.NoError(t, err)
+		receipt := &ethtypes.Receipt{
+			Type:              tx.GetMsgs()[0].(*types.MsgEthereumTx).AsTransaction().Type(),
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: res.CumulativeGasUsed,
+		}
+		if res.Failed {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+		}
+		receipt.Bloom = ethtypes.CreateBloom(receipt)
+		receipts = append(receipts, receipt)
+	}
+
+	root, err := idxer.GetReceiptsRoot(1)
+	require.NoError(t, err)
+	require.NotNil(t, root)
+	require.Equal(t, ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)), *root)
+
+	root, err = idxer.GetReceiptsRoot(2)
+	require.NoError(t, err)
+	require.Nil(t, root)
+}
+
+// newTestLogs returns the logs of the blocks 1 to 3 used to test the log queries.
+func newTestLogs(txHash common.Hash) map[int64][]*ethtypes.Log {
+	newLog := func(height, index uint64, address common.Address, topics ...common.Hash) *ethtypes.Log {
+		return &ethtypes.Log{
+			Address:     address,
+			Topics:      topics,
+			Data:        []byte{byte(index)},
+			BlockNumber: height,
+			TxHash:      txHash,
+			BlockHash:   common.BigToHash(new(big.Int).SetUint64(height)),
+			Index:       uint(index),
+		}
+	}
+	return map[int64][]*ethtypes.Log{
+		1: {newLog(1, 0, logAddrA, logTopic1, logTopic2), newLog(1, 1, logAddrB, logTopic1)},
+		2: {newLog(2, 0, logAddrA, logTopic2)},
+		3: {},
+	}
+}
+
+// indexLogBlocks indexes the blocks 1 to 3, each with one eth tx emitting the given logs.
+func indexLogBlocks(t *testing.T, idxer cosmosevmtypes.EVMTxIndexer, txBz []byte, txHash common.Hash, blockLogs map[int64][]*ethtypes.Log) {
+	t.Helper()
+	for height := int64(1); height <= 3; height++ {
+		attrs := make([]abci.EventAttribute, len(blockLogs[height]))
+		for i, ethLog := range blockLogs[height] {
+			bz, err := json.Marshal(types.NewLogFromEth(ethLog))
+			require.NoError(t, err)
+			attrs[i] = abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)}
+		}
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		blockResult := []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "21000"},
+					}},
+					{Type: types.EventTypeTxLog, Attributes: attrs},
+				},
+			},
+		}
+		require.NoError(t, idxer.IndexBlock(block, blockResult))
+	}
+}
+
+// testLogIndexer checks the log queries on the blocks indexed by indexLogBlocks.
+func testLogIndexer(t *testing.T, idxer cosmosevmtypes.EVMLogIndexer, blockLogs map[int64][]*ethtypes.Log) {
+	t.Helper()
+	for _, tc := range []struct {
+		from, to   int64
+		expIndexed bool
+	}{
+		{1, 3, true},
+		{3, 3, true},
+		{1, 4, false},
+		{0, 1, false},
+		{3, 1, false},
+	} {
+		indexed, err := idxer.IsLogIndexed(tc.from, tc.to)
+		require.NoError(t, err)
+		require.Equal(t, tc.expIndexed, indexed, "range [%d, %d]", tc.from, tc.to)
+	}
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		limit     int
+		expLogs   []*ethtypes.Log
+		expErr    string
+	}{
+		{
+			name:    "all logs",
+			from:    1,
+			to:      3,
+			expLogs: []*ethtypes.Log{blockLogs[1][0], blockLogs[1][1], blockLogs[2][0]},
+		},
+		{
+			name:    "all logs of a sub range",
+			from:    2,
+			to:      3,
+			expLogs: []*ethtypes.Log{blockLogs[2][0]},
+		},
+		{
+			name:      "by address",
+			from:      1,
+			to:        3,
+			addresses: []common.Address{logAddrA},
+			expLogs:   []*ethtypes.Log{blockLogs[1][0], blockLogs[2][0]},
+		},
+		{
+			name:      "by any of the addresses",
+			from:      1,
+			to:        3,
+			addresses: []common.Address{logAddrA, logAddrB},
+			expLogs:   []*ethtypes.Log{blockLogs[1][0], blockLogs[1][1], blockLogs[2][0]},
+		},
+		{
+			name:    "by first topic",
+			from:    1,
+			to:      3,
+			topics:  [][]common.Hash{{logTopic1}},
+			expLogs: []*ethtypes.Log{blockLogs[1][0], blockLogs[1][1]},
+		},
+		{
+			name:    "by any of the first topics",
+			from:    1,
+			to:      3,
+			topics:  [][]common.Hash{{logTopic1, logTopic2}},
+			expLogs: []*ethtypes.Log{blockLogs[1][0], blockLogs[1][1], blockLogs[2][0]},
+		},
+		{
+			name:    "by second topic with wildcard first topic",
+			from:    1,
+			to:      3,
+			topics:  [][]common.Hash{{}, {logTopic2}},
+			expLogs: []*ethtypes.Log{blockLogs[1][0]},
+		},
+		{
+			name:      "by address and topic",
+			from:      1,
+			to:        3,
+			addresses: []common.Address{logAddrA},
+			topics:    [][]common.Hash{{logTopic2}},
+			expLogs:   []*ethtypes.Log{blockLogs[2][0]},
+		},
+		{
+			name:      "no match",
+			from:      1,
+			to:        3,
+			addresses: []common.Address{logAddrB},
+			topics:    [][]common.Hash{{logTopic2}},
+			expLogs:   []*ethtypes.Log{},
+		},
+		{
+			name:   "limit exceeded",
+			from:   1,
+			to:     3,
+			limit:  2,
+			expErr: "query returned more than 2 results",
+		},
+		{
+			name:      "limit exceeded with criteria",
+			from:      1,
+			to:        3,
+			addresses: []common.Address{logAddrA},
+			limit:     1,
+			expErr:    "query returned more than 1 results",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics, tc.limit)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expLogs, logs)
+		})
+	}
+}
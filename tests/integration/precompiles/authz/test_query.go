@@ -0,0 +1,257 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+var (
+	sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	voteMsgTypeURL = sdk.MsgTypeURL(&govv1.MsgVote{})
+)
+
+// saveGrants saves two generic authorizations from the first to the second
+// account of the keyring, and one from the third to the second account.
+func (s *PrecompileTestSuite) saveGrants(ctx sdk.Context) {
+	authzKeeper := s.network.App.GetAuthzKeeper()
+	for _, msgTypeURL := range []string{sendMsgTypeURL, voteMsgTypeURL} {
+		err := authzKeeper.SaveGrant(
+			ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sdkauthz.NewGenericAuthorization(msgTypeURL), nil,
+		)
+		s.Require().NoError(err)
+	}
+	err := authzKeeper.SaveGrant(
+		ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(2), sdkauthz.NewGenericAuthorization(sendMsgTypeURL), nil,
+	)
+	s.Require().NoError(err)
+}
+
+func (s *PrecompileTestSuite) TestGrants() {
+	method := s.precompile.Methods[authz.GrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *authz.GrantsOutput)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(*authz.GrantsOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"success - all authorizations of the granter to the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Len(out.Authorizations, 2)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				for _, grant := range out.Authorizations {
+					s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+					s.Require().Equal(s.keyring.GetAddr(1), grant.Grantee)
+					s.Require().Equal(int64(0), grant.Expiration)
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - authorization for a msg type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL, query.PageRequest{}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Len(out.Authorizations, 1)
+				s.Require().Equal(voteMsgTypeURL, out.Authorizations[0].MsgTypeURL)
+				s.Require().Contains(string(out.Authorizations[0].Authorization), "GenericAuthorization")
+			},
+			false,
+			"",
+		},
+		{
+			"success - no authorization for a msg type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), s.keyring.GetAddr(1), voteMsgTypeURL, query.PageRequest{}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Empty(out.Authorizations)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+			s.saveGrants(ctx)
+
+			bz, err := s.precompile.Grants(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz))
+				tc.postCheck(&out)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranterGrants() {
+	method := s.precompile.Methods[authz.GranterGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expGrants   int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"success - authorizations of the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 10}}
+			},
+			2,
+			false,
+			"",
+		},
+		{
+			"success - no authorization granted",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{Limit: 10}}
+			},
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+			s.saveGrants(ctx)
+
+			bz, err := s.precompile.GranterGrants(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GranterGrantsMethod, bz))
+				s.Require().Len(out.Authorizations, tc.expGrants)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranteeGrants() {
+	method := s.precompile.Methods[authz.GranteeGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expGrants   int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"success - authorizations of the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{Limit: 10}}
+			},
+			3,
+			false,
+			"",
+		},
+		{
+			"success - paginated authorizations of the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{Limit: 1}}
+			},
+			1,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+			s.saveGrants(ctx)
+
+			bz, err := s.precompile.GranteeGrants(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GranteeGrantsMethod, bz))
+				s.Require().Len(out.Authorizations, tc.expGrants)
+			}
+		})
+	}
+}
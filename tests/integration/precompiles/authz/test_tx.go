@@ -0,0 +1,602 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *PrecompileTestSuite) TestGrantGenericAuthorization() {
+	method := s.precompile.Methods[authz.GrantGenericAuthorizationMethod]
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(ctx sdk.Context)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{
+					utiltx.GenerateAddress(), s.keyring.GetAddr(1), msgTypeURL, int64(0),
+				}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - granter and grantee are the same",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(0), msgTypeURL, int64(0),
+				}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"grantee and granter should be different",
+		},
+		{
+			"fail - disabled msg type",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), sdk.MsgTypeURL(&sdkauthz.MsgExec{}), int64(0),
+				}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"cannot be granted or executed through the authz precompile",
+		},
+		{
+			"fail - msg type disabled by the ante handler",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}), int64(0),
+				}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"cannot be granted or executed through the authz precompile",
+		},
+		{
+			"fail - nested grant",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), sdk.MsgTypeURL(&sdkauthz.MsgGrant{}), int64(0),
+				}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"cannot be granted or executed through the authz precompile",
+		},
+		{
+			"success - generic authorization granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), msgTypeURL, time.Now().Add(time.Hour).Unix(),
+				}
+			},
+			func(ctx sdk.Context) {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(
+					ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), msgTypeURL,
+				)
+				s.Require().NotNil(authorization)
+				s.Require().NotNil(expiration)
+				s.Require().IsType(&sdkauthz.GenericAuthorization{}, authorization)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				tc.gas,
+			)
+
+			res, err := s.precompile.GrantGenericAuthorization(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck(ctx)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantSendAuthorization() {
+	method := s.precompile.Methods[authz.GrantSendAuthorizationMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(ctx sdk.Context)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - empty spend limit",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, []common.Address{}, int64(0),
+				}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"spend limit",
+		},
+		{
+			"success - send authorization granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}},
+					[]common.Address{s.keyring.GetAddr(2)},
+					int64(0),
+				}
+			},
+			func(ctx sdk.Context) {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(
+					ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sdk.MsgTypeURL(&banktypes.MsgSend{}),
+				)
+				s.Require().Nil(expiration)
+				sendAuthorization, ok := authorization.(*banktypes.SendAuthorization)
+				s.Require().True(ok)
+				s.Require().Equal(
+					sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1e18))),
+					sendAuthorization.SpendLimit,
+				)
+				s.Require().Equal([]string{s.keyring.GetAccAddr(2).String()}, sendAuthorization.AllowList)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				tc.gas,
+			)
+
+			res, err := s.precompile.GrantSendAuthorization(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck(ctx)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantStakeAuthorization() {
+	method := s.precompile.Methods[authz.GrantStakeAuthorizationMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(ctx sdk.Context)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			"fail - invalid authorization type",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					uint8(0),
+					[]common.Address{s.keyring.GetAddr(2)},
+					[]common.Address{},
+					cmn.Coin{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(0)},
+					int64(0),
+				}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"invalid stake authorization type",
+		},
+		{
+			"success - delegate authorization granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE),
+					[]common.Address{s.keyring.GetAddr(2)},
+					[]common.Address{},
+					cmn.Coin{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(0)},
+					int64(0),
+				}
+			},
+			func(ctx sdk.Context) {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(
+					ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+				)
+				stakeAuthorization, ok := authorization.(*stakingtypes.StakeAuthorization)
+				s.Require().True(ok)
+				s.Require().Nil(stakeAuthorization.MaxTokens)
+				s.Require().Equal(
+					[]string{sdk.ValAddress(s.keyring.GetAccAddr(2)).String()},
+					stakeAuthorization.GetAllowList().Address,
+				)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				tc.gas,
+			)
+
+			res, err := s.precompile.GrantStakeAuthorization(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck(ctx)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authz.RevokeMethod]
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		postCheck   func(ctx sdk.Context)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(sdk.Context) []interface{} {
+				return []interface{}{}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func(sdk.Context) []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(0), msgTypeURL}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - no authorization to revoke",
+			func(sdk.Context) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), msgTypeURL}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"authorization not found",
+		},
+		{
+			"success - authorization revoked",
+			func(ctx sdk.Context) []interface{} {
+				err := s.network.App.GetAuthzKeeper().SaveGrant(
+					ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sdkauthz.NewGenericAuthorization(msgTypeURL), nil,
+				)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), msgTypeURL}
+			},
+			func(ctx sdk.Context) {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(
+					ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), msgTypeURL,
+				)
+				s.Require().Nil(authorization)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				tc.gas,
+			)
+
+			res, err := s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, tc.malleate(ctx))
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck(ctx)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	method := s.precompile.Methods[authz.ExecMethod]
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	amount := math.NewInt(1e18)
+
+	// newMsgSend returns the JSON encoded MsgSend of the given amount from the
+	// sender to the third account of the keyring
+	newMsgSend := func(from sdk.AccAddress) []byte {
+		msg := banktypes.NewMsgSend(from, s.keyring.GetAccAddr(2), sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amount)))
+		bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+		s.Require().NoError(err)
+		return bz
+	}
+
+	// saveGenericGrant grants the generic authorization of the given msg type
+	// from the first account of the keyring to the second one
+	saveGenericGrant := func(ctx sdk.Context, msgTypeURL string) {
+		err := s.network.App.GetAuthzKeeper().SaveGrant(
+			ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sdkauthz.NewGenericAuthorization(msgTypeURL), nil,
+		)
+		s.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		postCheck   func(ctx sdk.Context)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(sdk.Context) []interface{} {
+				return []interface{}{}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - msg.sender address does not match the grantee address",
+			func(sdk.Context) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{newMsgSend(s.keyring.GetAccAddr(0))}}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - message signed by the grantee",
+			func(sdk.Context) []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{newMsgSend(s.keyring.GetAccAddr(1))}}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"exec can only be used on behalf of a granter",
+		},
+		{
+			"fail - no authorization granted",
+			func(sdk.Context) []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{newMsgSend(s.keyring.GetAccAddr(0))}}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"authorization not found",
+		},
+		{
+			"fail - msg type disabled by the ante handler",
+			func(ctx sdk.Context) []interface{} {
+				msgTypeURL := sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{})
+				saveGenericGrant(ctx, msgTypeURL)
+
+				msg := sdkvesting.NewMsgCreateVestingAccount(
+					s.keyring.GetAccAddr(0), utiltx.GenerateAddress().Bytes(),
+					sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amount)), time.Now().Add(time.Hour).Unix(), false,
+				)
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{bz}}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"cannot be granted or executed through the authz precompile",
+		},
+		{
+			"fail - nested grant",
+			func(ctx sdk.Context) []interface{} {
+				grantMsgTypeURL := sdk.MsgTypeURL(&sdkauthz.MsgGrant{})
+				saveGenericGrant(ctx, grantMsgTypeURL)
+
+				msg, err := sdkauthz.NewMsgGrant(
+					s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sdkauthz.NewGenericAuthorization(sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})), nil,
+				)
+				s.Require().NoError(err)
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{bz}}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"cannot be granted or executed through the authz precompile",
+		},
+		{
+			"fail - msg executing EVM calls",
+			func(ctx sdk.Context) []interface{} {
+				msg := &erc20types.MsgRegisterERC20{
+					Signer:         s.keyring.GetAccAddr(0).String(),
+					Erc20Addresses: []string{utiltx.GenerateAddress().Hex()},
+				}
+				saveGenericGrant(ctx, sdk.MsgTypeURL(msg))
+
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{bz}}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"cannot be granted or executed through the authz precompile",
+		},
+		{
+			"success - message executed on behalf of the granter",
+			func(ctx sdk.Context) []interface{} {
+				err := s.network.App.GetAuthzKeeper().SaveGrant(
+					ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sdkauthz.NewGenericAuthorization(msgTypeURL), nil,
+				)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{newMsgSend(s.keyring.GetAccAddr(0))}}
+			},
+			func(ctx sdk.Context) {
+				bankKeeper := s.network.App.GetBankKeeper()
+				granterBalance := bankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), s.network.GetBaseDenom())
+				recipientBalance := bankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(2), s.network.GetBaseDenom())
+
+				initialBalance := network.PrefundedAccountInitialBalance
+				s.Require().Equal(initialBalance.Sub(amount), granterBalance.Amount)
+				s.Require().Equal(initialBalance.Add(amount), recipientBalance.Amount)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(1),
+				s.precompile.Address(),
+				tc.gas,
+			)
+
+			res, err := s.precompile.Exec(ctx, contract, s.network.GetStateDB(), &method, tc.malleate(ctx))
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var results [][]byte
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&results, authz.ExecMethod, res))
+				s.Require().Len(results, 1)
+				tc.postCheck(ctx)
+			}
+		})
+	}
+}
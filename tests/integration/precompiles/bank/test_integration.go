@@ -14,6 +14,7 @@ import (
 
 	bank2 "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bank/testdata"
+	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/precompiles/testutil"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
@@ -27,6 +28,7 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -295,6 +297,67 @@ func TestIntegrationSuite(t *testing.T, create network.CreateEvmApp, options ...
 			})
 		})
 
+		Context("Direct precompile transactions", func() {
+			Context("send transaction", func() {
+				It("should transfer the EVM coin and an ERC20 registered coin", func() {
+					receiver := utiltx.GenerateAddress()
+					evmDenom := evmtypes.GetEVMCoinDenom()
+					coins := []cmn.Coin{
+						{Denom: evmDenom, Amount: big.NewInt(100)},
+						{Denom: is.tokenDenom, Amount: big.NewInt(200)},
+					}
+
+					sendCheck := passCheck.WithABIEvents(is.precompile.Events).WithExpEvents(bank2.EventTypeSend, bank2.EventTypeSend)
+					txArgs, sendArgs := getTxAndCallArgs(directCall, contractData, bank2.SendMethod, receiver, coins)
+					_, _, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, sendArgs, sendCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+
+					// the balance changes committed by the EVM state must match the transfer
+					for _, coin := range coins {
+						balance, err := is.grpcHandler.GetBalanceFromBank(receiver.Bytes(), coin.Denom)
+						Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+						Expect(balance.Balance.Amount.BigInt()).To(Equal(coin.Amount))
+					}
+				})
+
+				It("should fail to transfer to a blocked address", func() {
+					blockedAddr := common.BytesToAddress(authtypes.NewModuleAddress(minttypes.ModuleName))
+					coins := []cmn.Coin{{Denom: is.tokenDenom, Amount: big.NewInt(100)}}
+
+					txArgs, sendArgs := getTxAndCallArgs(directCall, contractData, bank2.SendMethod, blockedAddr, coins)
+					_, _, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, sendArgs, passCheck.WithExpPass(false))
+					Expect(err).To(HaveOccurred(), "expected transfer to a blocked address to fail")
+				})
+			})
+
+			Context("multiSend transaction", func() {
+				It("should transfer the coins to all the recipients", func() {
+					receivers := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+					inputs := []bank2.Input{{
+						Addr:  sender.Addr,
+						Coins: []cmn.Coin{{Denom: is.tokenDenom, Amount: big.NewInt(300)}},
+					}}
+					outputs := []bank2.Output{
+						{Addr: receivers[0], Coins: []cmn.Coin{{Denom: is.tokenDenom, Amount: big.NewInt(100)}}},
+						{Addr: receivers[1], Coins: []cmn.Coin{{Denom: is.tokenDenom, Amount: big.NewInt(200)}}},
+					}
+
+					sendCheck := passCheck.WithABIEvents(is.precompile.Events).WithExpEvents(bank2.EventTypeSend, bank2.EventTypeSend)
+					txArgs, sendArgs := getTxAndCallArgs(directCall, contractData, bank2.MultiSendMethod, inputs, outputs)
+					_, _, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, sendArgs, sendCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+
+					for i, receiver := range receivers {
+						balance, err := is.grpcHandler.GetBalanceFromBank(receiver.Bytes(), is.tokenDenom)
+						Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+						Expect(balance.Balance.Amount.BigInt()).To(Equal(outputs[i].Coins[0].Amount))
+					}
+				})
+			})
+		})
+
 		Context("Calls from a contract", func() {
 			const (
 				BalancesFunction = "callBalances"
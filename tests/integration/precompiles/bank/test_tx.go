@@ -0,0 +1,241 @@
+package bank
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/bank"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	cosmosevmutiltx "github.com/cosmos/evm/testutil/tx"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (s *PrecompileTestSuite) TestSend() {
+	method := s.precompile.Methods[bank.SendMethod]
+	receiver := cosmosevmutiltx.GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{receiver}
+			},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - invalid recipient address",
+			func() []interface{} {
+				return []interface{}{"random text", []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}
+			},
+			false,
+			"invalid hex address",
+		},
+		{
+			"fail - invalid coins",
+			func() []interface{} {
+				return []interface{}{receiver, "random text"}
+			},
+			false,
+			"invalid amount",
+		},
+		{
+			"fail - zero amount",
+			func() []interface{} {
+				return []interface{}{receiver, []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(0)}}}
+			},
+			false,
+			"invalid coins",
+		},
+		{
+			"fail - blocked recipient",
+			func() []interface{} {
+				blockedAddr := common.BytesToAddress(authtypes.NewModuleAddress(minttypes.ModuleName))
+				return []interface{}{blockedAddr, []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}
+			},
+			false,
+			"is not allowed to receive funds",
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				amount := network.PrefundedAccountInitialBalance.Add(math.OneInt()).BigInt()
+				return []interface{}{receiver, []cmn.Coin{{Denom: s.tokenDenom, Amount: amount}}}
+			},
+			false,
+			"insufficient funds",
+		},
+		{
+			"pass - send coins",
+			func() []interface{} {
+				return []interface{}{receiver, []cmn.Coin{
+					{Denom: s.bondDenom, Amount: big.NewInt(100)},
+					{Denom: s.tokenDenom, Amount: big.NewInt(200)},
+				}}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx := s.SetupTest() // reset the chain each test
+			stateDB := s.network.GetStateDB()
+			sender := s.keyring.GetKey(0)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, sender.Addr, s.precompile.Address(), 200_000)
+
+			args := tc.malleate()
+			bz, err := s.precompile.Send(ctx, contract, stateDB, &method, args)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+
+				for _, coin := range args[1].([]cmn.Coin) {
+					balance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), coin.Denom)
+					s.Require().Equal(coin.Amount, balance.Amount.BigInt())
+				}
+
+				logs := stateDB.Logs()
+				s.Require().Len(logs, 2, "expected a Send event per coin")
+				s.Require().Equal(s.precompile.Events[bank.EventTypeSend].ID, logs[0].Topics[0])
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	method := s.precompile.Methods[bank.MultiSendMethod]
+	receivers := []common.Address{cosmosevmutiltx.GenerateAddress(), cosmosevmutiltx.GenerateAddress()}
+
+	testcases := []struct {
+		name        string
+		malleate    func(sender common.Address) []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(common.Address) []interface{} {
+				return []interface{}{[]bank.Input{}}
+			},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - no inputs",
+			func(common.Address) []interface{} {
+				return []interface{}{
+					[]bank.Input{},
+					[]bank.Output{{Addr: receivers[0], Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}},
+				}
+			},
+			false,
+			"no inputs to send transaction",
+		},
+		{
+			"fail - multiple inputs",
+			func(sender common.Address) []interface{} {
+				return []interface{}{
+					[]bank.Input{
+						{Addr: sender, Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}},
+						{Addr: sender, Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}},
+					},
+					[]bank.Output{{Addr: receivers[0], Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(2)}}}},
+				}
+			},
+			false,
+			"multiple senders not allowed",
+		},
+		{
+			"fail - no outputs",
+			func(sender common.Address) []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: sender, Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}},
+					[]bank.Output{},
+				}
+			},
+			false,
+			"no outputs to send transaction",
+		},
+		{
+			"fail - inputs and outputs mismatch",
+			func(sender common.Address) []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: sender, Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(3)}}}},
+					[]bank.Output{{Addr: receivers[0], Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(2)}}}},
+				}
+			},
+			false,
+			"sum inputs != sum outputs",
+		},
+		{
+			"fail - input is not the caller",
+			func(common.Address) []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: s.keyring.GetAddr(1), Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}},
+					[]bank.Output{{Addr: receivers[0], Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}},
+				}
+			},
+			false,
+			"does not match the requester address",
+		},
+		{
+			"pass - send coins to multiple recipients",
+			func(sender common.Address) []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: sender, Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(300)}}}},
+					[]bank.Output{
+						{Addr: receivers[0], Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(100)}}},
+						{Addr: receivers[1], Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(200)}}},
+					},
+				}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx := s.SetupTest() // reset the chain each test
+			stateDB := s.network.GetStateDB()
+			sender := s.keyring.GetKey(0)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, sender.Addr, s.precompile.Address(), 200_000)
+
+			args := tc.malleate(sender.Addr)
+			bz, err := s.precompile.MultiSend(ctx, contract, stateDB, &method, args)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+
+				for _, output := range args[1].([]bank.Output) {
+					balance := s.network.App.GetBankKeeper().GetBalance(ctx, output.Addr.Bytes(), s.tokenDenom)
+					s.Require().Equal(output.Coins[0].Amount, balance.Amount.BigInt())
+				}
+
+				s.Require().Len(stateDB.Logs(), 2, "expected a Send event per output")
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			}
+		})
+	}
+}
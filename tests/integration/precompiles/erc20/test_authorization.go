@@ -0,0 +1,291 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// signTransferAuthorization signs an EIP-3009 transfer or receive authorization
+// of the given primary type with the private key of the keyring account with
+// the given index.
+func (s *PrecompileTestSuite) signTransferAuthorization(
+	ctx sdk.Context,
+	keyIdx int,
+	primaryType string,
+	to common.Address,
+	value, validAfter, validBefore *big.Int,
+	nonce common.Hash,
+) (uint8, [32]byte, [32]byte) {
+	return s.signTypedData(ctx, s.precompile2, keyIdx, primaryType, []apitypes.Type{
+		{Name: "from", Type: "address"},
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "validAfter", Type: "uint256"},
+		{Name: "validBefore", Type: "uint256"},
+		{Name: "nonce", Type: "bytes32"},
+	}, apitypes.TypedDataMessage{
+		"from":        s.keyring.GetAddr(keyIdx).Hex(),
+		"to":          to.Hex(),
+		"value":       value.String(),
+		"validAfter":  validAfter.String(),
+		"validBefore": validBefore.String(),
+		"nonce":       nonce.Hex(),
+	})
+}
+
+// signCancelAuthorization signs an EIP-3009 authorization cancellation with the
+// private key of the keyring account with the given index.
+func (s *PrecompileTestSuite) signCancelAuthorization(
+	ctx sdk.Context,
+	keyIdx int,
+	nonce common.Hash,
+) (uint8, [32]byte, [32]byte) {
+	return s.signTypedData(ctx, s.precompile2, keyIdx, "CancelAuthorization", []apitypes.Type{
+		{Name: "authorizer", Type: "address"},
+		{Name: "nonce", Type: "bytes32"},
+	}, apitypes.TypedDataMessage{
+		"authorizer": s.keyring.GetAddr(keyIdx).Hex(),
+		"nonce":      hexutil.Encode(nonce.Bytes()),
+	})
+}
+
+func (s *PrecompileTestSuite) TestTransferWithAuthorization() {
+	var from common.Address
+	to := utiltx.GenerateAddress()
+	relayer := utiltx.GenerateAddress()
+	value := big.NewInt(100)
+	nonce := common.HexToHash("0x01")
+
+	testcases := []struct {
+		name        string
+		method      string
+		caller      common.Address
+		malleate    func(ctx sdk.Context) []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			erc20.TransferWithAuthorizationMethod,
+			relayer,
+			func(sdk.Context) []interface{} {
+				return []interface{}{from, to, value}
+			},
+			true,
+			"invalid number of arguments; expected 9; got: 3",
+		},
+		{
+			"fail - authorization is not yet valid",
+			erc20.TransferWithAuthorizationMethod,
+			relayer,
+			func(ctx sdk.Context) []interface{} {
+				validAfter, validBefore := big.NewInt(ctx.BlockTime().Unix()), big.NewInt(ctx.BlockTime().Unix()+100)
+				v, r, sv := s.signTransferAuthorization(ctx, 0, "TransferWithAuthorization", to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, [32]byte(nonce), v, r, sv}
+			},
+			true,
+			erc20.ErrAuthorizationNotYetValid.Error(),
+		},
+		{
+			"fail - authorization is expired",
+			erc20.TransferWithAuthorizationMethod,
+			relayer,
+			func(ctx sdk.Context) []interface{} {
+				validAfter, validBefore := common.Big0, big.NewInt(ctx.BlockTime().Unix())
+				v, r, sv := s.signTransferAuthorization(ctx, 0, "TransferWithAuthorization", to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, [32]byte(nonce), v, r, sv}
+			},
+			true,
+			erc20.ErrAuthorizationExpired.Error(),
+		},
+		{
+			"fail - authorization is already used",
+			erc20.TransferWithAuthorizationMethod,
+			relayer,
+			func(ctx sdk.Context) []interface{} {
+				s.network.App.GetErc20Keeper().SetAuthorizationUsed(ctx, s.precompile2.Address(), from, nonce)
+				validAfter, validBefore := common.Big0, big.NewInt(ctx.BlockTime().Unix()+100)
+				v, r, sv := s.signTransferAuthorization(ctx, 0, "TransferWithAuthorization", to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, [32]byte(nonce), v, r, sv}
+			},
+			true,
+			erc20.ErrAuthorizationUsed.Error(),
+		},
+		{
+			"fail - signed by another account",
+			erc20.TransferWithAuthorizationMethod,
+			relayer,
+			func(ctx sdk.Context) []interface{} {
+				validAfter, validBefore := common.Big0, big.NewInt(ctx.BlockTime().Unix()+100)
+				v, r, sv := s.signTransferAuthorization(ctx, 1, "TransferWithAuthorization", to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, [32]byte(nonce), v, r, sv}
+			},
+			true,
+			erc20.ErrAuthorizationInvalidSigner.Error(),
+		},
+		{
+			"fail - receive authorization used as a transfer authorization",
+			erc20.TransferWithAuthorizationMethod,
+			relayer,
+			func(ctx sdk.Context) []interface{} {
+				validAfter, validBefore := common.Big0, big.NewInt(ctx.BlockTime().Unix()+100)
+				v, r, sv := s.signTransferAuthorization(ctx, 0, "ReceiveWithAuthorization", to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, [32]byte(nonce), v, r, sv}
+			},
+			true,
+			erc20.ErrAuthorizationInvalidSigner.Error(),
+		},
+		{
+			"fail - receive authorization not submitted by the payee",
+			erc20.ReceiveWithAuthorizationMethod,
+			relayer,
+			func(ctx sdk.Context) []interface{} {
+				validAfter, validBefore := common.Big0, big.NewInt(ctx.BlockTime().Unix()+100)
+				v, r, sv := s.signTransferAuthorization(ctx, 0, "ReceiveWithAuthorization", to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, [32]byte(nonce), v, r, sv}
+			},
+			true,
+			erc20.ErrAuthorizationCallerNotPayee.Error(),
+		},
+		{
+			"pass - transfer with authorization submitted by a relayer",
+			erc20.TransferWithAuthorizationMethod,
+			relayer,
+			func(ctx sdk.Context) []interface{} {
+				validAfter, validBefore := common.Big0, big.NewInt(ctx.BlockTime().Unix()+100)
+				v, r, sv := s.signTransferAuthorization(ctx, 0, "TransferWithAuthorization", to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, [32]byte(nonce), v, r, sv}
+			},
+			false,
+			"",
+		},
+		{
+			"pass - receive with authorization submitted by the payee",
+			erc20.ReceiveWithAuthorizationMethod,
+			to,
+			func(ctx sdk.Context) []interface{} {
+				validAfter, validBefore := common.Big0, big.NewInt(ctx.BlockTime().Unix()+100)
+				v, r, sv := s.signTransferAuthorization(ctx, 0, "ReceiveWithAuthorization", to, value, validAfter, validBefore, nonce)
+				return []interface{}{from, to, value, validAfter, validBefore, [32]byte(nonce), v, r, sv}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			method := s.precompile2.Methods[tc.method]
+			from = s.keyring.GetAddr(0)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), tc.caller, s.precompile2.Address(), 0)
+
+			args := tc.malleate(ctx)
+			var err error
+			if tc.method == erc20.ReceiveWithAuthorizationMethod {
+				_, err = s.precompile2.ReceiveWithAuthorization(ctx, contract, stateDB, &method, args)
+			} else {
+				_, err = s.precompile2.TransferWithAuthorization(ctx, contract, stateDB, &method, args)
+			}
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			toBalance := s.network.App.GetBankKeeper().GetBalance(ctx, to.Bytes(), s.bondDenom)
+			s.Require().Equal(value, toBalance.Amount.BigInt())
+			s.Require().True(s.network.App.GetErc20Keeper().IsAuthorizationUsed(ctx, s.precompile2.Address(), from, nonce))
+
+			// the authorization cannot be replayed
+			_, err = s.precompile2.TransferWithAuthorization(ctx, contract, stateDB, &method, args)
+			s.Require().ErrorContains(err, erc20.ErrAuthorizationUsed.Error())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelAuthorization() {
+	method := s.precompile2.Methods[erc20.CancelAuthorizationMethod]
+	stateMethod := s.precompile2.Methods[erc20.AuthorizationStateMethod]
+	var authorizer common.Address
+	nonce := common.HexToHash("0x01")
+
+	testcases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(sdk.Context) []interface{} {
+				return []interface{}{authorizer, [32]byte(nonce)}
+			},
+			true,
+			"invalid number of arguments; expected 5; got: 2",
+		},
+		{
+			"fail - authorization is already used",
+			func(ctx sdk.Context) []interface{} {
+				s.network.App.GetErc20Keeper().SetAuthorizationUsed(ctx, s.precompile2.Address(), authorizer, nonce)
+				v, r, sv := s.signCancelAuthorization(ctx, 0, nonce)
+				return []interface{}{authorizer, [32]byte(nonce), v, r, sv}
+			},
+			true,
+			erc20.ErrAuthorizationUsed.Error(),
+		},
+		{
+			"fail - signed by another account",
+			func(ctx sdk.Context) []interface{} {
+				v, r, sv := s.signCancelAuthorization(ctx, 1, nonce)
+				return []interface{}{authorizer, [32]byte(nonce), v, r, sv}
+			},
+			true,
+			erc20.ErrAuthorizationInvalidSigner.Error(),
+		},
+		{
+			"pass - authorization canceled",
+			func(ctx sdk.Context) []interface{} {
+				v, r, sv := s.signCancelAuthorization(ctx, 0, nonce)
+				return []interface{}{authorizer, [32]byte(nonce), v, r, sv}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			authorizer = s.keyring.GetAddr(0)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile2.Address(), 0)
+
+			_, err := s.precompile2.CancelAuthorization(ctx, contract, stateDB, &method, tc.malleate(ctx))
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			bz, err := s.precompile2.AuthorizationState(ctx, contract, stateDB, &stateMethod, []interface{}{authorizer, [32]byte(nonce)})
+			s.Require().NoError(err)
+			out, err := stateMethod.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// eip712DomainTypes are the EIP-712 types of the signing domain of the ERC-20 precompiles.
+var eip712DomainTypes = []apitypes.Type{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+}
+
+// signTypedData signs the given EIP-712 message on the signing domain of the
+// given precompile with the private key of the keyring account with the given
+// index, and returns the v, r and s values of the signature.
+func (s *PrecompileTestSuite) signTypedData(
+	ctx sdk.Context,
+	precompile *erc20.Precompile,
+	keyIdx int,
+	primaryType string,
+	fields []apitypes.Type,
+	message apitypes.TypedDataMessage,
+) (uint8, [32]byte, [32]byte) {
+	typedData := s.typedData(ctx, precompile, primaryType, fields, message)
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	s.Require().NoError(err)
+
+	privKey, ok := s.keyring.GetPrivKey(keyIdx).(*ethsecp256k1.PrivKey)
+	s.Require().True(ok)
+	key, err := privKey.ToECDSA()
+	s.Require().NoError(err)
+
+	sig, err := crypto.Sign(hash, key)
+	s.Require().NoError(err)
+
+	var r, sv [32]byte
+	copy(r[:], sig[:32])
+	copy(sv[:], sig[32:64])
+	return sig[64] + 27, r, sv
+}
+
+// typedData returns the EIP-712 typed data of the given message on the signing
+// domain of the given precompile.
+func (s *PrecompileTestSuite) typedData(
+	ctx sdk.Context,
+	precompile *erc20.Precompile,
+	primaryType string,
+	fields []apitypes.Type,
+	message apitypes.TypedDataMessage,
+) apitypes.TypedData {
+	nameMethod := precompile.Methods[erc20.NameMethod]
+	bz, err := precompile.Name(ctx, nil, nil, &nameMethod, nil)
+	s.Require().NoError(err)
+	out, err := nameMethod.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": eip712DomainTypes,
+			primaryType:    fields,
+		},
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              out[0].(string),
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(evmtypes.GetEthChainConfig().ChainID.Int64()),
+			VerifyingContract: precompile.Address().Hex(),
+		},
+		Message: message,
+	}
+}
+
+// signPermit signs an EIP-2612 permit with the private key of the keyring
+// account with the given index.
+func (s *PrecompileTestSuite) signPermit(
+	ctx sdk.Context,
+	keyIdx int,
+	spender common.Address,
+	value, nonce, deadline *big.Int,
+) (uint8, [32]byte, [32]byte) {
+	return s.signTypedData(ctx, s.precompile2, keyIdx, "Permit", []apitypes.Type{
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	}, apitypes.TypedDataMessage{
+		"owner":    s.keyring.GetAddr(keyIdx).Hex(),
+		"spender":  spender.Hex(),
+		"value":    value.String(),
+		"nonce":    nonce.String(),
+		"deadline": deadline.String(),
+	})
+}
+
+func (s *PrecompileTestSuite) TestPermit() {
+	var owner common.Address
+	method := s.precompile2.Methods[erc20.PermitMethod]
+	spender := utiltx.GenerateAddress()
+	value := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		postCheck   func(ctx sdk.Context)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(sdk.Context) []interface{} {
+				return []interface{}{owner, spender, value}
+			},
+			func(sdk.Context) {},
+			true,
+			"invalid number of arguments; expected 7; got: 3",
+		},
+		{
+			"fail - invalid signature v",
+			func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 100)
+				_, r, sv := s.signPermit(ctx, 0, spender, value, common.Big0, deadline)
+				return []interface{}{owner, spender, value, deadline, uint8(0), r, sv}
+			},
+			func(sdk.Context) {},
+			true,
+			"invalid signature v",
+		},
+		{
+			"fail - expired deadline",
+			func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() - 1)
+				v, r, sv := s.signPermit(ctx, 0, spender, value, common.Big0, deadline)
+				return []interface{}{owner, spender, value, deadline, v, r, sv}
+			},
+			func(sdk.Context) {},
+			true,
+			erc20.ErrPermitExpired.Error(),
+		},
+		{
+			"fail - spender is the zero address",
+			func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 100)
+				v, r, sv := s.signPermit(ctx, 0, common.Address{}, value, common.Big0, deadline)
+				return []interface{}{owner, common.Address{}, value, deadline, v, r, sv}
+			},
+			func(sdk.Context) {},
+			true,
+			erc20.ErrPermitInvalidSpender.Error(),
+		},
+		{
+			"fail - signed by another account",
+			func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 100)
+				v, r, sv := s.signPermit(ctx, 1, spender, value, common.Big0, deadline)
+				return []interface{}{owner, spender, value, deadline, v, r, sv}
+			},
+			func(sdk.Context) {},
+			true,
+			erc20.ErrPermitInvalidSigner.Error(),
+		},
+		{
+			"fail - signed with a wrong nonce",
+			func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 100)
+				v, r, sv := s.signPermit(ctx, 0, spender, value, common.Big1, deadline)
+				return []interface{}{owner, spender, value, deadline, v, r, sv}
+			},
+			func(sdk.Context) {},
+			true,
+			erc20.ErrPermitInvalidSigner.Error(),
+		},
+		{
+			"fail - signed value does not match",
+			func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 100)
+				v, r, sv := s.signPermit(ctx, 0, spender, value, common.Big0, deadline)
+				return []interface{}{owner, spender, big.NewInt(1000), deadline, v, r, sv}
+			},
+			func(sdk.Context) {},
+			true,
+			erc20.ErrPermitInvalidSigner.Error(),
+		},
+		{
+			"pass - permit sets the allowance and consumes the nonce",
+			func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 100)
+				v, r, sv := s.signPermit(ctx, 0, spender, value, common.Big0, deadline)
+				return []interface{}{owner, spender, value, deadline, v, r, sv}
+			},
+			func(ctx sdk.Context) {
+				erc20Keeper := s.network.App.GetErc20Keeper()
+				allowance, err := erc20Keeper.GetAllowance(ctx, s.precompile2.Address(), owner, spender)
+				s.Require().NoError(err)
+				s.Require().Equal(value, allowance)
+				s.Require().Equal(uint64(1), erc20Keeper.GetPermitNonce(ctx, s.precompile2.Address(), owner))
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			owner = s.keyring.GetAddr(0)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile2.Address(), 0)
+
+			args := tc.malleate(ctx)
+			_, err := s.precompile2.Permit(ctx, contract, stateDB, &method, args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			tc.postCheck(ctx)
+
+			// the permit cannot be replayed
+			_, err = s.precompile2.Permit(ctx, contract, stateDB, &method, args)
+			s.Require().ErrorContains(err, erc20.ErrPermitInvalidSigner.Error())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestNonces() {
+	method := s.precompile2.Methods[erc20.NoncesMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	owner := s.keyring.GetAddr(0)
+
+	bz, err := s.precompile2.Nonces(ctx, nil, nil, &method, []interface{}{owner})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), out[0].(*big.Int).Int64())
+
+	s.network.App.GetErc20Keeper().SetPermitNonce(ctx, s.precompile2.Address(), owner, 5)
+
+	bz, err = s.precompile2.Nonces(ctx, nil, nil, &method, []interface{}{owner})
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(5), out[0].(*big.Int).Int64())
+
+	_, err = s.precompile2.Nonces(ctx, nil, nil, &method, []interface{}{})
+	s.Require().ErrorContains(err, "invalid number of arguments; expected 1; got: 0")
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	method := s.precompile2.Methods[erc20.DomainSeparatorMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	bz, err := s.precompile2.DomainSeparator(ctx, nil, nil, &method, nil)
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+
+	typedData := s.typedData(ctx, s.precompile2, "Permit", nil, nil)
+	expDomainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	s.Require().NoError(err)
+	s.Require().Equal(hexutil.Encode(expDomainSeparator), hexutil.Encode(common.Hash(out[0].([32]byte)).Bytes()))
+}
@@ -0,0 +1,235 @@
+package feegrant
+
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// saveAllowances saves a fee allowance from the first to the second account
+// of the keyring, and one from the third to the second account.
+func (s *PrecompileTestSuite) saveAllowances(ctx sdk.Context) {
+	feegrantKeeper := s.network.App.GetFeeGrantKeeper()
+	allowance := &sdkfeegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1e18))),
+	}
+	for _, granterIdx := range []int{0, 2} {
+		err := feegrantKeeper.GrantAllowance(
+			ctx, s.keyring.GetAccAddr(granterIdx), s.keyring.GetAccAddr(1), allowance,
+		)
+		s.Require().NoError(err)
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - no allowance granted",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(0)}
+			},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - allowance of the granter to the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+			s.saveAllowances(ctx)
+
+			bz, err := s.precompile.Allowance(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out struct{ Allowance feegrant.FeeAllowance }
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz))
+				s.Require().Equal(s.keyring.GetAddr(0), out.Allowance.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), out.Allowance.Grantee)
+				s.Require().Contains(string(out.Allowance.Allowance), "BasicAllowance")
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+
+	testCases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expAllowances int
+		expError      bool
+		errContains   string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"success - allowances of the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{Limit: 10}}
+			},
+			2,
+			false,
+			"",
+		},
+		{
+			"success - paginated allowances of the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{Limit: 1}}
+			},
+			1,
+			false,
+			"",
+		},
+		{
+			"success - no allowance granted",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 10}}
+			},
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+			s.saveAllowances(ctx)
+
+			bz, err := s.precompile.Allowances(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out feegrant.AllowancesOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz))
+				s.Require().Len(out.Allowances, tc.expAllowances)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowancesByGranter() {
+	method := s.precompile.Methods[feegrant.AllowancesByGranterMethod]
+
+	testCases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expAllowances int
+		expError      bool
+		errContains   string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"success - allowances of the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 10}}
+			},
+			1,
+			false,
+			"",
+		},
+		{
+			"success - no allowance granted",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{Limit: 10}}
+			},
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200000,
+			)
+			s.saveAllowances(ctx)
+
+			bz, err := s.precompile.AllowancesByGranter(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out feegrant.AllowancesOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesByGranterMethod, bz))
+				s.Require().Len(out.Allowances, tc.expAllowances)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	spendLimit := []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}}
+	ethTxTypeURL := sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+	newGrantee := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		postCheck   func(ctx sdk.Context)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(sdk.Context) []interface{} {
+				return []interface{}{}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func(sdk.Context) []interface{} {
+				return []interface{}{
+					utiltx.GenerateAddress(), s.keyring.GetAddr(1), spendLimit, int64(0), []string{},
+				}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - granter and grantee are the same",
+			func(sdk.Context) []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(0), spendLimit, int64(0), []string{},
+				}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"cannot self-grant fee authorization",
+		},
+		{
+			"fail - expiration in the past",
+			func(ctx sdk.Context) []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, ctx.BlockTime().Add(-time.Hour).Unix(), []string{},
+				}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"expiration is before current block time",
+		},
+		{
+			"fail - allowance already granted",
+			func(ctx sdk.Context) []interface{} {
+				err := s.network.App.GetFeeGrantKeeper().GrantAllowance(
+					ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), &sdkfeegrant.BasicAllowance{},
+				)
+				s.Require().NoError(err)
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0), []string{},
+				}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"fee allowance already exists",
+		},
+		{
+			"success - basic allowance granted",
+			func(ctx sdk.Context) []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, ctx.BlockTime().Add(time.Hour).Unix(), []string{},
+				}
+			},
+			func(ctx sdk.Context) {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(
+					ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1),
+				)
+				s.Require().NoError(err)
+				basic, ok := allowance.(*sdkfeegrant.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1e18))), basic.SpendLimit)
+				s.Require().NotNil(basic.Expiration)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - allowance restricted to EVM transactions granted to a new account",
+			func(sdk.Context) []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), newGrantee, []cmn.Coin{}, int64(0), []string{ethTxTypeURL},
+				}
+			},
+			func(ctx sdk.Context) {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(
+					ctx, s.keyring.GetAccAddr(0), newGrantee.Bytes(),
+				)
+				s.Require().NoError(err)
+				allowedMsgAllowance, ok := allowance.(*sdkfeegrant.AllowedMsgAllowance)
+				s.Require().True(ok)
+				s.Require().Equal([]string{ethTxTypeURL}, allowedMsgAllowance.AllowedMessages)
+				s.Require().True(s.network.App.GetAccountKeeper().HasAccount(ctx, newGrantee.Bytes()))
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				tc.gas,
+			)
+
+			res, err := s.precompile.GrantAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate(ctx))
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck(ctx)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		postCheck   func(ctx sdk.Context)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(sdk.Context) []interface{} {
+				return []interface{}{}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func(sdk.Context) []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(0)}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - no allowance to revoke",
+			func(sdk.Context) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(sdk.Context) {},
+			200000,
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - allowance revoked",
+			func(ctx sdk.Context) []interface{} {
+				err := s.network.App.GetFeeGrantKeeper().GrantAllowance(
+					ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), &sdkfeegrant.BasicAllowance{},
+				)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(ctx sdk.Context) {
+				_, err := s.network.App.GetFeeGrantKeeper().GetAllowance(
+					ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1),
+				)
+				s.Require().ErrorContains(err, "fee-grant not found")
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				tc.gas,
+			)
+
+			res, err := s.precompile.RevokeAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate(ctx))
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck(ctx)
+			}
+		})
+	}
+}
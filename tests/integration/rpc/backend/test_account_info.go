@@ -217,6 +217,45 @@ func (s *TestSuite) TestGetProof() {
 				},
 			},
 		},
+		{
+			"pass - Merkle-Patricia storage proofs",
+			address1,
+			[]string{"0x0"},
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				height := bn.Int64()
+				s.backend.Cfg.JSONRPC.EnableMPTProofs = true
+				s.backend.Ctx = rpctypes.ContextWithHeight(height)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterHeader(client, &height, nil)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccount(queryClient, addr, height)
+				RegisterStorageProof(queryClient, addr, []string{"0x0"}, height)
+				RegisterABCIQueryWithOptions(
+					client,
+					height,
+					"store/acc/key",
+					bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
+					cmtrpcclient.ABCIQueryOptions{Height: height, Prove: true},
+				)
+			},
+			true,
+			&rpctypes.AccountResult{
+				Address:      address1,
+				AccountProof: []string{""},
+				Balance:      (*hexutil.Big)(big.NewInt(0)),
+				CodeHash:     common.HexToHash(""),
+				Nonce:        0x0,
+				StorageHash:  common.HexToHash("0x1234"),
+				StorageProof: []rpctypes.StorageResult{
+					{
+						Key:   "0x0",
+						Value: (*hexutil.Big)(big.NewInt(2)),
+						Proof: []string{"0x01", "0x02"},
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -96,7 +96,9 @@ func (s *TestSuite) SetupTest() {
 	s.backend.Cfg.JSONRPC.EVMTimeout = 0
 	s.backend.Cfg.JSONRPC.AllowInsecureUnlock = true
 	s.backend.Cfg.EVM.EVMChainID = ChainID.EVMChainID
-	s.backend.QueryClient.QueryClient = mocks.NewEVMQueryClient(s.T())
+	queryClient := mocks.NewEVMQueryClient(s.T())
+	RegisterConfig(queryClient)
+	s.backend.QueryClient.QueryClient = queryClient
 	s.backend.QueryClient.FeeMarket = mocks.NewFeeMarketQueryClient(s.T())
 	s.backend.Ctx = rpctypes.ContextWithHeight(1)
 
@@ -199,6 +201,7 @@ func (s *TestSuite) buildFormattedBlock(
 		gasUsed,
 		ethRPCTxs,
 		bloom,
+		ethtypes.EmptyRootHash,
 		common.BytesToAddress(validator.Bytes()),
 		baseFee,
 	)
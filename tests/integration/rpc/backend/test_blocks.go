@@ -1121,6 +1121,7 @@ func (s *TestSuite) TestGetEthBlockFromComet() {
 				gasUsed,
 				ethRPCTxs,
 				bloom,
+				ethtypes.EmptyRootHash,
 				common.BytesToAddress(tc.validator.Bytes()),
 				tc.baseFee,
 			)
@@ -1261,6 +1262,8 @@ func (s *TestSuite) TestHeaderByNumber() {
 				expResultHeader = RegisterHeader(client, &height, nil)
 				_, err := RegisterBlockResults(client, height)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(QueryClient)
 			},
@@ -1276,6 +1279,8 @@ func (s *TestSuite) TestHeaderByNumber() {
 				expResultHeader = RegisterHeader(client, &height, nil)
 				_, err := RegisterBlockResults(client, height)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(QueryClient, baseFee)
 			},
@@ -1291,6 +1296,8 @@ func (s *TestSuite) TestHeaderByNumber() {
 				expResultHeader = RegisterHeader(client, &height, bz)
 				_, err := RegisterBlockResults(client, height)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, bz)
+				s.Require().NoError(err)
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(QueryClient, baseFee)
 			},
@@ -1305,7 +1312,7 @@ func (s *TestSuite) TestHeaderByNumber() {
 			header, err := s.backend.HeaderByNumber(tc.blockNumber)
 
 			if tc.expPass {
-				expHeader := ethrpc.EthHeaderFromComet(*expResultHeader.Header, ethtypes.Bloom{}, tc.baseFee)
+				expHeader := ethrpc.EthHeaderFromComet(*expResultHeader.Header, ethtypes.Bloom{}, ethtypes.EmptyRootHash, tc.baseFee)
 				s.Require().NoError(err)
 				s.Require().Equal(expHeader, header)
 			} else {
@@ -1372,6 +1379,8 @@ func (s *TestSuite) TestHeaderByHash() {
 				expResultHeader, _ = RegisterHeaderByHash(client, hash, bz)
 				_, err := RegisterBlockResults(client, height)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, bz)
+				s.Require().NoError(err)
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(QueryClient)
 			},
@@ -1387,6 +1396,8 @@ func (s *TestSuite) TestHeaderByHash() {
 				expResultHeader, _ = RegisterHeaderByHash(client, hash, nil)
 				_, err := RegisterBlockResults(client, height)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(QueryClient, baseFee)
 			},
@@ -1402,6 +1413,8 @@ func (s *TestSuite) TestHeaderByHash() {
 				expResultHeader, _ = RegisterHeaderByHash(client, hash, bz)
 				_, err := RegisterBlockResults(client, height)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, bz)
+				s.Require().NoError(err)
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(QueryClient, baseFee)
 			},
@@ -1416,7 +1429,7 @@ func (s *TestSuite) TestHeaderByHash() {
 			header, err := s.backend.HeaderByHash(tc.hash)
 
 			if tc.expPass {
-				expHeader := ethrpc.EthHeaderFromComet(*expResultHeader.Header, ethtypes.Bloom{}, tc.baseFee)
+				expHeader := ethrpc.EthHeaderFromComet(*expResultHeader.Header, ethtypes.Bloom{}, ethtypes.EmptyRootHash, tc.baseFee)
 				s.Require().NoError(err)
 				s.Require().Equal(expHeader, header)
 			} else {
@@ -1479,6 +1492,7 @@ func (s *TestSuite) TestEthBlockByNumber() {
 				ethrpc.EthHeaderFromComet(
 					emptyBlock.Header,
 					ethtypes.Bloom{},
+					ethtypes.EmptyRootHash,
 					math.NewInt(1).BigInt(),
 				),
 				&ethtypes.Body{},
@@ -1505,6 +1519,7 @@ func (s *TestSuite) TestEthBlockByNumber() {
 				ethrpc.EthHeaderFromComet(
 					emptyBlock.Header,
 					ethtypes.Bloom{},
+					ethtypes.EmptyRootHash,
 					math.NewInt(1).BigInt(),
 				),
 				&ethtypes.Body{
@@ -1570,6 +1585,7 @@ func (s *TestSuite) TestEthBlockFromCometBlock() {
 				ethrpc.EthHeaderFromComet(
 					emptyBlock.Header,
 					ethtypes.Bloom{},
+					ethtypes.EmptyRootHash,
 					math.NewInt(1).BigInt(),
 				),
 				&ethtypes.Body{},
@@ -1604,6 +1620,7 @@ func (s *TestSuite) TestEthBlockFromCometBlock() {
 				ethrpc.EthHeaderFromComet(
 					emptyBlock.Header,
 					ethtypes.Bloom{},
+					ethtypes.EmptyRootHash,
 					math.NewInt(1).BigInt(),
 				),
 				&ethtypes.Body{Transactions: []*ethtypes.Transaction{msgEthereumTx.AsTransaction()}},
@@ -75,6 +75,8 @@ func (s *TestSuite) TestResend() {
 				RegisterHeader(client, &height, nil)
 				_, err := RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				RegisterBaseFeeDisabled(QueryClient)
 			},
 			evmtypes.TransactionArgs{
@@ -98,6 +100,8 @@ func (s *TestSuite) TestResend() {
 				RegisterHeader(client, &height, nil)
 				_, err := RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				RegisterBaseFee(QueryClient, baseFee)
 			},
 			evmtypes.TransactionArgs{
@@ -118,6 +122,8 @@ func (s *TestSuite) TestResend() {
 				RegisterHeader(client, &height, nil)
 				_, err := RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				RegisterBaseFeeDisabled(QueryClient)
 			},
 			evmtypes.TransactionArgs{
@@ -172,6 +178,8 @@ func (s *TestSuite) TestResend() {
 				RegisterHeader(client, &height, nil)
 				_, err := RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				RegisterBaseFee(QueryClient, baseFee)
 			},
 			evmtypes.TransactionArgs{
@@ -196,6 +204,8 @@ func (s *TestSuite) TestResend() {
 				RegisterHeader(client, &height, nil)
 				_, err := RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				RegisterBaseFee(QueryClient, baseFee)
 			},
 			evmtypes.TransactionArgs{
@@ -217,6 +227,8 @@ func (s *TestSuite) TestResend() {
 				RegisterHeader(client, &height, nil)
 				_, err := RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				RegisterBaseFee(QueryClient, baseFee)
 				RegisterEstimateGas(QueryClient, callArgs)
 				RegisterParams(QueryClient, &header, height)
@@ -245,6 +257,8 @@ func (s *TestSuite) TestResend() {
 				RegisterHeader(client, &height, nil)
 				_, err := RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				RegisterBaseFee(QueryClient, baseFee)
 				RegisterEstimateGas(QueryClient, callArgs)
 				RegisterParams(QueryClient, &header, height)
@@ -426,11 +440,17 @@ func (s *TestSuite) TestDoCall() {
 	argsBz, err := json.Marshal(callArgs)
 	s.Require().NoError(err)
 
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+	overrides := rpctypes.StateOverride{toAddr: rpctypes.OverrideAccount{Balance: balance}}
+	overridesBz, err := json.Marshal(overrides)
+	s.Require().NoError(err)
+
 	testCases := []struct {
 		name         string
 		registerMock func()
 		blockNum     rpctypes.BlockNumber
 		callArgs     evmtypes.TransactionArgs
+		overrides    *rpctypes.StateOverride
 		expEthTx     *evmtypes.MsgEthereumTxResponse
 		expPass      bool
 	}{
@@ -445,6 +465,7 @@ func (s *TestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
 			&evmtypes.MsgEthereumTxResponse{},
 			false,
 		},
@@ -459,6 +480,22 @@ func (s *TestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+		},
+		{
+			"pass - Returned transaction response with state overrides",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				height := int64(1)
+				RegisterHeader(client, &height, bz)
+				RegisterEthCall(QueryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: s.backend.EvmChainID.Int64(), Overrides: overridesBz})
+			},
+			rpctypes.BlockNumber(1),
+			callArgs,
+			&overrides,
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
 		},
@@ -469,7 +506,7 @@ func (s *TestSuite) TestDoCall() {
 			s.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum, tc.overrides, nil)
 
 			if tc.expPass {
 				s.Require().Equal(tc.expEthTx, msgEthTx)
@@ -480,6 +517,169 @@ func (s *TestSuite) TestDoCall() {
 	}
 }
 
+func (s *TestSuite) TestSimulateV1() {
+	_, bz := s.buildEthereumTx()
+	sender := utiltx.GenerateAddress()
+	toAddr := utiltx.GenerateAddress()
+	opts := evmtypes.SimOpts{
+		ReturnFullTransactions: true,
+		BlockStateCalls: []evmtypes.SimBlock{
+			{Calls: []evmtypes.TransactionArgs{{From: &sender, To: &toAddr}}},
+		},
+	}
+	optsBz, err := json.Marshal(opts)
+	s.Require().NoError(err)
+
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   s.backend.EvmChainID,
+		To:        &toAddr,
+		Gas:       21000,
+		GasFeeCap: big.NewInt(0),
+		GasTipCap: big.NewInt(0),
+		Value:     big.NewInt(0),
+	})
+	header := &ethtypes.Header{
+		Number:     big.NewInt(2),
+		Difficulty: big.NewInt(0),
+		BaseFee:    big.NewInt(0),
+		GasUsed:    21000,
+	}
+	calls := []evmtypes.SimCallResult{{ReturnValue: hexutil.Bytes{}, Logs: []*ethtypes.Log{}, GasUsed: 21000, Status: 1}}
+	data, err := json.Marshal([]evmtypes.SimBlockResult{{
+		Header:       header,
+		Transactions: []*ethtypes.Transaction{tx},
+		Senders:      []common.Address{sender},
+		Calls:        calls,
+	}})
+	s.Require().NoError(err)
+
+	req := &evmtypes.SimulateV1Request{Opts: optsBz, ChainId: s.backend.EvmChainID.Int64()}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - invalid request",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				height := int64(1)
+				RegisterHeader(client, &height, bz)
+				RegisterSimulateV1Error(QueryClient, req)
+			},
+			false,
+		},
+		{
+			"pass - returned simulated blocks",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				height := int64(1)
+				RegisterHeader(client, &height, bz)
+				RegisterSimulateV1(QueryClient, req, data)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			blocks, err := s.backend.SimulateV1(opts, rpctypes.BlockNumber(1))
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(blocks, 1)
+
+			block := blocks[0]
+			s.Require().Equal(header.Hash(), block["hash"])
+			s.Require().Equal(hexutil.Uint64(21000), block["gasUsed"])
+			s.Require().Equal(calls, block["calls"])
+
+			txs := block["transactions"].([]interface{})
+			s.Require().Len(txs, 1)
+			rpcTx := txs[0].(*rpctypes.RPCTransaction)
+			s.Require().Equal(tx.Hash(), rpcTx.Hash)
+			s.Require().Equal(sender, rpcTx.From)
+			s.Require().Equal(header.Hash(), *rpcTx.BlockHash)
+		})
+	}
+}
+
+func (s *TestSuite) TestCreateAccessList() {
+	_, bz := s.buildEthereumTx()
+	sender := utiltx.GenerateAddress()
+	toAddr := utiltx.GenerateAddress()
+	callee := utiltx.GenerateAddress()
+	args := evmtypes.TransactionArgs{From: &sender, To: &toAddr}
+	argsBz, err := json.Marshal(args)
+	s.Require().NoError(err)
+
+	req := &evmtypes.EthCallRequest{Args: argsBz, ChainId: s.backend.EvmChainID.Int64()}
+	res := &evmtypes.CreateAccessListResponse{
+		AccessList: []evmtypes.AccessTuple{{Address: callee.Hex(), StorageKeys: []string{common.Hash{}.Hex()}}},
+		GasUsed:    23100,
+		VmError:    "execution reverted",
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - invalid request",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				height := int64(1)
+				RegisterHeader(client, &height, bz)
+				RegisterCreateAccessListError(QueryClient, req)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - returned access list and vm error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				height := int64(1)
+				RegisterHeader(client, &height, bz)
+				RegisterCreateAccessList(QueryClient, req, res)
+			},
+			&rpctypes.AccessListResult{
+				Accesslist: &ethtypes.AccessList{{Address: callee, StorageKeys: []common.Hash{{}}}},
+				Error:      "execution reverted",
+				GasUsed:    hexutil.Uint64(23100),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := s.backend.CreateAccessList(args, rpctypes.BlockNumber(1), nil)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expResult, result)
+		})
+	}
+}
+
 func (s *TestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 	height := int64(1)
@@ -502,6 +702,8 @@ func (s *TestSuite) TestGasPrice() {
 				RegisterHeader(client, &height, nil)
 				_, err := RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				RegisterBaseFee(QueryClient, math.NewInt(1))
 			},
 			defaultGasPrice,
@@ -519,6 +721,8 @@ func (s *TestSuite) TestGasPrice() {
 				RegisterHeader(client, &height, nil)
 				_, err := RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				RegisterBaseFee(QueryClient, math.NewInt(1))
 			},
 			defaultGasPrice,
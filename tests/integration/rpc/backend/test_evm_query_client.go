@@ -45,6 +45,19 @@ func MatchByProto(exp proto.Message) any {
 	})
 }
 
+// MatchByHeight matches a context carrying the given block height header,
+// regardless of any cancellation or deadline wrapping it.
+func MatchByHeight(height int64) any {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if !ok {
+			return false
+		}
+		heights := md.Get(grpctypes.GRPCBlockHeightHeader)
+		return len(heights) == 1 && heights[0] == strconv.FormatInt(height, 10)
+	})
+}
+
 // QueryClient defines a mocked object that implements the Cosmos EVM GRPC
 // QueryClient interface. It allows for performing QueryClient queries without having
 // to run a Cosmos EVM GRPC server.
@@ -84,6 +97,39 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest, data []byte) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), MatchByProto(request)).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), MatchByProto(request)).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// Preimage
+func RegisterPreimage(queryClient *mocks.EVMQueryClient, hash common.Hash, preimage []byte) {
+	queryClient.On("Preimage", rpc.ContextWithHeight(1), &evmtypes.QueryPreimageRequest{Hash: hash.Hex()}).
+		Return(&evmtypes.QueryPreimageResponse{Preimage: preimage}, nil)
+}
+
+func RegisterPreimageError(queryClient *mocks.EVMQueryClient, hash common.Hash, code codes.Code) {
+	queryClient.On("Preimage", rpc.ContextWithHeight(1), &evmtypes.QueryPreimageRequest{Hash: hash.Hex()}).
+		Return(nil, status.Error(code, code.String()))
+}
+
+func RegisterStorageRangeAt(queryClient *mocks.EVMQueryClient, address common.Address, predecessors []*evmtypes.MsgEthereumTx, storage []evmtypes.StorageEntry, nextKey string) {
+	queryClient.On("StorageRangeAt", rpc.ContextWithHeight(1),
+		MatchByProto(&evmtypes.QueryStorageRangeAtRequest{Address: address.Hex(), KeyStart: common.Hash{}.Hex(), MaxResult: 2, Predecessors: predecessors, BlockNumber: 1, ChainId: int64(constants.ExampleChainID.EVMChainID), BlockMaxGas: -1})). //nolint:gosec // G115
+		Return(&evmtypes.QueryStorageRangeAtResponse{Storage: storage, NextKey: nextKey}, nil)
+}
+
+func RegisterAccountRange(queryClient *mocks.EVMQueryClient, height int64, accounts []evmtypes.AccountRangeEntry, next string) {
+	queryClient.On("AccountRange", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRangeRequest{MaxResults: 1}).
+		Return(&evmtypes.QueryAccountRangeResponse{Accounts: accounts, Next: next}, nil)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -158,6 +204,12 @@ func TestRegisterParamsError(t *testing.T) {
 	require.Error(t, err)
 }
 
+// Config
+func RegisterConfig(queryClient *mocks.EVMQueryClient) {
+	queryClient.On("Config", mock.Anything, &evmtypes.QueryConfigRequest{}).
+		Return(&evmtypes.QueryConfigResponse{Config: evmtypes.GetChainConfig()}, nil).Maybe()
+}
+
 // ETH Call
 func RegisterEthCall(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
 	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
@@ -171,6 +223,28 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// SimulateV1
+func RegisterSimulateV1(queryClient *mocks.EVMQueryClient, request *evmtypes.SimulateV1Request, data []byte) {
+	queryClient.On("SimulateV1", MatchByHeight(1), request).
+		Return(&evmtypes.SimulateV1Response{Data: data}, nil)
+}
+
+func RegisterSimulateV1Error(queryClient *mocks.EVMQueryClient, request *evmtypes.SimulateV1Request) {
+	queryClient.On("SimulateV1", MatchByHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// CreateAccessList
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, res *evmtypes.CreateAccessListResponse) {
+	queryClient.On("CreateAccessList", MatchByHeight(1), request).
+		Return(res, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("CreateAccessList", MatchByHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -264,6 +338,15 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterStorageProof(queryClient *mocks.EVMQueryClient, addr common.Address, keys []string, height int64) {
+	proofs := make([]evmtypes.StorageSlotProof, len(keys))
+	for i, key := range keys {
+		proofs[i] = evmtypes.StorageSlotProof{Key: key, Value: common.HexToHash("0x2").Hex(), Proof: [][]byte{{0x01}, {0x02}}}
+	}
+	queryClient.On("StorageProof", rpc.ContextWithHeight(height), &evmtypes.QueryStorageProofRequest{Address: addr.String(), Keys: keys}).
+		Return(&evmtypes.QueryStorageProofResponse{StorageHash: common.HexToHash("0x1234").Hex(), Proofs: proofs}, nil)
+}
+
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryAccountResponse{
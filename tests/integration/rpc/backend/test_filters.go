@@ -2,15 +2,21 @@ package backend
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	ethrpc "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
 )
 
 func (s *TestSuite) TestGetLogs() {
@@ -122,3 +128,35 @@ func (s *TestSuite) TestBloomStatus() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetIndexedLogs() {
+	testCases := []struct {
+		name       string
+		indexLogs  bool
+		from, to   int64
+		expIndexed bool
+	}{
+		{"pass - log index disabled", false, 1, 1, false},
+		{"pass - range not indexed", true, 1, 2, false},
+		{"pass - range indexed", true, 1, 1, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+
+			idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), s.backend.ClientCtx).WithLogIndex(tc.indexLogs)
+			s.Require().NoError(idxer.IndexBlock(cmttypes.MakeBlock(1, nil, nil, nil), []*abcitypes.ExecTxResult{}))
+			s.backend.Indexer = idxer
+
+			logs, indexed, err := s.backend.GetIndexedLogs(tc.from, tc.to, nil, nil, 10)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expIndexed, indexed)
+			if tc.expIndexed {
+				s.Require().Empty(logs)
+			} else {
+				s.Require().Nil(logs)
+			}
+		})
+	}
+}
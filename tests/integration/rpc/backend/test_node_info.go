@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/testutil/constants"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -199,9 +200,23 @@ func (s *TestSuite) TestSyncing() {
 				status, _ := client.Status(s.backend.Ctx)
 				status.SyncInfo.CatchingUp = true
 			},
-			map[string]interface{}{
-				"startingBlock": hexutil.Uint64(0),
-				"currentBlock":  hexutil.Uint64(0),
+			&rpctypes.SyncStatus{},
+			true,
+		},
+		{
+			"pass - Node is catching up from an earlier block",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterStatus(client)
+				status, _ := client.Status(s.backend.Ctx)
+				status.SyncInfo.CatchingUp = true
+				status.SyncInfo.EarliestBlockHeight = 1
+				status.SyncInfo.LatestBlockHeight = 10
+			},
+			&rpctypes.SyncStatus{
+				StartingBlock: hexutil.Uint64(1),
+				CurrentBlock:  hexutil.Uint64(10),
+				HighestBlock:  hexutil.Uint64(10),
 			},
 			true,
 		},
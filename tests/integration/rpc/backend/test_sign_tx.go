@@ -86,6 +86,8 @@ func (s *TestSuite) TestSendTransaction() {
 				RegisterHeader(client, &height, nil)
 				_, err = RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
+				_, err = RegisterBlock(client, height, nil)
+				s.Require().NoError(err)
 				RegisterBaseFee(QueryClient, baseFee)
 			},
 			evmtypes.TransactionArgs{
@@ -253,6 +255,8 @@ func broadcastTx(suite *TestSuite, priv *ethsecp256k1.PrivKey, baseFee math.Int,
 	RegisterHeader(client, &height, nil)
 	_, err := RegisterBlockResults(client, height)
 	suite.Require().NoError(err)
+	_, err = RegisterBlock(client, height, nil)
+	suite.Require().NoError(err)
 	RegisterBaseFee(QueryClient, baseFee)
 	ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
 	msg := evmtypes.NewTxFromArgs(&callArgsDefault)
@@ -1,11 +1,17 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	mock "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -16,6 +22,7 @@ import (
 	"github.com/cosmos/evm/indexer"
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
@@ -299,3 +306,309 @@ func (s *TestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (s *TestSuite) TestTraceCall() {
+	sender := utiltx.GenerateAddress()
+	toAddr := utiltx.GenerateAddress()
+	args := evmtypes.TransactionArgs{From: &sender, To: &toAddr}
+	argsBz, err := json.Marshal(args)
+	s.Require().NoError(err)
+
+	code := hexutil.Bytes{0x00}
+	config := &rpctypes.TraceCallConfig{
+		TraceConfig:    rpctypes.TraceConfig{TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"}},
+		StateOverrides: &rpctypes.StateOverride{toAddr: {Code: &code}},
+	}
+	overridesBz, err := json.Marshal(config.StateOverrides)
+	s.Require().NoError(err)
+
+	req := &evmtypes.QueryTraceCallRequest{
+		Args:        argsBz,
+		GasCap:      s.backend.RPCGasCap(),
+		TraceConfig: &evmtypes.TraceConfig{Tracer: "callTracer"},
+		BlockNumber: 1,
+		ChainId:     s.backend.EvmChainID.Int64(),
+		BlockMaxGas: -1,
+		Overrides:   overridesBz,
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - trace call query error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCallError(queryClient, req)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - returned trace with state overrides",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCall(queryClient, req, []byte(`{"type":"CALL"}`))
+			},
+			map[string]interface{}{"type": "CALL"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := s.backend.TraceCall(args, rpctypes.BlockNumber(1), config)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, result)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestPreimage() {
+	preimage := []byte("preimage")
+	hash := common.HexToHash("0x01")
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    hexutil.Bytes
+		expErr       string
+	}{
+		{
+			"fail - preimage recording disabled",
+			func() {
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterPreimageError(queryClient, hash, codes.Unavailable)
+			},
+			nil,
+			"Unavailable",
+		},
+		{
+			"fail - unknown preimage",
+			func() {
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterPreimageError(queryClient, hash, codes.NotFound)
+			},
+			nil,
+			"unknown preimage",
+		},
+		{
+			"pass - recorded preimage",
+			func() {
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterPreimage(queryClient, hash, preimage)
+			},
+			preimage,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := s.backend.Preimage(hash)
+			if tc.expErr == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, result)
+			} else {
+				s.Require().ErrorContains(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestStorageRangeAt() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+
+	priv, _ := ethsecp256k1.GenerateKey()
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	msgEthereumTx.From = from.Bytes()
+	_ = msgEthereumTx.Sign(ethtypes.LatestSigner(s.backend.ChainConfig()), s.signer)
+
+	tx, _ := msgEthereumTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+	txBz, _ := s.backend.ClientCtx.TxConfig.TxEncoder()(tx)
+
+	address := utiltx.GenerateAddress()
+	key, value := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(1000))
+	nextKey := ethcrypto.Keccak256Hash(common.BigToHash(big.NewInt(2)).Bytes())
+	blockNum := rpctypes.BlockNumber(1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		txIndex      int
+		expResult    rpctypes.StorageRangeResult
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			0,
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"fail - transaction index out of range",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockMultipleTxs(client, 1, []types.Tx{txBz, txBz})
+				s.Require().NoError(err)
+			},
+			3,
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"pass - storage at the beginning of the transaction",
+			func() {
+				var (
+					queryClient = s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+					client      = s.backend.ClientCtx.Client.(*mocks.Client)
+				)
+				_, err := RegisterBlockMultipleTxs(client, 1, []types.Tx{txBz, txBz})
+				s.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterStorageRangeAt(
+					queryClient,
+					address,
+					[]*evmtypes.MsgEthereumTx{msgEthereumTx},
+					[]evmtypes.StorageEntry{{Key: key.Hex(), Value: value.Hex()}},
+					nextKey.Hex(),
+				)
+			},
+			1,
+			rpctypes.StorageRangeResult{
+				Storage: rpctypes.StorageMap{
+					ethcrypto.Keccak256Hash(key.Bytes()): {Key: &key, Value: value},
+				},
+				NextKey: &nextKey,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := s.backend.StorageRangeAt(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}, tc.txIndex, address, nil, 2)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, result)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestAccountRange() {
+	address := utiltx.GenerateAddress()
+	next := utiltx.GenerateAddress()
+	code := []byte("code")
+	codeHash := ethcrypto.Keccak256Hash(code)
+	storageRoot := common.HexToHash("0x02")
+	key, value := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(1000))
+	blockNum := rpctypes.BlockNumber(1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    state.Dump
+		expPass      bool
+	}{
+		{
+			"fail - header not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				height := blockNum.Int64()
+				RegisterHeaderError(client, &height)
+			},
+			state.Dump{},
+			false,
+		},
+		{
+			"pass - contract accounts",
+			func() {
+				var (
+					queryClient = s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+					client      = s.backend.ClientCtx.Client.(*mocks.Client)
+					height      = blockNum.Int64()
+				)
+				RegisterHeader(client, &height, nil)
+				RegisterAccountRange(queryClient, height, []evmtypes.AccountRangeEntry{{
+					Address:     address.Hex(),
+					Balance:     "1000",
+					Nonce:       1,
+					CodeHash:    codeHash.Hex(),
+					Code:        code,
+					StorageRoot: storageRoot.Hex(),
+					Storage:     []evmtypes.StorageEntry{{Key: key.Hex(), Value: value.Hex()}},
+				}}, next.Hex())
+			},
+			state.Dump{
+				Root: common.Hash{}.Hex(),
+				Accounts: map[string]state.DumpAccount{
+					address.Hex(): {
+						Balance:  "1000",
+						Nonce:    1,
+						Root:     storageRoot.Bytes(),
+						CodeHash: codeHash.Bytes(),
+						Code:     code,
+						Storage:  map[common.Hash]string{key: "03e8"},
+					},
+				},
+				Next: next.Bytes(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := s.backend.AccountRange(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}, nil, 1, false, false)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, result)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
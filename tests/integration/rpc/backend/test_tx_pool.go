@@ -0,0 +1,168 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// registerTxPoolTxs registers the mempool content with transactions sent by
+// the suite account with the given nonces.
+func (s *TestSuite) registerTxPoolTxs(nonces ...uint64) {
+	mockClient := s.backend.ClientCtx.Client.(*mocks.Client)
+
+	txs := make([]types.Tx, 0, len(nonces))
+	for _, nonce := range nonces {
+		to := common.Address{}
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  s.backend.EvmChainID,
+			Nonce:    nonce,
+			To:       &to,
+			Amount:   big.NewInt(1),
+			GasLimit: 21000,
+			GasPrice: big.NewInt(1),
+		})
+		msg.From = s.acc.Bytes()
+
+		txBuilder := s.backend.ClientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(msg))
+		bz, err := s.backend.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+		s.Require().NoError(err)
+		txs = append(txs, bz)
+	}
+	RegisterUnconfirmedTxs(mockClient, nil, txs)
+}
+
+// registerTxPoolAccount registers the suite account with the given committed
+// sequence.
+func (s *TestSuite) registerTxPoolAccount(sequence uint64) {
+	mockClient := s.backend.ClientCtx.Client.(*mocks.Client)
+
+	request := &authtypes.QueryAccountRequest{Address: s.acc.String()}
+	requestBz, err := request.Marshal()
+	s.Require().NoError(err)
+	RegisterABCIQueryAccount(
+		mockClient,
+		requestBz,
+		cmtrpcclient.ABCIQueryOptions{Height: int64(1), Prove: false},
+		client.TestAccount{Address: s.acc, Num: 1, Seq: sequence},
+	)
+
+	// the account query response needs to be unpacked
+	encCfg := encoding.MakeConfig(ChainID.EVMChainID)
+	s.backend.ClientCtx = s.backend.ClientCtx.WithInterfaceRegistry(encCfg.InterfaceRegistry)
+}
+
+func (s *TestSuite) TestTxPoolContent() {
+	testCases := []struct {
+		name       string
+		sequence   uint64
+		nonces     []uint64
+		expPending []string
+		expQueued  []string
+	}{
+		{
+			"pass - empty mempool",
+			0,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"pass - contiguous nonces are pending",
+			1,
+			[]uint64{1, 2},
+			[]string{"1", "2"},
+			nil,
+		},
+		{
+			"pass - nonces after a gap are queued",
+			1,
+			[]uint64{1, 3, 4},
+			[]string{"1"},
+			[]string{"3", "4"},
+		},
+		{
+			"pass - only queued when the next nonce is missing",
+			1,
+			[]uint64{2},
+			nil,
+			[]string{"2"},
+		},
+		{
+			"pass - already used nonces are discarded",
+			2,
+			[]uint64{1, 2},
+			[]string{"2"},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest()
+			s.registerTxPoolTxs(tc.nonces...)
+			if len(tc.nonces) > 0 {
+				s.registerTxPoolAccount(tc.sequence)
+			}
+			sender := common.BytesToAddress(s.acc.Bytes()).Hex()
+
+			content, err := s.backend.Content()
+			s.Require().NoError(err)
+			s.requireTxPoolNonces(tc.expPending, content["pending"][sender])
+			s.requireTxPoolNonces(tc.expQueued, content["queued"][sender])
+
+			contentFrom, err := s.backend.ContentFrom(common.BytesToAddress(s.acc.Bytes()))
+			s.Require().NoError(err)
+			s.Require().Equal(content, contentFrom)
+
+			inspect, err := s.backend.Inspect()
+			s.Require().NoError(err)
+			s.Require().Len(inspect["pending"][sender], len(tc.expPending))
+			s.Require().Len(inspect["queued"][sender], len(tc.expQueued))
+			for _, summary := range inspect["pending"][sender] {
+				s.Require().Equal(fmt.Sprintf("%s: 1 wei + 21000 gas × 1 wei", common.Address{}.Hex()), summary)
+			}
+
+			status, err := s.backend.Status()
+			s.Require().NoError(err)
+			s.Require().Equal(hexutil.Uint(len(tc.expPending)), status["pending"])
+			s.Require().Equal(hexutil.Uint(len(tc.expQueued)), status["queued"])
+		})
+	}
+}
+
+func (s *TestSuite) TestTxPoolContentFromOtherAddress() {
+	s.SetupTest()
+	s.registerTxPoolTxs(1)
+
+	content, err := s.backend.ContentFrom(common.BytesToAddress(sdk.AccAddress("other").Bytes()))
+	s.Require().NoError(err)
+	s.Require().Empty(content["pending"])
+	s.Require().Empty(content["queued"])
+}
+
+// requireTxPoolNonces checks that the txpool content of an account holds
+// exactly the transactions with the expected nonces.
+func (s *TestSuite) requireTxPoolNonces(expNonces []string, txs map[string]*rpctypes.RPCTransaction) {
+	s.Require().Len(txs, len(expNonces))
+	for _, nonce := range expNonces {
+		s.Require().Contains(txs, nonce)
+		s.Require().Equal(nonce, fmt.Sprintf("%d", uint64(txs[nonce].Nonce)))
+		s.Require().Nil(txs[nonce].BlockHash)
+	}
+}
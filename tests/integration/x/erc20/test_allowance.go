@@ -522,3 +522,50 @@ func (s *KeeperTestSuite) TestGetAllowances() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestPermitNonce() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	erc20Keeper := s.network.App.GetErc20Keeper()
+
+	erc20Addr := utiltx.GenerateAddress()
+	owner := utiltx.GenerateAddress()
+
+	s.Require().Equal(uint64(0), erc20Keeper.GetPermitNonce(ctx, erc20Addr, owner))
+	s.Require().Empty(erc20Keeper.GetPermitNonces(ctx))
+
+	erc20Keeper.SetPermitNonce(ctx, erc20Addr, owner, 2)
+	s.Require().Equal(uint64(2), erc20Keeper.GetPermitNonce(ctx, erc20Addr, owner))
+	s.Require().Equal(uint64(0), erc20Keeper.GetPermitNonce(ctx, utiltx.GenerateAddress(), owner))
+	s.Require().Equal(
+		[]types.PermitNonce{types.NewPermitNonce(erc20Addr, owner, 2)},
+		erc20Keeper.GetPermitNonces(ctx),
+	)
+
+	// the nonces are kept when the token pair is deleted
+	pair := types.NewTokenPair(erc20Addr, "coin", types.OWNER_MODULE)
+	s.Require().NoError(erc20Keeper.SetToken(ctx, pair))
+	erc20Keeper.DeleteTokenPair(ctx, pair)
+	s.Require().Equal(uint64(2), erc20Keeper.GetPermitNonce(ctx, erc20Addr, owner))
+}
+
+func (s *KeeperTestSuite) TestUsedAuthorization() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	erc20Keeper := s.network.App.GetErc20Keeper()
+
+	erc20Addr := utiltx.GenerateAddress()
+	authorizer := utiltx.GenerateAddress()
+	nonce := common.HexToHash("0x01")
+
+	s.Require().False(erc20Keeper.IsAuthorizationUsed(ctx, erc20Addr, authorizer, nonce))
+	s.Require().Empty(erc20Keeper.GetUsedAuthorizations(ctx))
+
+	erc20Keeper.SetAuthorizationUsed(ctx, erc20Addr, authorizer, nonce)
+	s.Require().True(erc20Keeper.IsAuthorizationUsed(ctx, erc20Addr, authorizer, nonce))
+	s.Require().False(erc20Keeper.IsAuthorizationUsed(ctx, erc20Addr, authorizer, common.HexToHash("0x02")))
+	s.Require().Equal(
+		[]types.UsedAuthorization{types.NewUsedAuthorization(erc20Addr, authorizer, nonce)},
+		erc20Keeper.GetUsedAuthorizations(ctx),
+	)
+}
@@ -1,6 +1,7 @@
 package erc20
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/evm/testutil/integration/evm/network"
@@ -108,6 +109,26 @@ func (s *GenesisTestSuite) TestERC20InitGenesis() {
 				},
 			),
 		},
+		{
+			name: "custom genesis with permit nonces and used authorizations",
+			genesisState: types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  osmoERC20ContractAddr,
+						Denom:         osmoDenom.IBCDenom(),
+						Enabled:       true,
+						ContractOwner: types.OWNER_MODULE,
+					},
+				},
+				PermitNonces: []types.PermitNonce{
+					types.NewPermitNonce(common.HexToAddress(osmoERC20ContractAddr), utiltx.GenerateAddress(), 3),
+				},
+				UsedAuthorizations: []types.UsedAuthorization{
+					types.NewUsedAuthorization(common.HexToAddress(osmoERC20ContractAddr), utiltx.GenerateAddress(), common.HexToHash("0x01")),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -136,6 +157,18 @@ func (s *GenesisTestSuite) TestERC20InitGenesis() {
 		} else {
 			s.Require().Len(tc.genesisState.Allowances, 0, tc.name)
 		}
+
+		permitNonces := nw.App.GetErc20Keeper().GetPermitNonces(nw.GetContext())
+		s.Require().Equal(len(tc.genesisState.PermitNonces), len(permitNonces), tc.name)
+		if len(permitNonces) > 0 {
+			s.Require().Equal(tc.genesisState.PermitNonces, permitNonces, tc.name)
+		}
+
+		usedAuthorizations := nw.App.GetErc20Keeper().GetUsedAuthorizations(nw.GetContext())
+		s.Require().Equal(len(tc.genesisState.UsedAuthorizations), len(usedAuthorizations), tc.name)
+		if len(usedAuthorizations) > 0 {
+			s.Require().Equal(tc.genesisState.UsedAuthorizations, usedAuthorizations, tc.name)
+		}
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/evm/testutil/keyring"
 	"github.com/cosmos/evm/x/ibc/callbacks/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	cbtypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -148,6 +149,26 @@ func (s *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			},
 			types.ErrInvalidCalldata,
 		},
+		{
+			"packet is interchain accounts but packet data is not valid",
+			func() {
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+				packet.Data = []byte("not an interchain accounts packet")
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"packet data is interchain accounts but custom calldata is set",
+			func() {
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+				packet.Data = icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+					Memo: fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%x"}}`, contract.Hex(), []byte("calldata")),
+				}.GetBytes()
+			},
+			types.ErrInvalidCalldata,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -235,6 +256,26 @@ func (s *KeeperTestSuite) TestOnTimeoutPacket() {
 			},
 			types.ErrInvalidCalldata,
 		},
+		{
+			"packet is interchain accounts but packet data is not valid",
+			func() {
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+				packet.Data = []byte("not an interchain accounts packet")
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"packet data is interchain accounts but custom calldata is set",
+			func() {
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+				packet.Data = icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+					Memo: fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%x"}}`, contract.Hex(), []byte("calldata")),
+				}.GetBytes()
+			},
+			types.ErrInvalidCalldata,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,11 +1,47 @@
 package vm
 
 import (
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
+func (s *KeeperTestSuite) TestBeginBlockPrevRandao() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.Create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	k := unitNetwork.App.GetEVMKeeper()
+
+	// use a height that has no value recorded by the network yet
+	headerHash := tmhash.Sum([]byte("header"))
+	ctx := unitNetwork.GetContext()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithHeaderHash(headerHash)
+	err := k.BeginBlock(ctx)
+	s.Require().NoError(err)
+
+	prevRandao := evmtypes.PrevRandao(headerHash)
+	s.Require().NotEqual(evmtypes.PrevRandao(nil), prevRandao)
+	// the recorded value is served to contexts without header hash (e.g. queries)
+	s.Require().Equal(prevRandao, k.GetPrevRandao(ctx.WithHeaderHash(nil)))
+
+	// the value of the next block is derived from its header hash until recorded
+	nextHeaderHash := tmhash.Sum([]byte("next header"))
+	nextCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithHeaderHash(nextHeaderHash)
+	nextPrevRandao := evmtypes.PrevRandao(nextHeaderHash)
+	s.Require().NotEqual(prevRandao, nextPrevRandao)
+	s.Require().Equal(nextPrevRandao, k.GetPrevRandao(nextCtx))
+
+	err = k.BeginBlock(nextCtx)
+	s.Require().NoError(err)
+	s.Require().Equal(nextPrevRandao, k.GetPrevRandao(nextCtx.WithHeaderHash(nil)))
+	// the value of the previous block is pruned
+	s.Require().NotEqual(prevRandao, k.GetPrevRandao(ctx.WithHeaderHash(nextHeaderHash)))
+}
+
 func (s *KeeperTestSuite) TestEndBlock() {
 	keyring := testkeyring.New(2)
 	unitNetwork := network.NewUnitTestNetwork(
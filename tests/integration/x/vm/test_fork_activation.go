@@ -0,0 +1,62 @@
+package vm
+
+import (
+	"time"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *KeeperTestSuite) TestForkActivation() {
+	s.SetupTest()
+	k := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext()
+	s.Require().Nil(types.GetChainConfig().OsakaTime)
+
+	activationTime := uint64(ctx.BlockTime().Unix()) + 100 //nolint:gosec // G115
+	_, err := k.ScheduleForkActivation(ctx, &types.MsgScheduleForkActivation{
+		Authority:      authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		ForkActivation: types.ForkActivation{Fork: types.ForkOsaka, ActivationTime: activationTime},
+	})
+	s.Require().NoError(err)
+
+	// the scheduled activation is served by the config query, without
+	// modifying the chain config of the application
+	res, err := k.Config(ctx, &types.QueryConfigRequest{})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.Config.OsakaTime)
+	s.Require().Equal(sdkmath.NewIntFromUint64(activationTime), *res.Config.OsakaTime)
+	s.Require().Nil(types.GetChainConfig().OsakaTime)
+
+	s.Require().Equal([]types.ForkActivation{{Fork: types.ForkOsaka, ActivationTime: activationTime}}, k.GetForkActivations(ctx))
+
+	cfg, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err)
+
+	recipient := s.Keyring.GetAddr(1)
+	msg, err := s.Factory.GenerateGethCoreMsg(s.Keyring.GetPrivKey(0), types.EvmTxArgs{To: &recipient})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		blockTime uint64
+		isOsaka   bool
+	}{
+		{"before the activation time", activationTime - 1, false},
+		{"at the activation time", activationTime, true},
+		{"after the activation time", activationTime + 1, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			evmCtx := ctx.WithBlockTime(time.Unix(int64(tc.blockTime), 0)) //nolint:gosec // G115
+			evm := k.NewEVM(evmCtx, *msg, cfg, nil, s.Network.GetStateDB())
+			rules := evm.ChainConfig().Rules(evm.Context.BlockNumber, true, evm.Context.Time)
+			s.Require().Equal(tc.isOsaka, rules.IsOsaka)
+		})
+	}
+}
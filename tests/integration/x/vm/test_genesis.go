@@ -1,10 +1,13 @@
 package vm
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/holiman/uint256"
 
@@ -234,3 +237,53 @@ func (s *GenesisTestSuite) TestExportGenesis() {
 	// Since preinstalls gets exported as normal contracts, it should be empty on export genesis
 	s.Require().Empty(genState.Preinstalls)
 }
+
+// TestExportAlloc verifies the EVM state exported as a geth genesis allocation
+func (s *GenesisTestSuite) TestExportAlloc() {
+	contractAddr, err := s.factory.DeployContract(
+		s.keyring.GetPrivKey(0),
+		types.EvmTxArgs{},
+		testutiltypes.ContractDeploymentData{
+			Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+			ConstructorArgs: []interface{}{"TestToken", "TTK", uint8(18)},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+
+	ctx := s.network.GetContext()
+	evmKeeper := s.network.App.GetEVMKeeper()
+
+	var buf bytes.Buffer
+	s.Require().NoError(evmKeeper.ExportAlloc(ctx, &buf))
+
+	var alloc ethtypes.GenesisAlloc
+	s.Require().NoError(json.Unmarshal(buf.Bytes(), &alloc))
+
+	// the deployed contract is exported with its code and storage
+	contract, ok := alloc[contractAddr]
+	s.Require().True(ok)
+	s.Require().Equal(evmKeeper.GetCode(ctx, evmKeeper.GetCodeHash(ctx, contractAddr)), contract.Code)
+	s.Require().NotEmpty(contract.Storage)
+	for key, value := range contract.Storage {
+		s.Require().Equal(value, evmKeeper.GetState(ctx, contractAddr, key))
+	}
+
+	// the deployer is exported with its balance and nonce
+	deployer := s.keyring.GetAddr(0)
+	account, ok := alloc[deployer]
+	s.Require().True(ok)
+	s.Require().Empty(account.Code)
+	s.Require().Equal(evmKeeper.GetBalance(ctx, deployer).ToBig(), account.Balance)
+	s.Require().Equal(evmKeeper.GetNonce(ctx, deployer), account.Nonce)
+	s.Require().NotZero(account.Nonce)
+
+	// every exported contract matches the EVM genesis export
+	genState := vm.ExportGenesis(ctx, evmKeeper)
+	for _, genAccount := range genState.Accounts {
+		exported, ok := alloc[common.HexToAddress(genAccount.Address)]
+		s.Require().True(ok, "missing contract %s", genAccount.Address)
+		s.Require().Equal(genAccount.Code, common.Bytes2Hex(exported.Code))
+		s.Require().Len(exported.Storage, len(genAccount.Storage))
+	}
+}
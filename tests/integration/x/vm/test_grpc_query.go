@@ -9,10 +9,14 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
@@ -320,6 +324,214 @@ func (s *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStorageProof() {
+	testCases := []struct {
+		msg      string
+		malleate func(addr common.Address)
+		address  func(addr common.Address) string
+		expPass  bool
+	}{
+		{
+			"fail - storage proofs disabled",
+			func(common.Address) {
+				s.Network.App.GetEVMKeeper().WithMPTProofs(false)
+			},
+			func(addr common.Address) string { return addr.String() },
+			false,
+		},
+		{
+			"fail - invalid address",
+			func(common.Address) {},
+			func(common.Address) string { return invalidAddress },
+			false,
+		},
+		{
+			"pass - empty storage",
+			func(common.Address) {},
+			func(addr common.Address) string { return addr.String() },
+			true,
+		},
+		{
+			"pass - with storage",
+			func(addr common.Address) {
+				ctx := s.Network.GetContext()
+				for i := int64(1); i <= 10; i++ {
+					key := common.BigToHash(big.NewInt(i))
+					s.Network.App.GetEVMKeeper().SetState(ctx, addr, key, big.NewInt(i*1000).Bytes())
+				}
+			},
+			func(addr common.Address) string { return addr.String() },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.SetupTest()
+			s.Network.App.GetEVMKeeper().WithMPTProofs(true)
+			defer s.Network.App.GetEVMKeeper().WithMPTProofs(false)
+			addr := s.Keyring.GetAddr(s.Keyring.AddKey())
+			tc.malleate(addr)
+
+			keys := []string{common.BigToHash(big.NewInt(1)).Hex(), common.BigToHash(big.NewInt(42)).Hex()}
+			ctx := s.Network.GetContext()
+			res, err := s.Network.GetEvmClient().StorageProof(ctx, &types.QueryStorageProofRequest{
+				Address: tc.address(addr),
+				Keys:    keys,
+			})
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the storage root must match the one of the state db
+			storageHash := common.HexToHash(res.StorageHash)
+			s.Require().Equal(s.Network.GetStateDB().GetStorageRoot(addr), storageHash)
+			s.Require().Len(res.Proofs, len(keys))
+
+			proofDB := memorydb.New()
+			for i, proof := range res.Proofs {
+				s.Require().Equal(keys[i], proof.Key)
+				if storageHash == ethtypes.EmptyRootHash {
+					// the empty trie has no node to prove
+					s.Require().Empty(proof.Proof)
+					continue
+				}
+				for _, node := range proof.Proof {
+					s.Require().NoError(proofDB.Put(crypto.Keccak256(node), node))
+				}
+
+				value, err := trie.VerifyProof(storageHash, crypto.Keccak256(common.HexToHash(proof.Key).Bytes()), proofDB)
+				s.Require().NoError(err)
+				expValue := s.Network.App.GetEVMKeeper().GetState(s.Network.GetContext(), addr, common.HexToHash(proof.Key))
+				s.Require().Equal(expValue.Hex(), proof.Value)
+				if expValue == (common.Hash{}) {
+					s.Require().Nil(value)
+					continue
+				}
+				expEncoded, err := rlp.EncodeToBytes(common.TrimLeftZeroes(expValue.Bytes()))
+				s.Require().NoError(err)
+				s.Require().Equal(expEncoded, value)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryStorageRangeAt() {
+	s.SetupTest()
+	addr := s.Keyring.GetAddr(s.Keyring.AddKey())
+	ctx := s.Network.GetContext()
+	for i := int64(1); i <= 5; i++ {
+		key := common.BigToHash(big.NewInt(i))
+		s.Network.App.GetEVMKeeper().SetState(ctx, addr, key, big.NewInt(i*1000).Bytes())
+	}
+
+	req := &types.QueryStorageRangeAtRequest{
+		Address:     invalidAddress,
+		MaxResult:   2,
+		BlockNumber: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime(),
+	}
+	_, err := s.Network.GetEvmClient().StorageRangeAt(ctx, req)
+	s.Require().Error(err)
+
+	req.Address = addr.String()
+	req.Predecessors = make([]*types.MsgEthereumTx, 10_001)
+	for i := range req.Predecessors {
+		req.Predecessors[i] = &types.MsgEthereumTx{}
+	}
+	_, err = s.Network.GetEvmClient().StorageRangeAt(ctx, req)
+	s.Require().Error(err)
+	req.Predecessors = nil
+
+	hashedKey := func(entry types.StorageEntry) common.Hash {
+		return crypto.Keccak256Hash(common.HexToHash(entry.Key).Bytes())
+	}
+
+	// the storage is paginated by hashed key from the start key
+	var storage []types.StorageEntry
+	for pages := 1; ; pages++ {
+		res, err := s.Network.GetEvmClient().StorageRangeAt(ctx, req)
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(res.Storage), 2)
+		if req.KeyStart != "" {
+			// the next key is the hashed key of the first slot of the next page
+			s.Require().Equal(req.KeyStart, hashedKey(res.Storage[0]).Hex())
+		}
+		storage = append(storage, res.Storage...)
+		if res.NextKey == "" {
+			s.Require().Equal(3, pages)
+			break
+		}
+		req.KeyStart = res.NextKey
+	}
+
+	s.Require().Len(storage, 5)
+	for i, entry := range storage {
+		value := new(big.Int).Mul(common.HexToHash(entry.Key).Big(), big.NewInt(1000))
+		s.Require().Equal(common.BigToHash(value).Hex(), entry.Value)
+		if i > 0 {
+			s.Require().Negative(hashedKey(storage[i-1]).Cmp(hashedKey(entry)))
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAccountRange() {
+	s.SetupTest()
+	addr := s.Keyring.GetAddr(s.Keyring.AddKey())
+	code := []byte("code")
+	stateDB := s.Network.GetStateDB()
+	stateDB.SetCode(addr, code)
+	stateDB.SetState(addr, common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(1000)))
+	s.Require().NoError(stateDB.Commit())
+
+	ctx := s.Network.GetContext()
+	_, err := s.Network.GetEvmClient().AccountRange(ctx, &types.QueryAccountRangeRequest{Start: invalidAddress})
+	s.Require().Error(err)
+
+	all, err := s.Network.GetEvmClient().AccountRange(ctx, &types.QueryAccountRangeRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(all.Next)
+
+	var found bool
+	for _, account := range all.Accounts {
+		if account.Address != addr.Hex() {
+			continue
+		}
+		found = true
+		s.Require().Equal(code, account.Code)
+		s.Require().Equal(crypto.Keccak256Hash(code).Hex(), account.CodeHash)
+		s.Require().Equal(s.Network.GetStateDB().GetStorageRoot(addr).Hex(), account.StorageRoot)
+		s.Require().Equal([]types.StorageEntry{{
+			Key:   common.BigToHash(big.NewInt(1)).Hex(),
+			Value: common.BigToHash(big.NewInt(1000)).Hex(),
+		}}, account.Storage)
+	}
+	s.Require().True(found, "contract account not found")
+
+	// the accounts are paginated from the start address
+	req := &types.QueryAccountRangeRequest{MaxResults: 1, NoCode: true, NoStorage: true}
+	var accounts []types.AccountRangeEntry
+	for {
+		res, err := s.Network.GetEvmClient().AccountRange(ctx, req)
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(res.Accounts), 1)
+		accounts = append(accounts, res.Accounts...)
+		if res.Next == "" {
+			break
+		}
+		req.Start = res.Next
+	}
+
+	s.Require().Len(accounts, len(all.Accounts))
+	for i, account := range accounts {
+		s.Require().Equal(all.Accounts[i].Address, account.Address)
+		s.Require().Empty(account.Code)
+		s.Require().Empty(account.Storage)
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -1503,6 +1715,148 @@ func (s *KeeperTestSuite) TestTraceBlock() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTraceCall() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	contract := tx.GenerateAddress()
+
+	// PUSH1 0x00 SLOAD PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	sloadCode := hexutil.Bytes{0x60, 0x00, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	// NUMBER PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	numberCode := hexutil.Bytes{0x43, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	slot := common.Hash{}
+	value := common.BigToHash(big.NewInt(42))
+
+	testCases := []struct {
+		name           string
+		traceConfig    *types.TraceConfig
+		overrides      types.StateOverride
+		blockOverrides *types.BlockOverrides
+		expTrace       string
+		expPass        bool
+	}{
+		{
+			"pass - default tracer with state overrides",
+			nil,
+			types.StateOverride{
+				contract: {Code: &sloadCode, StateDiff: map[common.Hash]common.Hash{slot: value}},
+			},
+			nil,
+			"\"returnValue\":\"" + value.Hex() + "\"",
+			true,
+		},
+		{
+			"pass - call tracer with state overrides",
+			&types.TraceConfig{Tracer: "callTracer"},
+			types.StateOverride{
+				contract: {Code: &sloadCode, StateDiff: map[common.Hash]common.Hash{slot: value}},
+			},
+			nil,
+			"\"output\":\"" + value.Hex() + "\"",
+			true,
+		},
+		{
+			"pass - prestate tracer with state overrides",
+			&types.TraceConfig{Tracer: "prestateTracer"},
+			types.StateOverride{
+				contract: {Code: &sloadCode, StateDiff: map[common.Hash]common.Hash{slot: value}},
+			},
+			nil,
+			"\"code\":\"" + sloadCode.String() + "\"",
+			true,
+		},
+		{
+			"pass - flat call tracer",
+			&types.TraceConfig{Tracer: "flatCallTracer", TracerJsonConfig: `{"includePrecompiles":true}`},
+			types.StateOverride{
+				contract: {Code: &sloadCode, StateDiff: map[common.Hash]common.Hash{slot: value}},
+			},
+			nil,
+			"\"output\":\"" + value.Hex() + "\"},\"subtraces\":0,\"traceAddress\":[]",
+			true,
+		},
+		{
+			"pass - call tracer with block overrides",
+			&types.TraceConfig{Tracer: "callTracer"},
+			types.StateOverride{
+				contract: {Code: &numberCode},
+			},
+			&types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1_000_000))},
+			"\"output\":\"" + common.BigToHash(big.NewInt(1_000_000)).Hex() + "\"",
+			true,
+		},
+		{
+			"fail - negative limit",
+			&types.TraceConfig{Limit: -1},
+			nil,
+			nil,
+			"",
+			false,
+		},
+		{
+			"fail - invalid tracer",
+			&types.TraceConfig{Tracer: "invalid_tracer"},
+			nil,
+			nil,
+			"",
+			false,
+		},
+		{
+			"fail - both state and state diff",
+			nil,
+			types.StateOverride{
+				contract: {
+					Code:      &sloadCode,
+					State:     map[common.Hash]common.Hash{slot: value},
+					StateDiff: map[common.Hash]common.Hash{slot: value},
+				},
+			},
+			nil,
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.Network.GetContext()
+			args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+			s.Require().NoError(err)
+
+			req := &types.QueryTraceCallRequest{
+				Args:        args,
+				GasCap:      config.DefaultGasCap,
+				TraceConfig: tc.traceConfig,
+				BlockNumber: ctx.BlockHeight(),
+				BlockTime:   ctx.BlockTime(),
+				BlockMaxGas: ctx.ConsensusParams().Block.MaxGas,
+				ChainId:     s.Network.GetEIP155ChainID().Int64(),
+			}
+			if tc.overrides != nil {
+				req.Overrides, err = json.Marshal(tc.overrides)
+				s.Require().NoError(err)
+			}
+			if tc.blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(tc.blockOverrides)
+				s.Require().NoError(err)
+			}
+
+			res, err := s.Network.GetEvmClient().TraceCall(ctx, req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Contains(string(res.Data), tc.expTrace)
+
+			// overrides must never be persisted
+			s.Require().Empty(s.Network.App.GetEVMKeeper().GetCode(s.Network.GetContext(), crypto.Keccak256Hash(*tc.overrides[contract].Code)))
+			s.Require().Equal(common.Hash{}, s.Network.App.GetEVMKeeper().GetState(s.Network.GetContext(), contract, slot))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestNonceInQuery() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -1779,6 +2133,451 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestEthCallWithOverrides() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	contract := tx.GenerateAddress()
+
+	// returnWord builds a runtime bytecode that pushes a single word with the
+	// given opcodes and returns it.
+	returnWord := func(opcodes ...byte) hexutil.Bytes {
+		code := append([]byte{}, opcodes...)
+		// PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+		return append(code, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3)
+	}
+
+	sloadCode := returnWord(0x60, 0x00, 0x54) // PUSH1 0x00 SLOAD
+	numberCode := returnWord(0x43)            // NUMBER
+	selfBalanceCode := returnWord(0x47)       // SELFBALANCE
+	slot := common.Hash{}
+	value := common.BigToHash(big.NewInt(42))
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+
+	testCases := []struct {
+		name           string
+		overrides      types.StateOverride
+		blockOverrides *types.BlockOverrides
+		expRet         common.Hash
+		expPass        bool
+	}{
+		{
+			"pass - code and state diff override",
+			types.StateOverride{
+				contract: {Code: &sloadCode, StateDiff: map[common.Hash]common.Hash{slot: value}},
+			},
+			nil,
+			value,
+			true,
+		},
+		{
+			"pass - code and full state override",
+			types.StateOverride{
+				contract: {Code: &sloadCode, State: map[common.Hash]common.Hash{slot: value}},
+			},
+			nil,
+			value,
+			true,
+		},
+		{
+			"pass - balance override",
+			types.StateOverride{
+				contract: {Code: &selfBalanceCode, Balance: balance},
+			},
+			nil,
+			common.BigToHash(balance.ToInt()),
+			true,
+		},
+		{
+			"pass - block number override",
+			types.StateOverride{
+				contract: {Code: &numberCode},
+			},
+			&types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1_000_000))},
+			common.BigToHash(big.NewInt(1_000_000)),
+			true,
+		},
+		{
+			"fail - both state and state diff",
+			types.StateOverride{
+				contract: {
+					Code:      &sloadCode,
+					State:     map[common.Hash]common.Hash{slot: value},
+					StateDiff: map[common.Hash]common.Hash{slot: value},
+				},
+			},
+			nil,
+			common.Hash{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+			s.Require().NoError(err)
+			overridesBz, err := json.Marshal(tc.overrides)
+			s.Require().NoError(err)
+
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overridesBz}
+			if tc.blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(tc.blockOverrides)
+				s.Require().NoError(err)
+			}
+
+			res, err := s.Network.GetEvmClient().EthCall(s.Network.GetContext(), req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Empty(res.VmError)
+			s.Require().Equal(tc.expRet, common.BytesToHash(res.Ret))
+
+			// overrides must never be persisted
+			ctx := s.Network.GetContext()
+			s.Require().Empty(s.Network.App.GetEVMKeeper().GetCode(ctx, crypto.Keccak256Hash(*tc.overrides[contract].Code)))
+			s.Require().Equal(common.Hash{}, s.Network.App.GetEVMKeeper().GetState(ctx, contract, slot))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCreateAccessList() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	caller := tx.GenerateAddress()
+	callee := tx.GenerateAddress()
+	slot := common.Hash{}
+
+	// touchCode builds a runtime bytecode that reads the balance of every given
+	// address and static calls the callee before returning.
+	touchCode := func(addrs ...common.Address) hexutil.Bytes {
+		var code []byte
+		for _, addr := range addrs {
+			// PUSH20 addr BALANCE POP
+			code = append(code, 0x73)
+			code = append(code, addr.Bytes()...)
+			code = append(code, 0x31, 0x50)
+		}
+		// PUSH1 0x00 PUSH1 0x00 PUSH1 0x00 PUSH1 0x00 PUSH20 callee GAS STATICCALL POP STOP
+		code = append(code, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x73)
+		code = append(code, callee.Bytes()...)
+		return append(code, 0x5a, 0xfa, 0x50, 0x00)
+	}
+	// PUSH1 0x00 SLOAD POP STOP
+	sloadCode := hexutil.Bytes{0x60, 0x00, 0x54, 0x50, 0x00}
+	// PUSH1 0x00 DUP1 REVERT
+	revertCode := hexutil.Bytes{0x60, 0x00, 0x80, 0xfd}
+
+	precompiles := []common.Address{
+		common.BytesToAddress([]byte{0x01}), // ecrecover
+		common.HexToAddress(types.StakingPrecompileAddress),
+		common.HexToAddress(testconstants.WEVMOSContractMainnet),
+	}
+	callerCode := touchCode(precompiles...)
+
+	testCases := []struct {
+		name          string
+		to            *common.Address
+		overrides     types.StateOverride
+		expAccessList ethtypes.AccessList
+		expVMError    bool
+		expPass       bool
+	}{
+		{
+			"pass - storage slot of callee, precompiles excluded",
+			&caller,
+			types.StateOverride{
+				caller: {Code: &callerCode},
+				callee: {Code: &sloadCode},
+			},
+			ethtypes.AccessList{{Address: callee, StorageKeys: []common.Hash{slot}}},
+			false,
+			true,
+		},
+		{
+			"pass - storage slot of the recipient",
+			&callee,
+			types.StateOverride{
+				callee: {Code: &sloadCode},
+			},
+			ethtypes.AccessList{{Address: callee, StorageKeys: []common.Hash{slot}}},
+			false,
+			true,
+		},
+		{
+			"pass - reverted call returns the vm error",
+			&callee,
+			types.StateOverride{
+				callee: {Code: &revertCode},
+			},
+			ethtypes.AccessList{},
+			true,
+			true,
+		},
+		{
+			"pass - contract creation",
+			nil,
+			nil,
+			ethtypes.AccessList{{Address: callee, StorageKeys: []common.Hash{}}},
+			false,
+			true,
+		},
+		{
+			"fail - invalid state override",
+			&caller,
+			types.StateOverride{
+				callee: {
+					Code:      &sloadCode,
+					State:     map[common.Hash]common.Hash{slot: slot},
+					StateDiff: map[common.Hash]common.Hash{slot: slot},
+				},
+			},
+			nil,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			txArgs := &types.TransactionArgs{From: &sender, To: tc.to}
+			if tc.to == nil {
+				// the init code executes the caller code, touching the callee
+				initCode := touchCode()
+				txArgs.Data = &initCode
+			}
+			args, err := json.Marshal(txArgs)
+			s.Require().NoError(err)
+
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			if tc.overrides != nil {
+				req.Overrides, err = json.Marshal(tc.overrides)
+				s.Require().NoError(err)
+			}
+
+			res, err := s.Network.GetEvmClient().CreateAccessList(s.Network.GetContext(), req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expVMError, res.VmError != "")
+			s.Require().Equal(tc.expAccessList, *types.AccessList(res.AccessList).ToEthAccessList())
+			s.Require().NotZero(res.GasUsed)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := tx.GenerateAddress()
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	ctorArgs, err := erc20Contract.ABI.Pack("", sender, supply)
+	s.Require().NoError(err)
+	deployData := hexutil.Bytes(append(erc20Contract.Bin, ctorArgs...))
+
+	nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender)
+	contract := crypto.CreateAddress(sender, nonce)
+
+	amount := big.NewInt(100)
+	transferData, err := erc20Contract.ABI.Pack("transfer", recipient, amount)
+	s.Require().NoError(err)
+	balanceOfData, err := erc20Contract.ABI.Pack("balanceOf", recipient)
+	s.Require().NoError(err)
+	overTransferData, err := erc20Contract.ABI.Pack("transfer", recipient, new(big.Int).Add(supply, big.NewInt(1)))
+	s.Require().NoError(err)
+
+	value := (*hexutil.Big)(big.NewInt(1000))
+	baseNumber := big.NewInt(s.Network.GetContext().BlockHeight())
+	numberOverride := func(offset int64) *types.BlockOverrides {
+		return &types.BlockOverrides{Number: (*hexutil.Big)(new(big.Int).Add(baseNumber, big.NewInt(offset)))}
+	}
+	tooHighNonce := hexutil.Uint64(nonce + 1)
+
+	testCases := []struct {
+		name    string
+		opts    types.SimOpts
+		expPass bool
+		check   func(results []types.SimBlockResult)
+	}{
+		{
+			"pass - dependent calls across blocks",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{Calls: []types.TransactionArgs{
+						{From: &sender, Data: &deployData},
+						{From: &sender, To: &contract, Input: (*hexutil.Bytes)(&transferData)},
+					}},
+					{Calls: []types.TransactionArgs{
+						{From: &sender, To: &contract, Input: (*hexutil.Bytes)(&balanceOfData)},
+					}},
+				},
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				s.Require().Len(results, 2)
+				s.Require().Equal(new(big.Int).Add(baseNumber, big.NewInt(1)), results[0].Header.Number)
+				s.Require().Equal(results[0].Header.Hash(), results[1].Header.ParentHash)
+				s.Require().Equal(results[0].Header.Time+types.SimulateTimestampIncrement, results[1].Header.Time)
+
+				transfer := results[0].Calls[1]
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), transfer.Status)
+				s.Require().Len(transfer.Logs, 1)
+				s.Require().Equal(contract, transfer.Logs[0].Address)
+				s.Require().Equal(results[0].Header.Hash(), transfer.Logs[0].BlockHash)
+				s.Require().Equal(results[0].Transactions[1].Hash(), transfer.Logs[0].TxHash)
+
+				s.Require().Equal(common.BigToHash(amount).Bytes(), []byte(results[1].Calls[0].ReturnValue))
+				s.Require().Equal(sender, results[1].Senders[0])
+			},
+		},
+		{
+			"pass - reverted call",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{Calls: []types.TransactionArgs{
+						{From: &sender, Data: &deployData},
+						{From: &sender, To: &contract, Input: (*hexutil.Bytes)(&overTransferData)},
+					}},
+				},
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				reverted := results[0].Calls[1]
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), reverted.Status)
+				s.Require().NotNil(reverted.Error)
+				s.Require().Equal(3, reverted.Error.Code)
+				s.Require().Empty(reverted.Logs)
+			},
+		},
+		{
+			"pass - ether transfer logs",
+			types.SimOpts{
+				TraceTransfers: true,
+				BlockStateCalls: []types.SimBlock{
+					{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Value: value}}},
+				},
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				logs := results[0].Calls[0].Logs
+				s.Require().Len(logs, 1)
+				s.Require().Equal(common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"), logs[0].Address)
+				s.Require().Equal(common.BytesToHash(sender.Bytes()), logs[0].Topics[1])
+				s.Require().Equal(common.BytesToHash(recipient.Bytes()), logs[0].Topics[2])
+				s.Require().Equal(common.BigToHash(value.ToInt()).Bytes(), logs[0].Data)
+			},
+		},
+		{
+			"pass - state overrides are applied per block",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{
+						StateOverrides: types.StateOverride{recipient: {Balance: value}},
+						Calls: []types.TransactionArgs{
+							{From: &recipient, To: &sender, Value: value},
+							{From: &recipient, To: &sender, Value: value},
+						},
+					},
+				},
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				s.Require().Empty(results[0].Calls[0].Error)
+				// the first transfer spent the overridden balance
+				s.Require().NotNil(results[0].Calls[1].Error)
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), results[0].Calls[1].Status)
+			},
+		},
+		{
+			"pass - gaps are filled with empty blocks",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{{BlockOverrides: numberOverride(3)}},
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				s.Require().Len(results, 3)
+				for i, result := range results {
+					s.Require().Equal(new(big.Int).Add(baseNumber, big.NewInt(int64(i+1))), result.Header.Number)
+				}
+			},
+		},
+		{
+			"pass - nonce is not validated",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Nonce: &tooHighNonce}}},
+				},
+			},
+			true,
+			nil,
+		},
+		{
+			"fail - invalid nonce in validation mode",
+			types.SimOpts{
+				Validation: true,
+				BlockStateCalls: []types.SimBlock{
+					{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Nonce: &tooHighNonce}}},
+				},
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - block numbers out of order",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{BlockOverrides: numberOverride(2)},
+					{BlockOverrides: numberOverride(1)},
+				},
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - too many blocks",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{{BlockOverrides: numberOverride(types.MaxSimulateBlocks + 1)}},
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			opts, err := json.Marshal(tc.opts)
+			s.Require().NoError(err)
+
+			req := &types.SimulateV1Request{Opts: opts, GasCap: config.DefaultGasCap}
+			res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var results []types.SimBlockResult
+			s.Require().NoError(json.Unmarshal(res.Data, &results))
+			if tc.check != nil {
+				tc.check(results)
+			}
+
+			// the simulation must never be persisted
+			ctx := s.Network.GetContext()
+			s.Require().Equal(nonce, s.Network.App.GetEVMKeeper().GetNonce(ctx, sender))
+			s.Require().Empty(s.Network.App.GetEVMKeeper().GetCode(ctx, s.Network.App.GetEVMKeeper().GetCodeHash(ctx, contract)))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -1976,12 +2775,30 @@ func (s *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(s.Network.GetContext(), nil)
 			},
 		},
+		{
+			"CreateAccessList method",
+			func() (interface{}, error) {
+				return k.CreateAccessList(s.Network.GetContext(), nil)
+			},
+		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(s.Network.GetContext(), nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
 				return k.TraceTx(s.Network.GetContext(), nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(s.Network.GetContext(), nil)
+			},
+		},
 		{
 			"TraceBlock method",
 			func() (interface{}, error) {
@@ -173,3 +173,91 @@ func (s *KeeperTestSuite) TestRegisterPreinstalls() {
 		s.Require().NoError(err)
 	}
 }
+
+func (s *KeeperTestSuite) TestScheduleForkActivation() {
+	s.SetupTest()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	blockTime := func() uint64 {
+		return uint64(s.Network.GetContext().BlockTime().Unix()) //nolint:gosec // G115
+	}
+
+	testCases := []struct {
+		name        string
+		getMsg      func() *types.MsgScheduleForkActivation
+		expectedErr error
+	}{
+		{
+			name: "fail - invalid authority",
+			getMsg: func() *types.MsgScheduleForkActivation {
+				return &types.MsgScheduleForkActivation{Authority: "foobar"}
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "fail - unknown fork",
+			getMsg: func() *types.MsgScheduleForkActivation {
+				return &types.MsgScheduleForkActivation{
+					Authority:      authority,
+					ForkActivation: types.ForkActivation{Fork: "foo", ActivationTime: blockTime() + 1000},
+				}
+			},
+			expectedErr: types.ErrInvalidChainConfig,
+		},
+		{
+			name: "fail - activation time is not in the future",
+			getMsg: func() *types.MsgScheduleForkActivation {
+				return &types.MsgScheduleForkActivation{
+					Authority:      authority,
+					ForkActivation: types.ForkActivation{Fork: types.ForkOsaka, ActivationTime: blockTime()},
+				}
+			},
+			expectedErr: types.ErrInvalidChainConfig,
+		},
+		{
+			name: "fail - fork is already active",
+			getMsg: func() *types.MsgScheduleForkActivation {
+				return &types.MsgScheduleForkActivation{
+					Authority:      authority,
+					ForkActivation: types.ForkActivation{Fork: types.ForkPrague, ActivationTime: blockTime() + 1000},
+				}
+			},
+			expectedErr: types.ErrInvalidChainConfig,
+		},
+		{
+			name: "pass - schedule osaka",
+			getMsg: func() *types.MsgScheduleForkActivation {
+				return &types.MsgScheduleForkActivation{
+					Authority:      authority,
+					ForkActivation: types.ForkActivation{Fork: types.ForkOsaka, ActivationTime: blockTime() + 1000},
+				}
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "fail - verkle cannot be scheduled",
+			getMsg: func() *types.MsgScheduleForkActivation {
+				return &types.MsgScheduleForkActivation{
+					Authority:      authority,
+					ForkActivation: types.ForkActivation{Fork: "verkle", ActivationTime: blockTime() + 2000},
+				}
+			},
+			expectedErr: types.ErrInvalidChainConfig,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run("MsgScheduleForkActivation_"+tc.name, func() {
+			msg := tc.getMsg()
+			_, err := s.Network.App.GetEVMKeeper().ScheduleForkActivation(s.Network.GetContext(), msg)
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				s.Contains(err.Error(), tc.expectedErr.Error())
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+
+		err := s.Network.NextBlock()
+		s.Require().NoError(err)
+	}
+}
@@ -0,0 +1,69 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/contracts"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func (s *KeeperTestSuite) TestPreimageRecording() {
+	evmKeeper := s.Network.App.GetEVMKeeper()
+
+	// preimage recording is disabled by default
+	_, err := s.Network.GetEvmClient().Preimage(s.Network.GetContext(), &types.QueryPreimageRequest{Hash: common.Hash{}.Hex()})
+	s.Require().Equal(codes.Unavailable, status.Code(err))
+
+	evmKeeper.WithPreimageDB(dbm.NewMemDB())
+	defer evmKeeper.WithPreimageDB(nil)
+
+	// the constructor grants the roles of the deployer, stored in mappings
+	contractAddr, err := s.Factory.DeployContract(
+		s.Keyring.GetPrivKey(0),
+		types.EvmTxArgs{},
+		testutiltypes.ContractDeploymentData{
+			Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+			ConstructorArgs: []interface{}{"TestToken", "TTK", uint8(18)},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	var buf bytes.Buffer
+	s.Require().NoError(evmKeeper.DumpStorage(s.Network.GetContext(), contractAddr, &buf))
+
+	var dump keeper.StorageDump
+	s.Require().NoError(json.Unmarshal(buf.Bytes(), &dump))
+	s.Require().Equal(contractAddr, dump.Address)
+	s.Require().NotEmpty(dump.Storage)
+
+	// the role membership of the deployer is resolved to its mapping key
+	deployer := common.BytesToHash(s.Keyring.GetAddr(0).Bytes())
+	var resolved *keeper.StorageSlotDump
+	for i, slot := range dump.Storage {
+		if slot.MappingKey != nil && *slot.MappingKey == deployer {
+			resolved = &dump.Storage[i]
+			break
+		}
+	}
+	s.Require().NotNil(resolved, "no storage slot resolved to the deployer")
+	s.Require().Equal(resolved.Slot, crypto.Keccak256Hash(resolved.Preimage))
+	s.Require().Equal(append(resolved.MappingKey.Bytes(), resolved.MappingSlot.Bytes()...), []byte(resolved.Preimage))
+
+	// the recorded preimages are served by the query
+	res, err := s.Network.GetEvmClient().Preimage(s.Network.GetContext(), &types.QueryPreimageRequest{Hash: resolved.Slot.Hex()})
+	s.Require().NoError(err)
+	s.Require().Equal([]byte(resolved.Preimage), res.Preimage)
+
+	_, err = s.Network.GetEvmClient().Preimage(s.Network.GetContext(), &types.QueryPreimageRequest{Hash: common.Hash{}.Hex()})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
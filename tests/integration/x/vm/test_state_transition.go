@@ -79,6 +79,26 @@ func (s *KeeperTestSuite) TestContextSetConsensusParams() {
 	s.Require().Equal(vm.Context.GasLimit, uint64(consParams.Block.MaxGas))
 }
 
+func (s *KeeperTestSuite) TestNewEVMPrevRandao() {
+	k := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext()
+	cfg, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err)
+
+	recipient := s.Keyring.GetAddr(1)
+	msg, err := s.Factory.GenerateGethCoreMsg(s.Keyring.GetPrivKey(0), types.EvmTxArgs{To: &recipient})
+	s.Require().NoError(err)
+
+	vm := k.NewEVM(ctx, *msg, cfg, nil, s.Network.GetStateDB())
+	s.Require().NotNil(vm.Context.Random)
+	s.Require().Equal(k.GetPrevRandao(ctx), *vm.Context.Random)
+
+	// replaying the block on the state of its parent yields the same value
+	headerHash := tmhash.Sum([]byte("header"))
+	vm = k.NewEVM(ctx.WithBlockHeight(ctx.BlockHeight()+1).WithHeaderHash(headerHash), *msg, cfg, nil, s.Network.GetStateDB())
+	s.Require().Equal(types.PrevRandao(headerHash), *vm.Context.Random)
+}
+
 func (s *KeeperTestSuite) TestGetHashFn() {
 	s.SetupTest()
 	header := s.Network.GetContext().BlockHeader()
@@ -525,6 +545,61 @@ func (s *KeeperTestSuite) TestRefundGas() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRefundGasToFeePayer() {
+	baseDenom := types.GetEVMCoinDenom()
+
+	// the fee collector account is pre-funded for the refund to work
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{
+		{
+			Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(baseDenom, sdkmath.NewInt(6e18))),
+		},
+	}
+	customGenesis := network.CustomGenesisState{}
+	customGenesis[banktypes.ModuleName] = bankGenesis
+
+	Keyring := testKeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.Create,
+		network.WithPreFundedAccounts(Keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(customGenesis),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+
+	sender := Keyring.GetKey(0)
+	feePayer := Keyring.GetKey(1)
+
+	coreMsg, err := txFactory.GenerateGethCoreMsg(
+		sender.Priv,
+		types.EvmTxArgs{
+			To:     &feePayer.Addr,
+			Amount: big.NewInt(100),
+		},
+	)
+	s.Require().NoError(err)
+
+	ctx := unitNetwork.GetContext()
+	evmKeeper := unitNetwork.App.GetEVMKeeper()
+	evmKeeper.SetTxFeePayerTransient(ctx, feePayer.AccAddr)
+
+	prevSenderBalance := evmKeeper.GetBalance(ctx, sender.Addr)
+	prevFeePayerBalance := evmKeeper.GetBalance(ctx, feePayer.Addr)
+
+	refund := params.TxGas / params.RefundQuotient
+	err = evmKeeper.RefundGas(ctx, *coreMsg, refund, unitNetwork.GetBaseDenom())
+	s.Require().NoError(err)
+
+	// the leftover gas is refunded to the fee payer instead of the sender
+	expRefund := new(big.Int).Mul(new(big.Int).SetUint64(refund), coreMsg.GasPrice)
+	s.Require().Equal(prevSenderBalance, evmKeeper.GetBalance(ctx, sender.Addr))
+	s.Require().Equal(
+		new(big.Int).Add(prevFeePayerBalance.ToBig(), expRefund),
+		evmKeeper.GetBalance(ctx, feePayer.Addr).ToBig(),
+	)
+}
+
 func (s *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	s.SetupTest()
 	testCases := []struct {
@@ -24,5 +24,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*tx.TxExtensionOptionI)(nil),
 		&ExtensionOptionsWeb3Tx{},
 		&ExtensionOptionDynamicFeeTx{},
+		&ExtensionOptionContractSignatureTx{},
+		&ExtensionOptionFeePayerTx{},
 	)
 }
@@ -0,0 +1,11 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// HasContractSignatureExtensionOption returns true if the tx implements the `ExtensionOptionContractSignatureTx` extension option.
+func HasContractSignatureExtensionOption(anyType *codectypes.Any) bool {
+	_, ok := anyType.GetCachedValue().(*ExtensionOptionContractSignatureTx)
+	return ok
+}
@@ -0,0 +1,269 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/types/v1/contract_signature.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExtensionOptionContractSignatureTx is an extension option for cosmos txs
+// signed by smart contract accounts. The signatures of the tx are verified by
+// calling the EIP-1271 `isValidSignature(bytes32,bytes)` method of the signer
+// contracts with the keccak256 hash of the tx sign bytes.
+type ExtensionOptionContractSignatureTx struct {
+}
+
+func (m *ExtensionOptionContractSignatureTx) Reset()         { *m = ExtensionOptionContractSignatureTx{} }
+func (m *ExtensionOptionContractSignatureTx) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionContractSignatureTx) ProtoMessage()    {}
+func (*ExtensionOptionContractSignatureTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fca28a059f3e6ec4, []int{0}
+}
+func (m *ExtensionOptionContractSignatureTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionContractSignatureTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionContractSignatureTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionContractSignatureTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionContractSignatureTx.Merge(m, src)
+}
+func (m *ExtensionOptionContractSignatureTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionContractSignatureTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionContractSignatureTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionContractSignatureTx proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ExtensionOptionContractSignatureTx)(nil), "cosmos.evm.types.v1.ExtensionOptionContractSignatureTx")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/evm/types/v1/contract_signature.proto", fileDescriptor_fca28a059f3e6ec4)
+}
+
+var fileDescriptor_fca28a059f3e6ec4 = []byte{
+	// 159 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x49, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2f, 0x33, 0xd4,
+	0x4f, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x89, 0x2f, 0xce, 0x4c, 0xcf, 0x4b, 0x2c, 0x29,
+	0x2d, 0x4a, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xa8, 0xd6, 0x4b, 0x2d, 0xcb,
+	0xd5, 0x03, 0xab, 0xd6, 0x2b, 0x33, 0x54, 0x52, 0xe1, 0x52, 0x72, 0xad, 0x28, 0x49, 0xcd, 0x2b,
+	0xce, 0xcc, 0xcf, 0xf3, 0x2f, 0x28, 0xc9, 0xcc, 0xcf, 0x73, 0x86, 0xea, 0x0f, 0x86, 0x69, 0x0f,
+	0xa9, 0x70, 0x32, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
+	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xe9, 0xf4,
+	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x74, 0xd7, 0x24, 0xb1, 0x81, 0x2d,
+	0x36, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x81, 0xfc, 0x21, 0xd6, 0xa8, 0x00, 0x00, 0x00,
+}
+
+func (m *ExtensionOptionContractSignatureTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionContractSignatureTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionContractSignatureTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintContractSignature(dAtA []byte, offset int, v uint64) int {
+	offset -= sovContractSignature(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExtensionOptionContractSignatureTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovContractSignature(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozContractSignature(x uint64) (n int) {
+	return sovContractSignature(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExtensionOptionContractSignatureTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractSignature
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionContractSignatureTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionContractSignatureTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractSignature(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractSignature
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipContractSignature(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowContractSignature
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractSignature
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractSignature
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthContractSignature
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupContractSignature
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthContractSignature
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthContractSignature        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowContractSignature          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupContractSignature = fmt.Errorf("proto: unexpected end of group")
+)
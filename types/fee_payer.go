@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasFeePayerExtensionOption returns true if the tx implements the `ExtensionOptionFeePayerTx` extension option.
+func HasFeePayerExtensionOption(anyType *codectypes.Any) bool {
+	_, ok := anyType.GetCachedValue().(*ExtensionOptionFeePayerTx)
+	return ok
+}
+
+// FeePayerSignHash returns the hash signed by the sender of an Ethereum tx to
+// authorize the fee payer of the `ExtensionOptionFeePayerTx` extension option.
+func FeePayerSignHash(txHash common.Hash, feePayer sdk.AccAddress) common.Hash {
+	return crypto.Keccak256Hash(txHash.Bytes(), feePayer.Bytes())
+}
@@ -0,0 +1,380 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/types/v1/fee_payer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExtensionOptionFeePayerTx is an extension option for Ethereum txs that
+// specifies the account paying the fees of the transaction. The fees are
+// deducted from the fee payer balance, using the fee allowance it granted to
+// the sender of the transaction through the x/feegrant module.
+type ExtensionOptionFeePayerTx struct {
+	// fee_payer is the bech32 address of the account granting the fee
+	// allowance.
+	FeePayer string `protobuf:"bytes,1,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// fee_payer_sig is the signature of the sender of the Ethereum tx over the
+	// keccak256 hash of the Ethereum tx hash and the fee payer address, which
+	// authorizes the fee payer to pay the fees of the transaction.
+	FeePayerSig []byte `protobuf:"bytes,2,opt,name=fee_payer_sig,json=feePayerSig,proto3" json:"fee_payer_sig,omitempty"`
+}
+
+func (m *ExtensionOptionFeePayerTx) Reset()         { *m = ExtensionOptionFeePayerTx{} }
+func (m *ExtensionOptionFeePayerTx) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionFeePayerTx) ProtoMessage()    {}
+func (*ExtensionOptionFeePayerTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3945eb0f244c0ac, []int{0}
+}
+func (m *ExtensionOptionFeePayerTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionFeePayerTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionFeePayerTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionFeePayerTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionFeePayerTx.Merge(m, src)
+}
+func (m *ExtensionOptionFeePayerTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionFeePayerTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionFeePayerTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionFeePayerTx proto.InternalMessageInfo
+
+func (m *ExtensionOptionFeePayerTx) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *ExtensionOptionFeePayerTx) GetFeePayerSig() []byte {
+	if m != nil {
+		return m.FeePayerSig
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ExtensionOptionFeePayerTx)(nil), "cosmos.evm.types.v1.ExtensionOptionFeePayerTx")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/evm/types/v1/fee_payer.proto", fileDescriptor_d3945eb0f244c0ac)
+}
+
+var fileDescriptor_d3945eb0f244c0ac = []byte{
+	// 190 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2f, 0x33, 0xd4,
+	0x4f, 0x4b, 0x4d, 0x8d, 0x2f, 0x48, 0xac, 0x4c, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+	0x12, 0x86, 0x28, 0xd2, 0x4b, 0x2d, 0xcb, 0xd5, 0x03, 0x2b, 0xd2, 0x2b, 0x33, 0x54, 0x8a, 0xe1,
+	0x92, 0x74, 0xad, 0x28, 0x49, 0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xf3, 0x2f, 0x28, 0xc9, 0xcc, 0xcf,
+	0x73, 0x4b, 0x4d, 0x0d, 0x00, 0xe9, 0x0a, 0xa9, 0x10, 0x92, 0xe6, 0xe2, 0x84, 0x1b, 0x22, 0xc1,
+	0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x91, 0x06, 0x95, 0x16, 0x52, 0xe2, 0xe2, 0x85, 0x4b, 0xc6,
+	0x17, 0x67, 0xa6, 0x4b, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x04, 0x71, 0xc3, 0x14, 0x04, 0x67, 0xa6,
+	0x3b, 0x99, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
+	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x74, 0x7a, 0x66,
+	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xba, 0xe3, 0x93, 0xd8, 0xc0, 0x0e, 0x36,
+	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x48, 0x24, 0x80, 0xd7, 0x00, 0x00, 0x00,
+}
+
+func (m *ExtensionOptionFeePayerTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionFeePayerTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionFeePayerTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeePayerSig) > 0 {
+		i -= len(m.FeePayerSig)
+		copy(dAtA[i:], m.FeePayerSig)
+		i = encodeVarintFeePayer(dAtA, i, uint64(len(m.FeePayerSig)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintFeePayer(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeePayer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeePayer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExtensionOptionFeePayerTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovFeePayer(uint64(l))
+	}
+	l = len(m.FeePayerSig)
+	if l > 0 {
+		n += 1 + l + sovFeePayer(uint64(l))
+	}
+	return n
+}
+
+func sovFeePayer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeePayer(x uint64) (n int) {
+	return sovFeePayer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExtensionOptionFeePayerTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeePayer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionFeePayerTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionFeePayerTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeePayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeePayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeePayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayerSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeePayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFeePayer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeePayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayerSig = append(m.FeePayerSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeePayerSig == nil {
+				m.FeePayerSig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeePayer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeePayer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeePayer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeePayer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeePayer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeePayer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeePayer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeePayer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeePayer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeePayer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeePayer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeePayer = fmt.Errorf("proto: unexpected end of group")
+)
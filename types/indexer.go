@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -18,3 +19,22 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMLogIndexer defines the interface of the optional secondary index of the
+// eth logs by address and topic.
+type EVMLogIndexer interface {
+	// IsLogIndexed returns true if the log index is enabled and all the blocks of
+	// the range are in it.
+	IsLogIndexed(from, to int64) (bool, error)
+	// GetLogs returns the logs of the block range matching the addresses and the
+	// topics, following the eth_getLogs semantics. It fails if more than limit
+	// logs match, unless limit is zero.
+	GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+}
+
+// EVMReceiptsRootIndexer defines the interface of the optional index of the
+// receipts trie root of the blocks.
+type EVMReceiptsRootIndexer interface {
+	// GetReceiptsRoot returns nil if the block is not indexed.
+	GetReceiptsRoot(int64) (*common.Hash, error)
+}
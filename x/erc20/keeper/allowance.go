@@ -177,3 +177,98 @@ func (k Keeper) deleteAllowances(ctx sdk.Context, erc20 common.Address) {
 		store.Delete(key)
 	}
 }
+
+// GetPermitNonce returns the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func (k Keeper) GetPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	bz := store.Get(types.PermitNonceKey(erc20, owner))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetPermitNonce sets the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func (k Keeper) SetPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+	nonce uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	store.Set(types.PermitNonceKey(erc20, owner), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetPermitNonces returns all EIP-2612 permit nonces stored on the erc20 precompiles.
+func (k Keeper) GetPermitNonces(
+	ctx sdk.Context,
+) []types.PermitNonce {
+	permitNonces := []types.PermitNonce{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		erc20 := common.BytesToAddress(key[:common.AddressLength])
+		owner := common.BytesToAddress(key[common.AddressLength:])
+		nonce := sdk.BigEndianToUint64(iterator.Value())
+		permitNonces = append(permitNonces, types.NewPermitNonce(erc20, owner, nonce))
+	}
+
+	return permitNonces
+}
+
+// IsAuthorizationUsed returns true if the EIP-3009 authorization with the
+// given nonce of the given authorizer on the given erc20 precompile address
+// has been used or canceled.
+func (k Keeper) IsAuthorizationUsed(
+	ctx sdk.Context,
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUsedAuthorization)
+	return store.Has(types.UsedAuthorizationKey(erc20, authorizer, nonce))
+}
+
+// SetAuthorizationUsed marks the EIP-3009 authorization with the given nonce
+// of the given authorizer on the given erc20 precompile address as used.
+func (k Keeper) SetAuthorizationUsed(
+	ctx sdk.Context,
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUsedAuthorization)
+	store.Set(types.UsedAuthorizationKey(erc20, authorizer, nonce), []byte{0x01})
+}
+
+// GetUsedAuthorizations returns all used EIP-3009 authorizations stored on the erc20 precompiles.
+func (k Keeper) GetUsedAuthorizations(
+	ctx sdk.Context,
+) []types.UsedAuthorization {
+	authorizations := []types.UsedAuthorization{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUsedAuthorization)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		erc20 := common.BytesToAddress(key[:common.AddressLength])
+		authorizer := common.BytesToAddress(key[common.AddressLength : 2*common.AddressLength])
+		nonce := common.BytesToHash(key[2*common.AddressLength:])
+		authorizations = append(authorizations, types.NewUsedAuthorization(erc20, authorizer, nonce))
+	}
+
+	return authorizations
+}
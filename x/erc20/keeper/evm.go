@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/cosmos/evm/contracts"
 	"github.com/cosmos/evm/utils"
@@ -157,3 +158,9 @@ func (k Keeper) BalanceOf(
 
 	return balance
 }
+
+// GetEthChainConfig returns the chain config used in the EVM at the given
+// context (geth type).
+func (k Keeper) GetEthChainConfig(ctx sdk.Context) *params.ChainConfig {
+	return k.evmKeeper.GetEthChainConfig(ctx)
+}
@@ -99,7 +99,9 @@ func (k Keeper) EnableNativePrecompile(ctx sdk.Context, addr common.Address) err
 	return nil
 }
 
-// Only to be used by ExportGenesis, not to be directly used
+// GetNativePrecompiles returns the sorted addresses of all the native precompiles.
+// It iterates over the whole prefix store, so it should not be used in the tx
+// execution path.
 func (k Keeper) GetNativePrecompiles(ctx sdk.Context) []string {
 	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixNativePrecompiles)
 	defer iterator.Close()
@@ -139,7 +141,9 @@ func (k Keeper) EnableDynamicPrecompile(ctx sdk.Context, address common.Address)
 	return nil
 }
 
-// Only to be used by ExportGenesis, not to be directly used
+// GetDynamicPrecompiles returns the sorted addresses of all the dynamic precompiles.
+// It iterates over the whole prefix store, so it should not be used in the tx
+// execution path.
 func (k Keeper) GetDynamicPrecompiles(ctx sdk.Context) []string {
 	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixDynamicPrecompiles)
 	defer iterator.Close()
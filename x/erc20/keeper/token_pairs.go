@@ -113,6 +113,9 @@ func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	k.deleteERC20Map(ctx, tokenPair.GetERC20Contract())
 	k.deleteDenomMap(ctx, tokenPair.Denom)
 	k.deleteAllowances(ctx, tokenPair.GetERC20Contract())
+	// NOTE: the permit nonces and the used authorizations are not deleted, so that
+	// the signed permits and authorizations cannot be replayed if a token pair with
+	// the same ERC20 address is registered again.
 }
 
 // deleteTokenPair deletes the token pair for the given id.
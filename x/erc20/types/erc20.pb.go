@@ -199,6 +199,135 @@ func (m *Allowance) GetSpender() string {
 	return ""
 }
 
+// PermitNonce is the EIP-2612 permit nonce of an owner on an erc20 precompile
+type PermitNonce struct {
+	// erc20_address is the hex address of ERC20 contract
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// owner is the hex address of the owner account
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// nonce is the next nonce to be used by a permit of the owner
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *PermitNonce) Reset()         { *m = PermitNonce{} }
+func (m *PermitNonce) String() string { return proto.CompactTextString(m) }
+func (*PermitNonce) ProtoMessage()    {}
+func (*PermitNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1164958b5b106e92, []int{2}
+}
+func (m *PermitNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermitNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermitNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermitNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermitNonce.Merge(m, src)
+}
+func (m *PermitNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermitNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermitNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermitNonce proto.InternalMessageInfo
+
+func (m *PermitNonce) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *PermitNonce) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PermitNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// UsedAuthorization is an EIP-3009 authorization of an erc20 precompile that
+// has been used or canceled by its authorizer
+type UsedAuthorization struct {
+	// erc20_address is the hex address of ERC20 contract
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// authorizer is the hex address of the account that signed the authorization
+	Authorizer string `protobuf:"bytes,2,opt,name=authorizer,proto3" json:"authorizer,omitempty"`
+	// nonce is the hex encoded 32 bytes nonce of the authorization
+	Nonce string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *UsedAuthorization) Reset()         { *m = UsedAuthorization{} }
+func (m *UsedAuthorization) String() string { return proto.CompactTextString(m) }
+func (*UsedAuthorization) ProtoMessage()    {}
+func (*UsedAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1164958b5b106e92, []int{3}
+}
+func (m *UsedAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsedAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsedAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsedAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsedAuthorization.Merge(m, src)
+}
+func (m *UsedAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsedAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsedAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsedAuthorization proto.InternalMessageInfo
+
+func (m *UsedAuthorization) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *UsedAuthorization) GetAuthorizer() string {
+	if m != nil {
+		return m.Authorizer
+	}
+	return ""
+}
+
+func (m *UsedAuthorization) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
 // Deprecated: RegisterCoinProposal is a gov Content type to register a token
 // pair for a native Cosmos coin. We're keeping it to remove the existing
 // proposals from store. After that, remove this message.
@@ -217,7 +346,7 @@ func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{2}
+	return fileDescriptor_1164958b5b106e92, []int{4}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,7 +408,7 @@ func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{3}
+	return fileDescriptor_1164958b5b106e92, []int{5}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,7 +461,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{4}
+	return fileDescriptor_1164958b5b106e92, []int{6}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,7 +529,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{5}
+	return fileDescriptor_1164958b5b106e92, []int{7}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,6 +583,8 @@ func init() {
 	proto.RegisterEnum("cosmos.evm.erc20.v1.Owner", Owner_name, Owner_value)
 	proto.RegisterType((*TokenPair)(nil), "cosmos.evm.erc20.v1.TokenPair")
 	proto.RegisterType((*Allowance)(nil), "cosmos.evm.erc20.v1.Allowance")
+	proto.RegisterType((*PermitNonce)(nil), "cosmos.evm.erc20.v1.PermitNonce")
+	proto.RegisterType((*UsedAuthorization)(nil), "cosmos.evm.erc20.v1.UsedAuthorization")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "cosmos.evm.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*ProposalMetadata)(nil), "cosmos.evm.erc20.v1.ProposalMetadata")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "cosmos.evm.erc20.v1.RegisterERC20Proposal")
@@ -463,43 +594,47 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/erc20.proto", fileDescriptor_1164958b5b106e92) }
 
 var fileDescriptor_1164958b5b106e92 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xb1, 0x6b, 0xdb, 0x4e,
-	0x14, 0xc7, 0x75, 0x89, 0xf3, 0xfb, 0x45, 0x97, 0xc4, 0xb8, 0xd7, 0x04, 0x84, 0xc1, 0xb2, 0x71,
-	0xa0, 0x98, 0x0e, 0x52, 0xec, 0x6c, 0x85, 0x52, 0x6c, 0x47, 0x05, 0x97, 0xc4, 0x36, 0x8a, 0x43,
-	0x4b, 0x17, 0x73, 0x96, 0x0e, 0x45, 0x58, 0xba, 0x33, 0xba, 0x8b, 0xd2, 0x0e, 0xdd, 0x3b, 0x76,
-	0xe9, 0xd4, 0xa5, 0xd0, 0xa9, 0xff, 0x49, 0xc6, 0x8c, 0xa5, 0x43, 0x28, 0xf6, 0xd2, 0x3f, 0xa3,
-	0xe8, 0x4e, 0x2a, 0x69, 0xe9, 0x50, 0x9a, 0xed, 0xbe, 0x5f, 0xbf, 0xf7, 0xfc, 0x79, 0xf7, 0x9e,
-	0x0e, 0xd6, 0x3d, 0xc6, 0x63, 0xc6, 0x6d, 0x92, 0xc6, 0x36, 0x49, 0xbc, 0xce, 0x81, 0x9d, 0xb6,
-	0xd5, 0xc1, 0x5a, 0x24, 0x4c, 0x30, 0x74, 0x5f, 0x05, 0x58, 0x24, 0x8d, 0x2d, 0xe5, 0xa7, 0xed,
-	0xaa, 0x99, 0x67, 0xcd, 0x30, 0x9d, 0xdb, 0x69, 0x7b, 0x46, 0x04, 0x6e, 0x4b, 0xa1, 0x92, 0xaa,
-	0xbb, 0x01, 0x0b, 0x98, 0x3c, 0xda, 0xd9, 0x49, 0xb9, 0xcd, 0xcf, 0x00, 0xea, 0x13, 0x36, 0x27,
-	0x74, 0x8c, 0xc3, 0x04, 0xed, 0xc3, 0x1d, 0x59, 0x6f, 0x8a, 0x7d, 0x3f, 0x21, 0x9c, 0x1b, 0xa0,
-	0x01, 0x5a, 0xba, 0xbb, 0x2d, 0xcd, 0xae, 0xf2, 0xd0, 0x2e, 0xdc, 0xf0, 0x09, 0x65, 0xb1, 0xb1,
-	0x26, 0x7f, 0x54, 0x02, 0x19, 0xf0, 0x7f, 0x42, 0xf1, 0x2c, 0x22, 0xbe, 0xb1, 0xde, 0x00, 0xad,
-	0x4d, 0xb7, 0x90, 0xa8, 0x0b, 0xcb, 0x1e, 0xa3, 0x22, 0xc1, 0x9e, 0x98, 0xb2, 0x4b, 0x4a, 0x12,
-	0xa3, 0xd4, 0x00, 0xad, 0x72, 0xa7, 0x6a, 0xfd, 0xa1, 0x0d, 0x6b, 0x94, 0x45, 0xb8, 0x3b, 0x45,
-	0x86, 0x94, 0x8f, 0x4a, 0xdf, 0x3f, 0xd6, 0x41, 0xf3, 0x03, 0x80, 0x7a, 0x37, 0x8a, 0xd8, 0x25,
-	0xa6, 0x1e, 0xf9, 0x6b, 0x56, 0xf5, 0x97, 0x39, 0xab, 0x14, 0x19, 0x2b, 0x5f, 0x10, 0xea, 0x93,
-	0x44, 0xb2, 0xea, 0x6e, 0x21, 0xd1, 0x21, 0xdc, 0x48, 0x71, 0x74, 0x41, 0x24, 0xa2, 0xde, 0xab,
-	0x5d, 0xdd, 0xd4, 0xb5, 0xaf, 0x37, 0xf5, 0x3d, 0x45, 0xca, 0xfd, 0xb9, 0x15, 0x32, 0x3b, 0xc6,
-	0xe2, 0xdc, 0x1a, 0x50, 0xe1, 0xaa, 0x58, 0x49, 0xa7, 0x35, 0xdf, 0x03, 0xb8, 0xeb, 0x92, 0x20,
-	0xe4, 0x82, 0x24, 0x7d, 0x16, 0xd2, 0x71, 0xc2, 0x16, 0x8c, 0xe3, 0x28, 0x63, 0x10, 0xa1, 0x88,
-	0x48, 0x0e, 0xa8, 0x04, 0x6a, 0xc0, 0x2d, 0x9f, 0x70, 0x2f, 0x09, 0x17, 0x22, 0x64, 0x34, 0xe7,
-	0xbb, 0x6d, 0xa1, 0x27, 0x70, 0x33, 0x26, 0x02, 0xfb, 0x58, 0x60, 0x63, 0xbd, 0xb1, 0xde, 0xda,
-	0xea, 0xd4, 0x8a, 0x1b, 0x93, 0x63, 0xcd, 0x67, 0x6c, 0x9d, 0xe4, 0x41, 0xbd, 0x52, 0x46, 0xeb,
-	0xfe, 0x4c, 0xca, 0xb9, 0x4e, 0x61, 0xa5, 0x40, 0x29, 0x22, 0x7f, 0x29, 0x0d, 0xfe, 0xa1, 0x74,
-	0xf3, 0x0d, 0xdc, 0x2b, 0x7a, 0x75, 0xdc, 0x7e, 0xe7, 0xe0, 0xce, 0xcd, 0x3e, 0x80, 0x65, 0x39,
-	0xb8, 0x7c, 0x98, 0x84, 0xcb, 0x96, 0x75, 0xf7, 0x37, 0x37, 0xef, 0x89, 0xc3, 0xda, 0x84, 0x05,
-	0x41, 0x44, 0xe4, 0xea, 0xf6, 0x19, 0x4d, 0x49, 0xc2, 0x43, 0x76, 0xf7, 0x3b, 0xcf, 0xf2, 0xb2,
-	0x92, 0xf9, 0x5e, 0x28, 0xa1, 0xd6, 0xef, 0xe1, 0x33, 0xb8, 0x21, 0xb7, 0x11, 0xed, 0xc1, 0x7b,
-	0xa3, 0xe7, 0x43, 0xc7, 0x9d, 0x9e, 0x0d, 0x4f, 0xc7, 0x4e, 0x7f, 0xf0, 0x74, 0xe0, 0x1c, 0x55,
-	0x34, 0x54, 0x81, 0xdb, 0xca, 0x3e, 0x19, 0x1d, 0x9d, 0x1d, 0x3b, 0x15, 0x80, 0x10, 0x2c, 0x2b,
-	0xc7, 0x79, 0x31, 0x71, 0xdc, 0x61, 0xf7, 0xb8, 0xb2, 0x56, 0x2d, 0xbd, 0xfd, 0x64, 0x6a, 0xbd,
-	0xc7, 0x57, 0x4b, 0x13, 0x5c, 0x2f, 0x4d, 0xf0, 0x6d, 0x69, 0x82, 0x77, 0x2b, 0x53, 0xbb, 0x5e,
-	0x99, 0xda, 0x97, 0x95, 0xa9, 0xbd, 0xdc, 0x0f, 0x42, 0x71, 0x7e, 0x31, 0xb3, 0x3c, 0x16, 0xdb,
-	0xb7, 0xde, 0x81, 0x57, 0xf9, 0x4b, 0x20, 0x5e, 0x2f, 0x08, 0x9f, 0xfd, 0x27, 0x3f, 0xde, 0xc3,
-	0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xc4, 0x5f, 0x9c, 0x2a, 0x04, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4b, 0x1b, 0x4f,
+	0x14, 0xdf, 0xd1, 0xe4, 0xfb, 0x75, 0x47, 0x0d, 0x71, 0x1b, 0x21, 0x04, 0xdc, 0x84, 0x08, 0x25,
+	0xf4, 0xb0, 0x6b, 0xe2, 0xad, 0x50, 0x4a, 0x8c, 0x5b, 0xb0, 0x68, 0x0c, 0xab, 0xd2, 0xd2, 0x43,
+	0x65, 0xb2, 0xfb, 0x88, 0x8b, 0xbb, 0x33, 0x61, 0x66, 0x5c, 0xdb, 0x42, 0xef, 0x3d, 0xf6, 0xd2,
+	0x53, 0x2f, 0x85, 0x9e, 0xfa, 0x9f, 0x78, 0xf4, 0x58, 0x7a, 0x90, 0xa2, 0x97, 0xfe, 0x19, 0x65,
+	0x67, 0x76, 0x45, 0x4b, 0x0f, 0x52, 0x6f, 0xf3, 0xf9, 0xcc, 0x7b, 0xef, 0xf3, 0x79, 0xf3, 0xe3,
+	0xe1, 0x66, 0xc0, 0x44, 0xc2, 0x84, 0x0b, 0x69, 0xe2, 0x02, 0x0f, 0x7a, 0x6b, 0x6e, 0xda, 0xd5,
+	0x0b, 0x67, 0xca, 0x99, 0x64, 0xd6, 0x03, 0x1d, 0xe0, 0x40, 0x9a, 0x38, 0x9a, 0x4f, 0xbb, 0x0d,
+	0x3b, 0xcf, 0x1a, 0x13, 0x7a, 0xec, 0xa6, 0xdd, 0x31, 0x48, 0xd2, 0x55, 0x40, 0x27, 0x35, 0x6a,
+	0x13, 0x36, 0x61, 0x6a, 0xe9, 0x66, 0x2b, 0xcd, 0xb6, 0xbf, 0x21, 0x6c, 0xee, 0xb3, 0x63, 0xa0,
+	0x23, 0x12, 0x71, 0x6b, 0x15, 0x2f, 0xaa, 0x7a, 0x87, 0x24, 0x0c, 0x39, 0x08, 0x51, 0x47, 0x2d,
+	0xd4, 0x31, 0xfd, 0x05, 0x45, 0xf6, 0x35, 0x67, 0xd5, 0x70, 0x39, 0x04, 0xca, 0x92, 0xfa, 0x8c,
+	0xda, 0xd4, 0xc0, 0xaa, 0xe3, 0xff, 0x81, 0x92, 0x71, 0x0c, 0x61, 0x7d, 0xb6, 0x85, 0x3a, 0x73,
+	0x7e, 0x01, 0xad, 0x3e, 0xae, 0x04, 0x8c, 0x4a, 0x4e, 0x02, 0x79, 0xc8, 0x4e, 0x29, 0xf0, 0x7a,
+	0xa9, 0x85, 0x3a, 0x95, 0x5e, 0xc3, 0xf9, 0x4b, 0x1b, 0xce, 0x6e, 0x16, 0xe1, 0x2f, 0x16, 0x19,
+	0x0a, 0x3e, 0x2e, 0xfd, 0xfa, 0xd2, 0x44, 0xed, 0xcf, 0x08, 0x9b, 0xfd, 0x38, 0x66, 0xa7, 0x84,
+	0x06, 0x70, 0x67, 0xaf, 0x5a, 0x32, 0xf7, 0xaa, 0x40, 0xe6, 0x55, 0x4c, 0x81, 0x86, 0xc0, 0x95,
+	0x57, 0xd3, 0x2f, 0xa0, 0xb5, 0x8e, 0xcb, 0x29, 0x89, 0x4f, 0x40, 0x59, 0x34, 0x37, 0x56, 0xce,
+	0x2e, 0x9a, 0xc6, 0x8f, 0x8b, 0xe6, 0xb2, 0x76, 0x2a, 0xc2, 0x63, 0x27, 0x62, 0x6e, 0x42, 0xe4,
+	0x91, 0xb3, 0x45, 0xa5, 0xaf, 0x63, 0x95, 0x3b, 0xa3, 0xfd, 0x1a, 0xcf, 0x8f, 0x80, 0x27, 0x91,
+	0x1c, 0xb2, 0x7b, 0xda, 0xab, 0xe1, 0x32, 0xcd, 0x6a, 0x28, 0x73, 0x25, 0x5f, 0x83, 0x36, 0xc5,
+	0x4b, 0x07, 0x02, 0xc2, 0xfe, 0x89, 0x3c, 0x62, 0x3c, 0x7a, 0x47, 0x64, 0xc4, 0xe8, 0xdd, 0x54,
+	0x6c, 0x8c, 0x49, 0x9e, 0x75, 0x2d, 0x75, 0x83, 0xb9, 0xad, 0x67, 0x16, 0x7a, 0x9f, 0x10, 0xae,
+	0xf9, 0x30, 0x89, 0x84, 0x04, 0x3e, 0x60, 0x11, 0x1d, 0x71, 0x36, 0x65, 0x82, 0xc4, 0x59, 0xb8,
+	0x8c, 0x64, 0x0c, 0xb9, 0x96, 0x06, 0x56, 0x0b, 0xcf, 0x87, 0x20, 0x02, 0x1e, 0x4d, 0x33, 0x63,
+	0xb9, 0xca, 0x4d, 0xca, 0x7a, 0x8a, 0xe7, 0x12, 0x90, 0x24, 0x24, 0x92, 0xd4, 0x67, 0x5b, 0xb3,
+	0x9d, 0xf9, 0xde, 0x4a, 0xf1, 0x02, 0xd4, 0x33, 0xcd, 0xdf, 0xac, 0xb3, 0x93, 0x07, 0x6d, 0x94,
+	0xb2, 0xd3, 0xf7, 0xaf, 0x93, 0xf2, 0x73, 0xde, 0xc3, 0xd5, 0xc2, 0x4a, 0x11, 0x79, 0xab, 0x34,
+	0xfa, 0x87, 0xd2, 0xed, 0xf7, 0x78, 0xb9, 0xe8, 0xd5, 0xf3, 0x07, 0xbd, 0xb5, 0x7b, 0x37, 0xfb,
+	0x10, 0x57, 0xd4, 0x1d, 0xe4, 0xf7, 0x02, 0x42, 0xb5, 0x6c, 0xfa, 0x7f, 0xb0, 0x79, 0x4f, 0x02,
+	0xaf, 0xec, 0xb3, 0xc9, 0x24, 0x06, 0xf5, 0x15, 0x07, 0x8c, 0xa6, 0xc0, 0x45, 0xc4, 0xee, 0x7f,
+	0xe6, 0x59, 0x5e, 0x56, 0xb2, 0xb8, 0x5a, 0x05, 0xf4, 0x77, 0x7a, 0xf4, 0x1c, 0x97, 0xd5, 0xef,
+	0xb2, 0x96, 0xf1, 0xd2, 0xee, 0x8b, 0xa1, 0xe7, 0x1f, 0x1e, 0x0c, 0xf7, 0x46, 0xde, 0x60, 0xeb,
+	0xd9, 0x96, 0xb7, 0x59, 0x35, 0xac, 0x2a, 0x5e, 0xd0, 0xf4, 0xce, 0xee, 0xe6, 0xc1, 0xb6, 0x57,
+	0x45, 0x96, 0x85, 0x2b, 0x9a, 0xf1, 0x5e, 0xee, 0x7b, 0xfe, 0xb0, 0xbf, 0x5d, 0x9d, 0x69, 0x94,
+	0x3e, 0x7c, 0xb5, 0x8d, 0x8d, 0x27, 0x67, 0x97, 0x36, 0x3a, 0xbf, 0xb4, 0xd1, 0xcf, 0x4b, 0x1b,
+	0x7d, 0xbc, 0xb2, 0x8d, 0xf3, 0x2b, 0xdb, 0xf8, 0x7e, 0x65, 0x1b, 0xaf, 0x56, 0x27, 0x91, 0x3c,
+	0x3a, 0x19, 0x3b, 0x01, 0x4b, 0xdc, 0x1b, 0x73, 0xed, 0x4d, 0x3e, 0xd9, 0xe4, 0xdb, 0x29, 0x88,
+	0xf1, 0x7f, 0x6a, 0x18, 0xad, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x88, 0xf6, 0x64, 0xc6, 0xfa,
+	0x04, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -671,6 +806,92 @@ func (m *Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PermitNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermitNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermitNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsedAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsedAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsedAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Authorizer) > 0 {
+		i -= len(m.Authorizer)
+		copy(dAtA[i:], m.Authorizer)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Authorizer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -906,6 +1127,47 @@ func (m *Allowance) Size() (n int) {
 	return n
 }
 
+func (m *PermitNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovErc20(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *UsedAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Authorizer)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
 func (m *RegisterCoinProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1327,6 +1589,285 @@ func (m *Allowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PermitNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermitNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermitNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsedAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsedAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsedAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -73,6 +73,35 @@ func (gs GenesisState) Validate() error {
 		seenAllowance[a.Erc20Address+a.Owner+a.Spender] = true
 	}
 
+	// Check if permit nonces are valid. They don't require a corresponding token
+	// pair, as they are kept when a token pair is deleted.
+	seenPermitNonce := make(map[string]bool)
+	for _, n := range gs.PermitNonces {
+		if seenPermitNonce[n.Erc20Address+n.Owner] {
+			return fmt.Errorf("duplicated permit nonce on genesis: %s", n.Erc20Address+n.Owner)
+		}
+
+		if err := n.Validate(); err != nil {
+			return fmt.Errorf("invalid permit nonce on genesis: %w", err)
+		}
+
+		seenPermitNonce[n.Erc20Address+n.Owner] = true
+	}
+
+	// Check if used authorizations are valid
+	seenAuthorization := make(map[string]bool)
+	for _, a := range gs.UsedAuthorizations {
+		if seenAuthorization[a.Erc20Address+a.Authorizer+a.Nonce] {
+			return fmt.Errorf("duplicated used authorization on genesis: %s", a.Erc20Address+a.Authorizer+a.Nonce)
+		}
+
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("invalid used authorization on genesis: %w", err)
+		}
+
+		seenAuthorization[a.Erc20Address+a.Authorizer+a.Nonce] = true
+	}
+
 	return nil
 }
 
@@ -36,6 +36,11 @@ type GenesisState struct {
 	NativePrecompiles []string `protobuf:"bytes,4,rep,name=native_precompiles,json=nativePrecompiles,proto3" json:"native_precompiles,omitempty"`
 	// dynamic_precompiles is a slice of registered dynamic precompiles at genesis
 	DynamicPrecompiles []string `protobuf:"bytes,5,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// permit_nonces is a slice of the EIP-2612 permit nonces at genesis
+	PermitNonces []PermitNonce `protobuf:"bytes,6,rep,name=permit_nonces,json=permitNonces,proto3" json:"permit_nonces"`
+	// used_authorizations is a slice of the used EIP-3009 authorizations at
+	// genesis
+	UsedAuthorizations []UsedAuthorization `protobuf:"bytes,7,rep,name=used_authorizations,json=usedAuthorizations,proto3" json:"used_authorizations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +111,20 @@ func (m *GenesisState) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *GenesisState) GetPermitNonces() []PermitNonce {
+	if m != nil {
+		return m.PermitNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsedAuthorizations() []UsedAuthorization {
+	if m != nil {
+		return m.UsedAuthorizations
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <-->
@@ -171,33 +190,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/genesis.proto", fileDescriptor_e964b7a0cc2cbbd5) }
 
 var fileDescriptor_e964b7a0cc2cbbd5 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x93, 0xe6, 0xde, 0xd2, 0x4e, 0xba, 0xb0, 0x53, 0x17, 0xa1, 0x85, 0xf4, 0x8f, 0x9b,
-	0xe2, 0x22, 0xb1, 0x75, 0x23, 0x82, 0x8a, 0x05, 0x11, 0xbb, 0x2a, 0xd5, 0x95, 0x9b, 0x30, 0x8d,
-	0x87, 0x38, 0x98, 0x99, 0x09, 0x33, 0x63, 0xb4, 0x6f, 0xe1, 0x63, 0xb8, 0xf4, 0x31, 0xba, 0xec,
-	0x52, 0x10, 0x44, 0xda, 0x85, 0xaf, 0x21, 0x9d, 0x69, 0x69, 0x2a, 0xe5, 0x6e, 0xc2, 0xf0, 0xf1,
-	0xfb, 0x7d, 0x39, 0x1c, 0x0e, 0x1a, 0xa6, 0x42, 0x31, 0xa1, 0x62, 0x28, 0x59, 0x0c, 0x32, 0x9d,
-	0x3e, 0x8a, 0xcb, 0x49, 0x9c, 0x01, 0x07, 0x45, 0x55, 0x54, 0x48, 0xa1, 0x05, 0xee, 0x58, 0x24,
-	0x82, 0x92, 0x45, 0x06, 0x89, 0xca, 0x49, 0xb7, 0x4d, 0x18, 0xe5, 0x22, 0x36, 0x5f, 0xcb, 0x75,
-	0xfb, 0xd7, 0xaa, 0xac, 0x60, 0x81, 0xfb, 0x99, 0xc8, 0x84, 0x79, 0xc6, 0x87, 0x97, 0x4d, 0x47,
-	0xbf, 0x6a, 0xa8, 0xf5, 0xda, 0xfe, 0xf0, 0xad, 0x26, 0x1a, 0xf0, 0x73, 0x54, 0x2f, 0x88, 0x24,
-	0x4c, 0x05, 0xee, 0xc0, 0x1d, 0xfb, 0xd3, 0x5e, 0x74, 0x65, 0x80, 0x68, 0x61, 0x90, 0x59, 0x73,
-	0xf3, 0xbb, 0xef, 0x7c, 0xff, 0xfb, 0xe3, 0xa1, 0xbb, 0x3c, 0x5a, 0x78, 0x8e, 0x7c, 0x2d, 0x3e,
-	0x01, 0x4f, 0x0a, 0x42, 0xa5, 0x0a, 0x6a, 0x03, 0x6f, 0xec, 0x4f, 0xc3, 0xab, 0x25, 0xef, 0x0e,
-	0xdc, 0x82, 0x50, 0x59, 0xed, 0x41, 0xfa, 0x94, 0x2a, 0xfc, 0x06, 0x21, 0x92, 0xe7, 0xe2, 0x0b,
-	0xe1, 0x29, 0xa8, 0xc0, 0xbb, 0xa3, 0xea, 0xe5, 0x09, 0xbb, 0xa8, 0x3a, 0xcb, 0xf8, 0x09, 0xc2,
-	0x9c, 0x68, 0x5a, 0x42, 0x52, 0x48, 0x48, 0x05, 0x2b, 0x68, 0x0e, 0x2a, 0xb8, 0x19, 0x78, 0xe3,
-	0xa6, 0x51, 0x5c, 0xab, 0xb4, 0x2d, 0xb4, 0x38, 0x33, 0xf8, 0x29, 0xea, 0x7c, 0x58, 0x73, 0xc2,
-	0x68, 0x7a, 0xa1, 0xde, 0xfe, 0xaf, 0xe2, 0x23, 0x55, 0x71, 0x47, 0x12, 0xd5, 0xed, 0xa6, 0xf0,
-	0x10, 0xb5, 0x80, 0x93, 0x55, 0x0e, 0x89, 0x99, 0xd9, 0x2c, 0xb7, 0xb1, 0xf4, 0x6d, 0xf6, 0xea,
-	0x10, 0xe1, 0x17, 0xa8, 0x57, 0x80, 0x64, 0x54, 0x29, 0x2a, 0x78, 0x0e, 0x4a, 0x25, 0x12, 0x32,
-	0xaa, 0xb4, 0x24, 0x9a, 0x0a, 0x1e, 0xdc, 0x1a, 0xa3, 0x7b, 0x89, 0x2c, 0x2b, 0xc4, 0xfc, 0xa6,
-	0x51, 0xbb, 0xe7, 0xcd, 0x9e, 0x6d, 0x76, 0xa1, 0xbb, 0xdd, 0x85, 0xee, 0x9f, 0x5d, 0xe8, 0x7e,
-	0xdb, 0x87, 0xce, 0x76, 0x1f, 0x3a, 0x3f, 0xf7, 0xa1, 0xf3, 0xfe, 0x41, 0x46, 0xf5, 0xc7, 0xcf,
-	0xab, 0x28, 0x15, 0x2c, 0xae, 0x5c, 0xcb, 0xd7, 0xe3, 0xbd, 0xe8, 0x75, 0x01, 0x6a, 0x55, 0x37,
-	0x77, 0xf1, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x48, 0x19, 0xb7, 0x9b, 0x02, 0x00,
-	0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6a, 0x13, 0x41,
+	0x18, 0xc7, 0xb3, 0x4d, 0x1a, 0xdb, 0x49, 0x04, 0x3b, 0xf1, 0xb0, 0xa4, 0xb0, 0x4d, 0x2b, 0x48,
+	0xf0, 0xb0, 0x6b, 0xe3, 0x45, 0x04, 0x95, 0x16, 0x44, 0xec, 0x41, 0x42, 0xd4, 0x8b, 0x97, 0x65,
+	0xb2, 0xf9, 0xd8, 0x0e, 0xee, 0xcc, 0x2c, 0xf3, 0xcd, 0xae, 0xd6, 0xa7, 0xd0, 0xb7, 0xf0, 0xe8,
+	0x63, 0xf4, 0xd8, 0xa3, 0x27, 0x91, 0xe4, 0xe0, 0x6b, 0x48, 0x66, 0x52, 0xb2, 0x09, 0x8b, 0x97,
+	0x30, 0xfc, 0xf3, 0xfb, 0xff, 0x76, 0xbe, 0xdd, 0x8f, 0x1c, 0x27, 0x0a, 0x85, 0xc2, 0x08, 0x4a,
+	0x11, 0x81, 0x4e, 0x46, 0x8f, 0xa3, 0xf2, 0x34, 0x4a, 0x41, 0x02, 0x72, 0x0c, 0x73, 0xad, 0x8c,
+	0xa2, 0x3d, 0x87, 0x84, 0x50, 0x8a, 0xd0, 0x22, 0x61, 0x79, 0xda, 0x3f, 0x60, 0x82, 0x4b, 0x15,
+	0xd9, 0x5f, 0xc7, 0xf5, 0x8f, 0xea, 0x54, 0xae, 0xe0, 0x80, 0xfb, 0xa9, 0x4a, 0x95, 0x3d, 0x46,
+	0xcb, 0x93, 0x4b, 0x4f, 0xbe, 0xb7, 0x48, 0xf7, 0xb5, 0x7b, 0xe0, 0x3b, 0xc3, 0x0c, 0xd0, 0x17,
+	0xa4, 0x9d, 0x33, 0xcd, 0x04, 0xfa, 0xde, 0xc0, 0x1b, 0x76, 0x46, 0x87, 0x61, 0xcd, 0x05, 0xc2,
+	0xb1, 0x45, 0xce, 0xf7, 0xaf, 0x7f, 0x1f, 0x35, 0x7e, 0xfc, 0xfd, 0xf9, 0xc8, 0x9b, 0xac, 0x5a,
+	0xf4, 0x82, 0x74, 0x8c, 0xfa, 0x04, 0x32, 0xce, 0x19, 0xd7, 0xe8, 0xef, 0x0c, 0x9a, 0xc3, 0xce,
+	0x28, 0xa8, 0x95, 0xbc, 0x5f, 0x72, 0x63, 0xc6, 0x75, 0xd5, 0x43, 0xcc, 0x6d, 0x8a, 0xf4, 0x0d,
+	0x21, 0x2c, 0xcb, 0xd4, 0x67, 0x26, 0x13, 0x40, 0xbf, 0xf9, 0x1f, 0xd5, 0xd9, 0x2d, 0xb6, 0xa1,
+	0x5a, 0x97, 0xe9, 0x53, 0x42, 0x25, 0x33, 0xbc, 0x84, 0x38, 0xd7, 0x90, 0x28, 0x91, 0xf3, 0x0c,
+	0xd0, 0x6f, 0x0d, 0x9a, 0xc3, 0x7d, 0x5b, 0xf1, 0x5c, 0xe5, 0xc0, 0x41, 0xe3, 0x35, 0x43, 0x9f,
+	0x91, 0xde, 0xec, 0x4a, 0x32, 0xc1, 0x93, 0x8d, 0xea, 0xee, 0x76, 0x95, 0xae, 0xa8, 0x6a, 0x77,
+	0x4c, 0xee, 0xe6, 0xa0, 0x05, 0x37, 0xb1, 0x54, 0x76, 0x86, 0xb6, 0x9d, 0x61, 0x50, 0xff, 0x4e,
+	0x2d, 0xf9, 0x56, 0x6d, 0x4d, 0xd1, 0xcd, 0xd7, 0x39, 0xd2, 0x29, 0xe9, 0x15, 0x08, 0xb3, 0x98,
+	0x15, 0xe6, 0x52, 0x69, 0xfe, 0x95, 0x19, 0xae, 0x24, 0xfa, 0x77, 0xac, 0xf7, 0x61, 0xad, 0xf7,
+	0x03, 0xc2, 0xec, 0xac, 0x8a, 0x57, 0xed, 0xb4, 0xd8, 0xfe, 0x17, 0x4f, 0x34, 0x69, 0xbb, 0xef,
+	0x4b, 0x8f, 0x49, 0x17, 0x24, 0x9b, 0x66, 0x10, 0x5b, 0x9b, 0x5d, 0x89, 0xbd, 0x49, 0xc7, 0x65,
+	0xaf, 0x96, 0x11, 0x7d, 0x49, 0x0e, 0xed, 0x05, 0x11, 0xb9, 0x92, 0x19, 0x20, 0xc6, 0x1a, 0x52,
+	0x8e, 0x46, 0x5b, 0x99, 0xbf, 0x6b, 0x1b, 0xfd, 0x4d, 0x64, 0x52, 0x21, 0x2e, 0x5a, 0x7b, 0x3b,
+	0xf7, 0x9a, 0xe7, 0xcf, 0xaf, 0xe7, 0x81, 0x77, 0x33, 0x0f, 0xbc, 0x3f, 0xf3, 0xc0, 0xfb, 0xb6,
+	0x08, 0x1a, 0x37, 0x8b, 0xa0, 0xf1, 0x6b, 0x11, 0x34, 0x3e, 0x3e, 0x48, 0xb9, 0xb9, 0x2c, 0xa6,
+	0x61, 0xa2, 0x44, 0x54, 0xd9, 0xf1, 0x2f, 0xab, 0x2d, 0x37, 0x57, 0x39, 0xe0, 0xb4, 0x6d, 0xb7,
+	0xf9, 0xc9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x57, 0x4a, 0x94, 0x51, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -220,6 +242,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UsedAuthorizations) > 0 {
+		for iNdEx := len(m.UsedAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PermitNonces) > 0 {
+		for iNdEx := len(m.PermitNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermitNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -365,6 +415,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PermitNonces) > 0 {
+		for _, e := range m.PermitNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsedAuthorizations) > 0 {
+		for _, e := range m.UsedAuthorizations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -583,6 +645,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermitNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermitNonces = append(m.PermitNonces, PermitNonce{})
+			if err := m.PermitNonces[len(m.PermitNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedAuthorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsedAuthorizations = append(m.UsedAuthorizations, UsedAuthorization{})
+			if err := m.UsedAuthorizations[len(m.UsedAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -311,6 +311,98 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with permit nonces and used authorizations",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        1,
+					},
+				},
+				UsedAuthorizations: []types.UsedAuthorization{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        "0x0000000000000000000000000000000000000000000000000000000000000001",
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated permit nonces",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        1,
+					},
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        2,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - zero permit nonce",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        0,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated used authorizations",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				UsedAuthorizations: []types.UsedAuthorization{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        "0x0000000000000000000000000000000000000000000000000000000000000001",
+					},
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        "0x0000000000000000000000000000000000000000000000000000000000000001",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid used authorization nonce",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				UsedAuthorizations: []types.UsedAuthorization{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        "0x01",
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
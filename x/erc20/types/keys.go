@@ -34,6 +34,8 @@ const (
 	prefixAllowance
 	prefixNativePrecompiles
 	prefixDynamicPrecompiles
+	prefixPermitNonce
+	prefixUsedAuthorization
 )
 
 // KVStore key prefixes
@@ -45,6 +47,8 @@ var (
 	KeyPrefixAllowance          = []byte{prefixAllowance}
 	KeyPrefixNativePrecompiles  = []byte{prefixNativePrecompiles}
 	KeyPrefixDynamicPrecompiles = []byte{prefixDynamicPrecompiles}
+	KeyPrefixPermitNonce        = []byte{prefixPermitNonce}
+	KeyPrefixUsedAuthorization  = []byte{prefixUsedAuthorization}
 )
 
 func AllowanceKey(
@@ -54,3 +58,22 @@ func AllowanceKey(
 ) []byte {
 	return append(append(erc20.Bytes(), owner.Bytes()...), spender.Bytes()...)
 }
+
+// PermitNonceKey returns the key of the EIP-2612 permit nonce of the given
+// owner on the given erc20 precompile address.
+func PermitNonceKey(
+	erc20 common.Address,
+	owner common.Address,
+) []byte {
+	return append(erc20.Bytes(), owner.Bytes()...)
+}
+
+// UsedAuthorizationKey returns the key of the EIP-3009 authorization with the
+// given nonce of the given authorizer on the given erc20 precompile address.
+func UsedAuthorizationKey(
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) []byte {
+	return append(append(erc20.Bytes(), authorizer.Bytes()...), nonce.Bytes()...)
+}
@@ -15,6 +15,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	params "github.com/ethereum/go-ethereum/params"
+
 	statedb "github.com/cosmos/evm/x/vm/statedb"
 
 	tracing "github.com/ethereum/go-ethereum/core/tracing"
@@ -230,6 +232,26 @@ func (_m *EVMKeeper) GetCode(ctx types.Context, hash common.Hash) []byte {
 	return r0
 }
 
+// GetEthChainConfig provides a mock function with given fields: ctx
+func (_m *EVMKeeper) GetEthChainConfig(ctx types.Context) *params.ChainConfig {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEthChainConfig")
+	}
+
+	var r0 *params.ChainConfig
+	if rf, ok := ret.Get(0).(func(types.Context) *params.ChainConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*params.ChainConfig)
+		}
+	}
+
+	return r0
+}
+
 // GetParams provides a mock function with given fields: ctx
 func (_m *EVMKeeper) GetParams(ctx types.Context) vmtypes.Params {
 	ret := _m.Called(ctx)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -13,11 +14,13 @@ import (
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	"github.com/cosmos/evm/x/ibc/callbacks/types"
 	evmante "github.com/cosmos/evm/x/vm/ante"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	callbacktypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 
 	errorsmod "cosmossdk.io/errors"
@@ -277,12 +280,12 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := unmarshalSourcePacketData(packet, version)
 	if err != nil {
 		return err
 	}
 
-	cbData, isCbPacket, err := callbacktypes.GetCallbackData(data, version, packet.GetDestPort(), ctx.GasMeter().GasRemaining(), ctx.GasMeter().GasRemaining(), callbacktypes.SourceCallbackKey)
+	cbData, isCbPacket, err := callbacktypes.GetCallbackData(data, version, packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), ctx.GasMeter().GasRemaining(), callbacktypes.SourceCallbackKey)
 	if err != nil {
 		return err
 	}
@@ -378,12 +381,12 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := unmarshalSourcePacketData(packet, version)
 	if err != nil {
 		return err
 	}
 
-	cbData, isCbPacket, err := callbacktypes.GetCallbackData(data, version, packet.GetDestPort(), ctx.GasMeter().GasRemaining(), ctx.GasMeter().GasRemaining(), callbacktypes.SourceCallbackKey)
+	cbData, isCbPacket, err := callbacktypes.GetCallbackData(data, version, packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), ctx.GasMeter().GasRemaining(), callbacktypes.SourceCallbackKey)
 	if err != nil {
 		return err
 	}
@@ -439,3 +442,21 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	writeFn()
 	return nil
 }
+
+// unmarshalSourcePacketData unmarshals the data of a packet sent from this chain.
+// Packets sent from an interchain accounts controller port carry ICS-27 packet
+// data, so that contracts owning an interchain account receive the
+// acknowledgement and timeout callbacks of their transactions. Any other packet
+// is expected to be an ICS-20 transfer.
+func unmarshalSourcePacketData(packet channeltypes.Packet, version string) (any, error) {
+	if !strings.HasPrefix(packet.GetSourcePort(), icatypes.ControllerPortPrefix) {
+		return transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(packet.GetData()); err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-27 interchain account packet data: %s", err.Error())
+	}
+
+	return data, nil
+}
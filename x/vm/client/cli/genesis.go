@@ -0,0 +1,241 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const flagDecimals = "decimals"
+
+// NewImportAllocCmd returns a CLI command handler for importing a geth genesis
+// allocation into the genesis file.
+func NewImportAllocCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-alloc ALLOC_FILE",
+		Short: "Import a geth genesis allocation into genesis.json",
+		Long: `Import the accounts of a geth genesis allocation into genesis.json, so that the
+state exported from another chain can be loaded into a fresh chain. The file may hold
+either the allocation itself or a full geth genesis with an "alloc" field.
+Every account is added to the auth genesis with its nonce as sequence, its balance is
+credited in the EVM denom of the bank genesis, and its code and storage are added to the
+EVM genesis. Balances are given in wei and converted to the decimals of the EVM coin.
+`,
+		Example: "evmd genesis import-alloc ./alloc.json --decimals 18",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			alloc, err := readAlloc(args[0])
+			if err != nil {
+				return err
+			}
+
+			decimals, err := cmd.Flags().GetUint8(flagDecimals)
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return errors.Wrap(err, "failed to unmarshal genesis state")
+			}
+
+			if err := importAlloc(clientCtx.Codec, appState, alloc, types.Decimals(decimals)); err != nil {
+				return err
+			}
+
+			appGenesis.AppState, err = json.Marshal(appState)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal application genesis state")
+			}
+
+			return genutil.ExportGenesisFile(appGenesis, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Uint8(flagDecimals, uint8(types.EighteenDecimals), "Decimals of the EVM coin in the bank module")
+	return cmd
+}
+
+// readAlloc reads a geth genesis allocation from the given JSON file, which
+// holds either the allocation or a full geth genesis.
+func readAlloc(path string) (ethtypes.GenesisAlloc, error) {
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read allocation file")
+	}
+
+	var genesis struct {
+		Alloc json.RawMessage `json:"alloc"`
+	}
+	if err := json.Unmarshal(bz, &genesis); err != nil {
+		return nil, errors.Wrap(err, "failed to decode allocation file")
+	}
+	if len(genesis.Alloc) > 0 {
+		bz = genesis.Alloc
+	}
+
+	var alloc ethtypes.GenesisAlloc
+	if err := json.Unmarshal(bz, &alloc); err != nil {
+		return nil, errors.Wrap(err, "failed to decode allocation")
+	}
+	return alloc, nil
+}
+
+// importAlloc adds the accounts of the allocation to the auth, bank and EVM
+// genesis states of the given application state. Accounts that already exist
+// get their sequence, code and storage replaced and the balance added.
+func importAlloc(cdc codec.Codec, appState map[string]json.RawMessage, alloc ethtypes.GenesisAlloc, decimals types.Decimals) error {
+	if err := decimals.Validate(); err != nil {
+		return err
+	}
+
+	var evmGenState types.GenesisState
+	if err := cdc.UnmarshalJSON(appState[types.ModuleName], &evmGenState); err != nil {
+		return errors.Wrap(err, "failed to unmarshal EVM genesis state")
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return errors.Wrap(err, "failed to get genesis accounts from genesis state")
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	addresses := make([]common.Address, 0, len(alloc))
+	for address := range alloc {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	// index the existing accounts, balances and contracts by address
+	accIndex := make(map[string]int, len(accs))
+	for i, acc := range accs {
+		accIndex[acc.GetAddress().String()] = i
+	}
+	balanceIndex := make(map[string]int, len(bankGenState.Balances))
+	for i, balance := range bankGenState.Balances {
+		balanceIndex[balance.Address] = i
+	}
+	evmIndex := make(map[common.Address]int, len(evmGenState.Accounts))
+	for i, acc := range evmGenState.Accounts {
+		evmIndex[common.HexToAddress(acc.Address)] = i
+	}
+
+	conversionFactor := decimals.ConversionFactor().BigInt()
+	for _, address := range addresses {
+		account := alloc[address]
+		accAddr := sdk.AccAddress(address.Bytes())
+		bech32Addr := accAddr.String()
+
+		if i, ok := accIndex[bech32Addr]; ok {
+			if err := accs[i].SetSequence(account.Nonce); err != nil {
+				return err
+			}
+		} else {
+			accs = append(accs, authtypes.NewBaseAccount(accAddr, nil, 0, account.Nonce))
+		}
+
+		if account.Balance != nil && account.Balance.Sign() > 0 {
+			amount, remainder := new(big.Int).QuoRem(account.Balance, conversionFactor, new(big.Int))
+			if remainder.Sign() != 0 {
+				return fmt.Errorf("balance %s of %s cannot be represented with %d decimals", account.Balance, address.Hex(), decimals)
+			}
+
+			coins := sdk.NewCoins(sdk.NewCoin(evmGenState.Params.EvmDenom, sdkmath.NewIntFromBigInt(amount)))
+			if i, ok := balanceIndex[bech32Addr]; ok {
+				bankGenState.Balances[i].Coins = bankGenState.Balances[i].Coins.Add(coins...)
+			} else {
+				balanceIndex[bech32Addr] = len(bankGenState.Balances)
+				bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: bech32Addr, Coins: coins})
+			}
+			bankGenState.Supply = bankGenState.Supply.Add(coins...)
+		}
+
+		if len(account.Code) > 0 || len(account.Storage) > 0 {
+			genAccount := newGenesisAccount(address, account)
+			if i, ok := evmIndex[address]; ok {
+				evmGenState.Accounts[i] = genAccount
+			} else {
+				evmGenState.Accounts = append(evmGenState.Accounts, genAccount)
+			}
+		}
+	}
+
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+	authGenState.Accounts, err = authtypes.PackAccounts(accs)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert accounts into any's")
+	}
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+	if err := evmGenState.Validate(); err != nil {
+		return errors.Wrap(err, "invalid EVM genesis state")
+	}
+
+	if appState[authtypes.ModuleName], err = cdc.MarshalJSON(&authGenState); err != nil {
+		return errors.Wrap(err, "failed to marshal auth genesis state")
+	}
+	if appState[banktypes.ModuleName], err = cdc.MarshalJSON(bankGenState); err != nil {
+		return errors.Wrap(err, "failed to marshal bank genesis state")
+	}
+	if appState[types.ModuleName], err = cdc.MarshalJSON(&evmGenState); err != nil {
+		return errors.Wrap(err, "failed to marshal EVM genesis state")
+	}
+	return nil
+}
+
+// newGenesisAccount returns the EVM genesis account of an allocation account,
+// with its storage sorted by key.
+func newGenesisAccount(address common.Address, account ethtypes.Account) types.GenesisAccount {
+	keys := make([]common.Hash, 0, len(account.Storage))
+	for key := range account.Storage {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+	})
+
+	storage := make(types.Storage, len(keys))
+	for i, key := range keys {
+		storage[i] = types.NewState(key, account.Storage[key])
+	}
+
+	return types.GenesisAccount{
+		Address: address.Hex(),
+		Code:    common.Bytes2Hex(account.Code),
+		Storage: storage,
+	}
+}
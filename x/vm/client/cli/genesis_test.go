@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	allocEOA      = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	allocContract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	allocExisting = common.HexToAddress("0x3000000000000000000000000000000000000003")
+)
+
+func newTestAppState(t *testing.T, cdc codec.Codec) map[string]json.RawMessage {
+	t.Helper()
+
+	existing := sdk.AccAddress(allocExisting.Bytes())
+	accs, err := authtypes.PackAccounts(authtypes.GenesisAccounts{authtypes.NewBaseAccount(existing, nil, 5, 0)})
+	require.NoError(t, err)
+	authGenState := authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{})
+	authGenState.Accounts = accs
+
+	coins := sdk.NewCoins(sdk.NewCoin(types.DefaultEVMDenom, sdkmath.NewInt(10)))
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.Balances = []banktypes.Balance{{Address: existing.String(), Coins: coins}}
+	bankGenState.Supply = coins
+
+	return map[string]json.RawMessage{
+		authtypes.ModuleName: cdc.MustMarshalJSON(authGenState),
+		banktypes.ModuleName: cdc.MustMarshalJSON(bankGenState),
+		types.ModuleName:     cdc.MustMarshalJSON(types.DefaultGenesisState()),
+	}
+}
+
+func TestImportAlloc(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	oneEther := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	alloc := ethtypes.GenesisAlloc{
+		allocEOA: {Balance: oneEther, Nonce: 2},
+		allocContract: {
+			Code:    []byte{0x60, 0x80},
+			Storage: map[common.Hash]common.Hash{common.HexToHash("0x02"): common.HexToHash("0x0b"), common.HexToHash("0x01"): common.HexToHash("0x0a")},
+			Balance: big.NewInt(0),
+			Nonce:   1,
+		},
+		allocExisting: {Balance: oneEther, Nonce: 7},
+	}
+
+	testCases := []struct {
+		name       string
+		alloc      ethtypes.GenesisAlloc
+		decimals   types.Decimals
+		expBalance sdkmath.Int
+		expPass    bool
+	}{
+		{"pass - 18 decimals", alloc, types.EighteenDecimals, sdkmath.NewIntFromBigInt(oneEther), true},
+		{"pass - 6 decimals", alloc, types.SixDecimals, sdkmath.NewInt(1_000_000), true},
+		{"fail - fractional balance", ethtypes.GenesisAlloc{allocEOA: {Balance: big.NewInt(1)}}, types.SixDecimals, sdkmath.Int{}, false},
+		{"fail - invalid decimals", alloc, types.Decimals(19), sdkmath.Int{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appState := newTestAppState(t, cdc)
+			err := importAlloc(cdc, appState, tc.alloc, tc.decimals)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			require.NoError(t, err)
+			require.Len(t, accs, 3)
+			sequences := make(map[string]uint64)
+			for _, acc := range accs {
+				sequences[acc.GetAddress().String()] = acc.GetSequence()
+			}
+			require.Equal(t, uint64(2), sequences[sdk.AccAddress(allocEOA.Bytes()).String()])
+			require.Equal(t, uint64(1), sequences[sdk.AccAddress(allocContract.Bytes()).String()])
+			require.Equal(t, uint64(7), sequences[sdk.AccAddress(allocExisting.Bytes()).String()])
+
+			bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+			require.Len(t, bankGenState.Balances, 2)
+			balances := make(map[string]sdkmath.Int)
+			for _, balance := range bankGenState.Balances {
+				balances[balance.Address] = balance.Coins.AmountOf(types.DefaultEVMDenom)
+			}
+			require.Equal(t, tc.expBalance, balances[sdk.AccAddress(allocEOA.Bytes()).String()])
+			require.Equal(t, tc.expBalance.AddRaw(10), balances[sdk.AccAddress(allocExisting.Bytes()).String()])
+			require.Equal(t, tc.expBalance.MulRaw(2).AddRaw(10), bankGenState.Supply.AmountOf(types.DefaultEVMDenom))
+
+			var evmGenState types.GenesisState
+			require.NoError(t, cdc.UnmarshalJSON(appState[types.ModuleName], &evmGenState))
+			require.Equal(t, []types.GenesisAccount{{
+				Address: allocContract.Hex(),
+				Code:    "6080",
+				Storage: types.Storage{
+					types.NewState(common.HexToHash("0x01"), common.HexToHash("0x0a")),
+					types.NewState(common.HexToHash("0x02"), common.HexToHash("0x0b")),
+				},
+			}}, evmGenState.Accounts)
+		})
+	}
+}
+
+func TestReadAlloc(t *testing.T) {
+	allocJSON := `{"` + allocEOA.Hex() + `":{"balance":"0x10","nonce":"0x1"}}`
+
+	testCases := []struct {
+		name    string
+		content string
+		expPass bool
+	}{
+		{"allocation", allocJSON, true},
+		{"geth genesis", `{"config":{"chainId":1},"alloc":` + allocJSON + `}`, true},
+		{"invalid JSON", `{`, false},
+		{"invalid address", `{"0x01":{"balance":"0x10"}}`, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "alloc.json")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			alloc, err := readAlloc(path)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(16), alloc[allocEOA].Balance)
+			require.Equal(t, uint64(1), alloc[allocEOA].Nonce)
+		})
+	}
+}
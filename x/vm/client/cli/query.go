@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/evm/contracts"
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/vm/types"
 
@@ -39,6 +40,7 @@ func GetQueryCmd() *cobra.Command {
 		Bech32ToHexCmd(),
 		GetBankBalanceCmd(),
 		GetERC20BalanceCmd(),
+		GetCallCmd(),
 	)
 	return cmd
 }
@@ -342,3 +344,108 @@ func GetERC20BalanceCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCallCmd calls a contract method without sending a transaction
+func GetCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call ADDRESS METHOD [ARGS...]",
+		Short: "Call a contract method without sending a transaction",
+		Long: `Call a contract method without sending a transaction and decode its outputs. The call
+data is encoded using the contract ABI passed with the '--abi' flag. Integers may be given
+in decimal or 0x prefixed hex, bytes in hex, addresses in 0x or bech32 format and arrays
+as JSON arrays. If the height is not provided, it will use the latest height from context.`,
+		Example: "evmd query evm call 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE balanceOf 0xA2A8B87390F8F2D188242656BFb6852914073D06 --abi ./ERC20.abi",
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+			to := common.HexToAddress(address)
+
+			abiPath, err := cmd.Flags().GetString(flagABI)
+			if err != nil {
+				return err
+			}
+			contractABI, err := loadABI(abiPath)
+			if err != nil {
+				return err
+			}
+
+			method, input, err := packMethodCall(contractABI, args[1], args[2:])
+			if err != nil {
+				return err
+			}
+
+			value, err := getBigIntFlag(cmd, flagValue)
+			if err != nil {
+				return err
+			}
+
+			callArgs := types.TransactionArgs{
+				To:    &to,
+				Value: (*hexutil.Big)(value),
+				Input: (*hexutil.Bytes)(&input),
+			}
+
+			fromStr, err := cmd.Flags().GetString(flags.FlagFrom)
+			if err != nil {
+				return err
+			}
+			if fromStr != "" {
+				fromHex, err := accountToHex(fromStr)
+				if err != nil {
+					return err
+				}
+				from := common.HexToAddress(fromHex)
+				callArgs.From = &from
+			}
+
+			callData, err := json.Marshal(callArgs)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EthCall(
+				rpctypes.ContextWithHeight(clientCtx.Height),
+				&types.EthCallRequest{
+					Args:   callData,
+					GasCap: config.DefaultGasCap,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := types.HandleRevertError(res.VmError, res.Ret); err != nil {
+				return err
+			}
+
+			outputs, err := unpackABIOutputs(method, res.Ret)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(outputs)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().String(flagABI, "", "Path to the contract ABI JSON file")
+	cmd.Flags().String(flags.FlagFrom, "", "Address the call is made from")
+	cmd.Flags().String(flagValue, "0", "Amount of wei sent with the call")
+	_ = cmd.MarkFlagRequired(flagABI)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
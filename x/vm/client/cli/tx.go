@@ -2,16 +2,23 @@ package cli
 
 import (
 	"bufio"
+	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	evmclient "github.com/cosmos/evm/client"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/vm/types"
 
@@ -21,11 +28,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	types2 "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+const (
+	flagABI                  = "abi"
+	flagValue                = "value"
+	flagMaxFeePerGas         = "max-fee-per-gas"
+	flagMaxPriorityFeePerGas = "max-priority-fee-per-gas"
+)
+
 // NewTxCmd returns a root CLI command handler for evm module transaction commands
 func NewTxCmd(ac address.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
@@ -39,6 +54,8 @@ func NewTxCmd(ac address.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		NewRawTxCmd(),
 		NewSendTxCmd(ac),
+		NewDeployTxCmd(),
+		NewCallTxCmd(),
 	)
 	return txCmd
 }
@@ -74,51 +91,7 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			baseDenom := types.GetEVMCoinDenom()
-
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), baseDenom)
-			if err != nil {
-				return err
-			}
-
-			if clientCtx.GenerateOnly {
-				json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
-				if err != nil {
-					return err
-				}
-
-				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
-			}
-
-			if !clientCtx.SkipConfirm {
-				out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
-				if err != nil {
-					return err
-				}
-
-				_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
-
-				buf := bufio.NewReader(os.Stdin)
-				ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
-
-				if err != nil || !ok {
-					_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
-					return err
-				}
-			}
-
-			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
-			if err != nil {
-				return err
-			}
-
-			// broadcast to a CometBFT node
-			res, err := clientCtx.BroadcastTx(txBytes)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+			return broadcastEthereumTx(clientCtx, msg)
 		},
 	}
 
@@ -177,3 +150,342 @@ When using '--dry-run' a key name cannot be used, only an 0x or bech32 address.
 
 	return cmd
 }
+
+// NewDeployTxCmd returns a CLI command handler for deploying a contract.
+func NewDeployTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy BYTECODE [CONSTRUCTOR_ARGS...]",
+		Short: "Deploy a contract from its bytecode",
+		Long: `Deploy a contract from its bytecode, given either as a hex string or as the path
+to a file holding the hex encoded bytecode. Constructor arguments are encoded using
+the contract ABI passed with the '--abi' flag.
+The transaction is signed with the eth_secp256k1 key given by the '--from' flag.
+`,
+		Example: "evmd tx evm deploy ./Counter.bin 10 --abi ./Counter.abi --from mykey --gas auto",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			data, err := readBytecode(args[0])
+			if err != nil {
+				return err
+			}
+
+			abiPath, err := cmd.Flags().GetString(flagABI)
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case abiPath != "":
+				contractABI, err := loadABI(abiPath)
+				if err != nil {
+					return err
+				}
+				values, err := parseABIArgs(contractABI.Constructor.Inputs, args[1:])
+				if err != nil {
+					return err
+				}
+				packed, err := contractABI.Pack("", values...)
+				if err != nil {
+					return err
+				}
+				data = append(data, packed...)
+			case len(args) > 1:
+				return fmt.Errorf("the --%s flag is required to encode constructor arguments", flagABI)
+			}
+
+			return sendEthereumTx(cmd, clientCtx, nil, data)
+		},
+	}
+
+	cmd.Flags().String(flagABI, "", "Path to the contract ABI JSON file")
+	addEthereumTxFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCallTxCmd returns a CLI command handler for sending a transaction that
+// calls a contract method.
+func NewCallTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call ADDRESS METHOD [ARGS...]",
+		Short: "Call a contract method in a transaction",
+		Long: `Call a contract method in a transaction. The call data is encoded using the contract
+ABI passed with the '--abi' flag. Integers may be given in decimal or 0x prefixed hex,
+bytes in hex, addresses in 0x or bech32 format and arrays as JSON arrays.
+The transaction is signed with the eth_secp256k1 key given by the '--from' flag.
+`,
+		Example: "evmd tx evm call 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE transfer 0xA2A8B87390F8F2D188242656BFb6852914073D06 1000 --abi ./ERC20.abi --from mykey",
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+			to := common.HexToAddress(address)
+
+			abiPath, err := cmd.Flags().GetString(flagABI)
+			if err != nil {
+				return err
+			}
+			contractABI, err := loadABI(abiPath)
+			if err != nil {
+				return err
+			}
+
+			_, data, err := packMethodCall(contractABI, args[1], args[2:])
+			if err != nil {
+				return err
+			}
+
+			return sendEthereumTx(cmd, clientCtx, &to, data)
+		},
+	}
+
+	cmd.Flags().String(flagABI, "", "Path to the contract ABI JSON file")
+	_ = cmd.MarkFlagRequired(flagABI)
+	addEthereumTxFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// addEthereumTxFlags adds the value and fee flags of the Ethereum transactions
+// built by the deploy and call commands.
+func addEthereumTxFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagValue, "0", "Amount of wei sent with the transaction")
+	cmd.Flags().String(flagMaxFeePerGas, "", "Maximum fee per gas in wei (defaults to twice the base fee plus the priority fee)")
+	cmd.Flags().String(flagMaxPriorityFeePerGas, "0", "Maximum priority fee per gas in wei")
+}
+
+// sendEthereumTx builds an EIP-1559 transaction from the key given by the
+// '--from' flag, signs it with the EIP-155 chain ID of the EVM and broadcasts it.
+// A nil recipient deploys the data as a contract.
+func sendEthereumTx(cmd *cobra.Command, clientCtx client.Context, to *common.Address, data []byte) error {
+	evmCtx, err := getEVMContext(cmd.Context(), clientCtx)
+	if err != nil {
+		return err
+	}
+
+	fromAddr := clientCtx.GetFromAddress()
+	if err := checkEthSecp256k1Key(clientCtx.Keyring, fromAddr); err != nil {
+		return err
+	}
+	from := common.BytesToAddress(fromAddr)
+
+	value, err := getBigIntFlag(cmd, flagValue)
+	if err != nil {
+		return err
+	}
+	gasTipCap, err := getBigIntFlag(cmd, flagMaxPriorityFeePerGas)
+	if err != nil {
+		return err
+	}
+	// an empty value or priority fee is unset and defaults to zero
+	if value == nil {
+		value = new(big.Int)
+	}
+	if gasTipCap == nil {
+		gasTipCap = new(big.Int)
+	}
+	gasFeeCap, err := getBigIntFlag(cmd, flagMaxFeePerGas)
+	if err != nil {
+		return err
+	}
+
+	queryClient := types.NewQueryClient(evmCtx)
+
+	if gasFeeCap == nil {
+		res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+		if err != nil {
+			return err
+		}
+		gasFeeCap = new(big.Int).Set(gasTipCap)
+		if res.BaseFee != nil {
+			gasFeeCap.Add(gasFeeCap, new(big.Int).Mul(res.BaseFee.BigInt(), big.NewInt(2)))
+		}
+	}
+
+	account, err := queryClient.Account(cmd.Context(), &types.QueryAccountRequest{Address: from.Hex()})
+	if err != nil {
+		return err
+	}
+
+	gas, err := getEthereumTxGas(cmd, queryClient, types.TransactionArgs{
+		From:  &from,
+		To:    to,
+		Value: (*hexutil.Big)(value),
+		Input: (*hexutil.Bytes)(&data),
+	})
+	if err != nil {
+		return err
+	}
+
+	chainID := new(big.Int).SetUint64(evmCtx.EVMChainID)
+	msg := &types.MsgEthereumTx{From: from.Bytes()}
+	msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     account.Nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       gas,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	}))
+
+	if err := msg.Sign(ethtypes.LatestSignerForChainID(chainID), evmCtx.Keyring); err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if to == nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "contract address: %s\n", crypto.CreateAddress(from, account.Nonce).Hex())
+	}
+
+	return broadcastEthereumTx(evmCtx.Context, msg)
+}
+
+// getEthereumTxGas returns the gas limit given by the '--gas' flag, estimating
+// it when set to auto.
+func getEthereumTxGas(cmd *cobra.Command, queryClient types.QueryClient, args types.TransactionArgs) (uint64, error) {
+	gasStr, err := cmd.Flags().GetString(flags.FlagGas)
+	if err != nil {
+		return 0, err
+	}
+	gasSetting, err := flags.ParseGasSetting(gasStr)
+	if err != nil {
+		return 0, err
+	}
+	if !gasSetting.Simulate {
+		return gasSetting.Gas, nil
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := queryClient.EstimateGas(cmd.Context(), &types.EthCallRequest{
+		Args:   bz,
+		GasCap: config.DefaultGasCap,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := types.HandleRevertError(res.VmError, res.Ret); err != nil {
+		return 0, err
+	}
+
+	gasAdjustment, err := cmd.Flags().GetFloat64(flags.FlagGasAdjustment)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(gasAdjustment * float64(res.Gas)), nil
+}
+
+// getEVMContext wraps the client context with the EIP-155 chain ID of the EVM
+// queried from the node.
+func getEVMContext(ctx context.Context, clientCtx client.Context) (evmclient.EVMContext, error) {
+	res, err := types.NewQueryClient(clientCtx).Config(ctx, &types.QueryConfigRequest{})
+	if err != nil {
+		return evmclient.EVMContext{}, err
+	}
+	return evmclient.EVMContext{Context: clientCtx}.WithEVMChainID(res.Config.ChainId), nil
+}
+
+// checkEthSecp256k1Key returns an error if the keyring key of the given
+// address is not an eth_secp256k1 key, which is required to sign Ethereum
+// transactions.
+func checkEthSecp256k1Key(kr keyring.Keyring, address sdk.AccAddress) error {
+	if kr == nil {
+		return errors.New("a keyring is required to sign Ethereum transactions")
+	}
+
+	record, err := kr.KeyByAddress(address)
+	if err != nil {
+		return err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if _, ok := pubKey.(*ethsecp256k1.PubKey); !ok {
+		return fmt.Errorf("key %s is of type %s, expected %s", record.Name, pubKey.Type(), ethsecp256k1.KeyType)
+	}
+	return nil
+}
+
+// getBigIntFlag parses a base-10 integer flag, returning nil if it is not set.
+func getBigIntFlag(cmd *cobra.Command, flag string) (*big.Int, error) {
+	s, err := cmd.Flags().GetString(flag)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok || n.Sign() < 0 {
+		return nil, fmt.Errorf("invalid --%s value %s", flag, s)
+	}
+	return n, nil
+}
+
+// broadcastEthereumTx wraps the signed Ethereum transaction into a cosmos
+// transaction and broadcasts it, or prints it when generate only is set.
+func broadcastEthereumTx(clientCtx client.Context, msg *types.MsgEthereumTx) error {
+	baseDenom := types.GetEVMCoinDenom()
+
+	tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.GenerateOnly {
+		json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+	}
+
+	if !clientCtx.SkipConfirm {
+		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+
+		buf := bufio.NewReader(os.Stdin)
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+
+		if err != nil || !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
+			return err
+		}
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return err
+	}
+
+	// broadcast to a CometBFT node
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
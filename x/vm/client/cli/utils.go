@@ -1,10 +1,19 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -44,3 +53,252 @@ func formatKeyToHash(key string) string {
 
 	return ethkey.Hex()
 }
+
+// loadABI reads a contract ABI from the given JSON file. Both plain ABI arrays
+// and compiled contract artifacts holding the ABI under an "abi" field are
+// accepted.
+func loadABI(path string) (abi.ABI, error) {
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return abi.ABI{}, errors.Wrap(err, "failed to read contract ABI")
+	}
+
+	bz = bytes.TrimSpace(bz)
+	if len(bz) > 0 && bz[0] == '{' {
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(bz, &artifact); err != nil {
+			return abi.ABI{}, errors.Wrap(err, "failed to decode contract artifact")
+		}
+		bz = artifact.ABI
+	}
+
+	contractABI, err := abi.JSON(bytes.NewReader(bz))
+	if err != nil {
+		return abi.ABI{}, errors.Wrap(err, "failed to decode contract ABI")
+	}
+	return contractABI, nil
+}
+
+// readBytecode returns the contract bytecode given either as a hex string or
+// as the path to a file holding the hex encoded bytecode.
+func readBytecode(arg string) ([]byte, error) {
+	if !strings.HasPrefix(arg, "0x") {
+		if bz, err := os.ReadFile(filepath.Clean(arg)); err == nil {
+			arg = strings.TrimSpace(string(bz))
+		}
+	}
+	if !strings.HasPrefix(arg, "0x") {
+		arg = "0x" + arg
+	}
+
+	bytecode, err := hexutil.Decode(arg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode contract bytecode")
+	}
+	if len(bytecode) == 0 {
+		return nil, errors.New("contract bytecode cannot be empty")
+	}
+	return bytecode, nil
+}
+
+// packMethodCall encodes the call data of the given contract method, parsing
+// the method arguments from their command line representation.
+func packMethodCall(contractABI abi.ABI, name string, args []string) (abi.Method, []byte, error) {
+	method, ok := contractABI.Methods[name]
+	if !ok {
+		return abi.Method{}, nil, fmt.Errorf("method %s not found in the contract ABI", name)
+	}
+
+	values, err := parseABIArgs(method.Inputs, args)
+	if err != nil {
+		return abi.Method{}, nil, err
+	}
+
+	input, err := contractABI.Pack(name, values...)
+	if err != nil {
+		return abi.Method{}, nil, err
+	}
+	return method, input, nil
+}
+
+// parseABIArgs converts the command line arguments into the Go values expected
+// by the abi package for the given argument types.
+func parseABIArgs(arguments abi.Arguments, args []string) ([]interface{}, error) {
+	if len(args) != len(arguments) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(arguments), len(args))
+	}
+
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		value, err := parseABIValue(arguments[i].Type, arg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid argument %d of type %s", i, arguments[i].Type)
+		}
+		values[i] = value.Interface()
+	}
+	return values, nil
+}
+
+// parseABIValue parses a single argument of the given ABI type. Integers may be
+// given in decimal or 0x prefixed hex, bytes in hex, addresses in hex or bech32
+// and arrays as JSON arrays of their elements.
+func parseABIValue(typ abi.Type, arg string) (reflect.Value, error) {
+	switch typ.T {
+	case abi.AddressTy:
+		address, err := accountToHex(arg)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(common.HexToAddress(address)), nil
+	case abi.BoolTy:
+		b, err := strconv.ParseBool(arg)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(b), nil
+	case abi.StringTy:
+		return reflect.ValueOf(arg), nil
+	case abi.BytesTy:
+		bz, err := hexutil.Decode(arg)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(bz), nil
+	case abi.FixedBytesTy:
+		bz, err := hexutil.Decode(arg)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(bz) > typ.Size {
+			return reflect.Value{}, fmt.Errorf("value exceeds %d bytes", typ.Size)
+		}
+		value := reflect.New(typ.GetType()).Elem()
+		reflect.Copy(value, reflect.ValueOf(bz))
+		return value, nil
+	case abi.IntTy, abi.UintTy:
+		return parseABIInteger(typ, arg)
+	case abi.SliceTy, abi.ArrayTy:
+		var elems []json.RawMessage
+		if err := json.Unmarshal([]byte(arg), &elems); err != nil {
+			return reflect.Value{}, errors.Wrap(err, "expected a JSON array")
+		}
+
+		var value reflect.Value
+		if typ.T == abi.ArrayTy {
+			if len(elems) != typ.Size {
+				return reflect.Value{}, fmt.Errorf("expected %d elements, got %d", typ.Size, len(elems))
+			}
+			value = reflect.New(typ.GetType()).Elem()
+		} else {
+			value = reflect.MakeSlice(typ.GetType(), len(elems), len(elems))
+		}
+
+		for i, elem := range elems {
+			// elements may be given both as JSON strings and as raw JSON values
+			var s string
+			if err := json.Unmarshal(elem, &s); err != nil {
+				s = string(elem)
+			}
+			elemValue, err := parseABIValue(*typ.Elem, s)
+			if err != nil {
+				return reflect.Value{}, errors.Wrapf(err, "invalid element %d", i)
+			}
+			value.Index(i).Set(elemValue)
+		}
+		return value, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported argument type %s", typ)
+	}
+}
+
+// parseABIInteger parses an integer argument, checking that it fits into the
+// bit size of the ABI type.
+func parseABIInteger(typ abi.Type, arg string) (reflect.Value, error) {
+	n, ok := new(big.Int).SetString(arg, 0)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("invalid integer %s", arg)
+	}
+
+	if typ.T == abi.UintTy {
+		if n.Sign() < 0 || n.BitLen() > typ.Size {
+			return reflect.Value{}, fmt.Errorf("value %s out of range for %s", arg, typ)
+		}
+	} else {
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(typ.Size-1)) //nolint:gosec // G115 // ABI sizes are bounded
+		if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return reflect.Value{}, fmt.Errorf("value %s out of range for %s", arg, typ)
+		}
+	}
+
+	value := reflect.New(typ.GetType()).Elem()
+	switch value.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value.SetInt(n.Int64())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value.SetUint(n.Uint64())
+	default:
+		return reflect.ValueOf(n), nil
+	}
+	return value, nil
+}
+
+// unpackABIOutputs decodes the return data of a contract method into a map of
+// the output values keyed by their name, or by their position when unnamed.
+func unpackABIOutputs(method abi.Method, ret []byte) (map[string]interface{}, error) {
+	values, err := method.Outputs.Unpack(ret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode the outputs of %s", method.Name)
+	}
+
+	outputs := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		name := method.Outputs[i].Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		outputs[name] = formatABIValue(reflect.ValueOf(value))
+	}
+	return outputs, nil
+}
+
+// formatABIValue converts a value unpacked by the abi package into a JSON
+// friendly representation. Integers are formatted as decimal strings so that
+// they don't lose precision, and bytes are hex encoded.
+func formatABIValue(value reflect.Value) interface{} {
+	switch v := value.Interface().(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	}
+
+	switch value.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10)
+	case reflect.Array, reflect.Slice:
+		if value.Kind() == reflect.Array && value.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(bz), value)
+			return hexutil.Encode(bz)
+		}
+		elems := make([]interface{}, value.Len())
+		for i := range elems {
+			elems[i] = formatABIValue(value.Index(i))
+		}
+		return elems
+	case reflect.Struct:
+		fields := make(map[string]interface{}, value.NumField())
+		for i := 0; i < value.NumField(); i++ {
+			fields[value.Type().Field(i).Name] = formatABIValue(value.Field(i))
+		}
+		return fields
+	default:
+		return value.Interface()
+	}
+}
@@ -1,9 +1,13 @@
 package cli
 
 import (
+	"math/big"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -98,3 +102,147 @@ func TestAddressToCosmosAddress(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, baseAddr, ethFormatted)
 }
+
+const testABI = `[
+	{"type":"constructor","inputs":[{"name":"initial","type":"uint256"}]},
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"set","inputs":[{"name":"flags","type":"uint8[2]"},{"name":"ids","type":"int64[]"},{"name":"key","type":"bytes32"},{"name":"data","type":"bytes"},{"name":"name","type":"string"}],"outputs":[]},
+	{"type":"function","name":"get","inputs":[],"outputs":[{"name":"owner","type":"address"},{"name":"","type":"uint256"},{"name":"key","type":"bytes4"},{"name":"ids","type":"int32[]"}]}
+]`
+
+func writeTestABI(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "contract.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadABI(t *testing.T) {
+	testCases := []struct {
+		name      string
+		content   string
+		expectErr bool
+	}{
+		{"ABI array", testABI, false},
+		{"contract artifact", `{"contractName":"Test","abi":` + testABI + `,"bytecode":"0x00"}`, false},
+		{"invalid ABI", `[{"type":"function","name":"x","inputs":[{"type":"foo"}]}]`, true},
+		{"invalid JSON", `{`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contractABI, err := loadABI(writeTestABI(t, tc.content))
+			require.Equal(t, tc.expectErr, err != nil, err)
+
+			if !tc.expectErr {
+				require.Contains(t, contractABI.Methods, "transfer")
+				require.Len(t, contractABI.Constructor.Inputs, 1)
+			}
+		})
+	}
+}
+
+func TestReadBytecode(t *testing.T) {
+	path := writeTestABI(t, "6080604052\n")
+
+	bytecode, err := readBytecode(path)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, bytecode)
+
+	bytecode, err = readBytecode("0x6080")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x60, 0x80}, bytecode)
+
+	_, err = readBytecode("0x")
+	require.Error(t, err)
+
+	_, err = readBytecode("not-a-file")
+	require.Error(t, err)
+}
+
+func TestPackMethodCall(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(testABI))
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7")
+
+	testCases := []struct {
+		name      string
+		method    string
+		args      []string
+		expValues []interface{}
+		expectErr bool
+	}{
+		{
+			"transfer with hex address and decimal amount",
+			"transfer",
+			[]string{to.Hex(), "1000"},
+			[]interface{}{to, big.NewInt(1000)},
+			false,
+		},
+		{
+			"transfer with bech32 address and hex amount",
+			"transfer",
+			[]string{"cosmos18wvvwfmq77a6d8tza4h5sfuy2yj3jj88yqg82a", "0x3e8"},
+			[]interface{}{to, big.NewInt(1000)},
+			false,
+		},
+		{
+			"arrays, fixed bytes, bytes and string",
+			"set",
+			[]string{`[1, "2"]`, `["-5", 6]`, "0x0102", "0xabcd", "hello"},
+			[]interface{}{
+				[2]uint8{1, 2},
+				[]int64{-5, 6},
+				[32]byte{1, 2},
+				[]byte{0xab, 0xcd},
+				"hello",
+			},
+			false,
+		},
+		{"unknown method", "mint", []string{}, nil, true},
+		{"wrong number of arguments", "transfer", []string{to.Hex()}, nil, true},
+		{"negative uint", "transfer", []string{to.Hex(), "-1"}, nil, true},
+		{"uint out of range", "set", []string{`[256, 1]`, `[]`, "0x01", "0x", ""}, nil, true},
+		{"wrong array length", "set", []string{`[1]`, `[]`, "0x01", "0x", ""}, nil, true},
+		{"fixed bytes too long", "set", []string{`[1, 2]`, `[]`, "0x" + strings.Repeat("00", 33), "0x", ""}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			method, data, err := packMethodCall(contractABI, tc.method, tc.args)
+			require.Equal(t, tc.expectErr, err != nil, err)
+
+			if !tc.expectErr {
+				values, err := method.Inputs.Unpack(data[4:])
+				require.NoError(t, err)
+				require.Equal(t, tc.expValues, values)
+			}
+		})
+	}
+}
+
+func TestUnpackABIOutputs(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(testABI))
+	require.NoError(t, err)
+
+	method := contractABI.Methods["get"]
+	owner := common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7")
+	amount, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	ret, err := method.Outputs.Pack(owner, amount, [4]byte{0xde, 0xad, 0xbe, 0xef}, []int32{-1, 2})
+	require.NoError(t, err)
+
+	outputs, err := unpackABIOutputs(method, ret)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"owner": owner.Hex(),
+		"1":     "123456789012345678901234567890",
+		"key":   "0xdeadbeef",
+		"ids":   []interface{}{"-1", "2"},
+	}, outputs)
+
+	_, err = unpackABIOutputs(method, ret[:10])
+	require.Error(t, err)
+}
@@ -61,6 +61,15 @@ func InitGenesis(
 		panic(fmt.Errorf("error adding preinstalls: %s", err))
 	}
 
+	for _, activation := range data.ForkActivations {
+		if err := k.SetForkActivation(ctx, activation); err != nil {
+			panic(fmt.Errorf("error setting fork activation: %s", err))
+		}
+	}
+	if err := k.GetChainConfig(ctx).Validate(); err != nil {
+		panic(fmt.Errorf("invalid fork activations: %s", err))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -81,7 +90,8 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:        ethGenAccounts,
+		Params:          k.GetParams(ctx),
+		ForkActivations: k.GetForkActivations(ctx),
 	}
 }
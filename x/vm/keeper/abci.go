@@ -10,10 +10,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlock emits a base fee event which will be adjusted to the evm decimals
+// BeginBlock records the PREVRANDAO value of the block and emits a base fee
+// event which will be adjusted to the evm decimals
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	logger := ctx.Logger().With("begin_block", "evm")
 
+	k.SetPrevRandao(ctx, k.GetPrevRandao(ctx))
+
 	// Base fee is already set on FeeMarket BeginBlock
 	// that runs before this one
 	// We emit this event on the EVM and FeeMarket modules
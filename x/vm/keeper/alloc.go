@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExportAlloc streams the EVM state into the writer as a geth genesis
+// allocation, i.e. a JSON object of the accounts keyed by address. Contracts are
+// exported with their code and storage, followed by every other account that
+// holds a balance or has a non-zero nonce.
+func (k *Keeper) ExportAlloc(ctx sdk.Context, w io.Writer) error {
+	var (
+		err       error
+		count     int
+		contracts = make(map[common.Address]struct{})
+	)
+
+	writeAccount := func(address common.Address, account ethtypes.Account) {
+		var bz []byte
+		bz, err = json.Marshal(account)
+		if err != nil {
+			return
+		}
+
+		sep := ","
+		if count == 0 {
+			sep = ""
+		}
+		count++
+		_, err = fmt.Fprintf(w, "%s\n  %q: %s", sep, address.Hex(), bz)
+	}
+
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return err
+	}
+
+	k.IterateContracts(ctx, func(address common.Address, codeHash common.Hash) (stop bool) {
+		contracts[address] = struct{}{}
+		acct := k.GetAccountOrEmpty(ctx, address)
+
+		storage := make(map[common.Hash]common.Hash)
+		k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+			storage[key] = value
+			return true
+		})
+
+		writeAccount(address, ethtypes.Account{
+			Code:    k.GetCode(ctx, codeHash),
+			Storage: storage,
+			Balance: acct.Balance.ToBig(),
+			Nonce:   acct.Nonce,
+		})
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) (stop bool) {
+		address := common.BytesToAddress(account.GetAddress())
+		if _, ok := contracts[address]; ok {
+			return false
+		}
+
+		acct := k.GetAccountOrEmpty(ctx, address)
+		if acct.Nonce == 0 && acct.Balance.IsZero() {
+			return false
+		}
+
+		writeAccount(address, ethtypes.Account{
+			Balance: acct.Balance.ToBig(),
+			Nonce:   acct.Nonce,
+		})
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n}\n")
+	return err
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	gethparams "github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetForkActivations returns the hard fork activation times scheduled through
+// governance.
+func (k Keeper) GetForkActivations(ctx sdk.Context) []types.ForkActivation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixForkActivation)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var activations []types.ForkActivation
+	for ; iterator.Valid(); iterator.Next() {
+		var activation types.ForkActivation
+		k.cdc.MustUnmarshal(iterator.Value(), &activation)
+		activations = append(activations, activation)
+	}
+	return activations
+}
+
+// SetForkActivation stores the activation time of a hard fork, replacing any
+// previously scheduled time of the fork.
+func (k Keeper) SetForkActivation(ctx sdk.Context, activation types.ForkActivation) error {
+	if err := activation.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&activation)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixForkActivation)
+	store.Set([]byte(activation.Fork), bz)
+	return nil
+}
+
+// AddForkActivation schedules the activation of a hard fork at a future
+// block time. The fork must not be active yet and the resulting chain config
+// must respect the fork ordering.
+func (k Keeper) AddForkActivation(ctx sdk.Context, activation types.ForkActivation) error {
+	if err := activation.Validate(); err != nil {
+		return err
+	}
+
+	blockTime := uint64(ctx.BlockTime().Unix()) //#nosec G115 -- int overflow is not a concern here
+	if activation.ActivationTime <= blockTime {
+		return errorsmod.Wrapf(
+			types.ErrInvalidChainConfig,
+			"activation time %d of fork %s must be after the current block time %d",
+			activation.ActivationTime, activation.Fork, blockTime,
+		)
+	}
+
+	config := k.GetChainConfig(ctx)
+	if current := config.ForkActivationTime(activation.Fork); current != nil && current.Uint64() <= blockTime {
+		return errorsmod.Wrapf(types.ErrInvalidChainConfig, "fork %s is already active since %s", activation.Fork, current)
+	}
+
+	if err := config.WithForkActivations([]types.ForkActivation{activation}).Validate(); err != nil {
+		return err
+	}
+
+	return k.SetForkActivation(ctx, activation)
+}
+
+// GetChainConfig returns the chain config used in the EVM at the given
+// context, i.e. the chain config of the application with the fork activation
+// times scheduled through governance.
+func (k Keeper) GetChainConfig(ctx sdk.Context) *types.ChainConfig {
+	return types.GetChainConfig().WithForkActivations(k.GetForkActivations(ctx))
+}
+
+// GetEthChainConfig returns the chain config used in the EVM at the given
+// context (geth type).
+func (k Keeper) GetEthChainConfig(ctx sdk.Context) *gethparams.ChainConfig {
+	return k.GetChainConfig(ctx).EthereumConfig(nil)
+}
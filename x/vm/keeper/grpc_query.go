@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
@@ -41,6 +42,12 @@ const (
 	maxTracePredecessors = 10_000
 
 	maxPredecessorGas = uint64(50_000_000)
+
+	// maxStorageRangeResults is the maximum number of storage slots returned by a StorageRangeAt request.
+	maxStorageRangeResults = 1024
+	// maxAccountRangeResults is the maximum number of accounts returned by an AccountRange request,
+	// the same limit as go-ethereum.
+	maxAccountRangeResults = 256
 )
 
 // Account implements the Query/Account gRPC method. The method returns the
@@ -185,6 +192,231 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}, nil
 }
 
+// StorageProof implements the Query/StorageProof gRPC method. The storage trie
+// of the account is built on demand from its storage, so the cost of the query
+// grows with the number of storage slots of the account and it is only served
+// when the storage proofs are enabled on the node.
+func (k Keeper) StorageProof(c context.Context, req *types.QueryStorageProofRequest) (*types.QueryStorageProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !k.MPTProofsEnabled() {
+		return nil, status.Error(codes.Unavailable, "storage proofs are disabled on this node")
+	}
+
+	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	address := common.HexToAddress(req.Address)
+
+	storageTrie := types.NewStorageTrie()
+	var err error
+	k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+		err = storageTrie.Update(key, value)
+		return err == nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	proofs := make([]types.StorageSlotProof, len(req.Keys))
+	for i, keyHex := range req.Keys {
+		key := common.HexToHash(keyHex)
+		proof, err := storageTrie.Prove(key)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		proofs[i] = types.StorageSlotProof{
+			Key:   keyHex,
+			Value: k.GetState(ctx, address, key).Hex(),
+			Proof: proof,
+		}
+	}
+
+	return &types.QueryStorageProofResponse{
+		StorageHash: storageTrie.Hash().Hex(),
+		Proofs:      proofs,
+	}, nil
+}
+
+// Preimage implements the Query/Preimage gRPC method
+func (k Keeper) Preimage(_ context.Context, req *types.QueryPreimageRequest) (*types.QueryPreimageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !k.PreimageRecordingEnabled() {
+		return nil, status.Error(codes.Unavailable, "preimage recording is disabled on this node")
+	}
+
+	preimage, err := k.GetPreimage(common.HexToHash(req.Hash))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if preimage == nil {
+		return nil, status.Errorf(codes.NotFound, "preimage of %s not found", req.Hash)
+	}
+
+	return &types.QueryPreimageResponse{
+		Preimage: preimage,
+	}, nil
+}
+
+// StorageRangeAt implements the Query/StorageRangeAt gRPC method. The storage of
+// the account is iterated at the beginning of the requested transaction, once its
+// predecessors in the block are replayed on top of the state of the previous block.
+// The slots are ordered and paginated by the keccak256 hash of their keys, as in
+// the Ethereum storage trie.
+func (k Keeper) StorageRangeAt(c context.Context, req *types.QueryStorageRangeAtRequest) (*types.QueryStorageRangeAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	if len(req.Predecessors) > maxTracePredecessors {
+		return nil, status.Errorf(codes.InvalidArgument, "too many predecessors, got %d: limit %d", len(req.Predecessors), maxTracePredecessors)
+	}
+
+	maxResult := min(req.MaxResult, maxStorageRangeResults)
+
+	// get the context of block beginning
+	requestedHeight := req.BlockNumber
+	if requestedHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		requestedHeight = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	// the caller sets the `ctx.BlockHeight()` to be `requestedHeight - 1`, so we can get the context of block beginning
+	if requestedHeight > ctx.BlockHeight()+1 {
+		return nil, status.Errorf(codes.FailedPrecondition, "requested height [%d] must be less than or equal to current height [%d]", requestedHeight, ctx.BlockHeight())
+	}
+	ctx = ctx.WithBlockHeight(requestedHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	// to get the base fee we only need the block max gas in the consensus params
+	ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+	})
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// compute and use base fee of the height of the requested transaction
+	baseFee := k.feeMarketWrapper.CalculateBaseFee(ctx)
+	if baseFee != nil {
+		cfg.BaseFee = baseFee
+	}
+
+	signer := ethtypes.MakeSigner(k.GetEthChainConfig(ctx), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	ctx = evmante.BuildEvmExecutionCtx(ctx).
+		WithGasMeter(storetypes.NewGasMeter(maxPredecessorGas))
+	ctx = k.replayPredecessors(ctx, cfg, signer, &txConfig, req.Predecessors)
+
+	entries, nextKey := k.storageRange(ctx, common.HexToAddress(req.Address), common.HexToHash(req.KeyStart), int(maxResult)) //#nosec G115 -- bounded by maxStorageRangeResults
+
+	res := &types.QueryStorageRangeAtResponse{
+		Storage: make([]types.StorageEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		res.Storage[i] = types.StorageEntry{
+			Key:   entry.key.Hex(),
+			Value: entry.value.Hex(),
+		}
+	}
+	if nextKey != nil {
+		res.NextKey = nextKey.Hex()
+	}
+
+	return res, nil
+}
+
+// AccountRange implements the Query/AccountRange gRPC method. Only the accounts
+// holding code are iterated, since the other accounts are stored by the auth
+// module.
+func (k Keeper) AccountRange(c context.Context, req *types.QueryAccountRangeRequest) (*types.QueryAccountRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var start common.Address
+	if req.Start != "" {
+		if !common.IsHexAddress(req.Start) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start address %s", req.Start)
+		}
+		start = common.HexToAddress(req.Start)
+	}
+
+	maxResults := req.MaxResults
+	if maxResults == 0 || maxResults > maxAccountRangeResults {
+		maxResults = maxAccountRangeResults
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryAccountRangeResponse{
+		Accounts: []types.AccountRangeEntry{},
+	}
+	var err error
+	k.IterateContractsFrom(ctx, start, func(address common.Address, codeHash common.Hash) bool {
+		if uint64(len(res.Accounts)) == maxResults {
+			res.Next = address.Hex()
+			return true
+		}
+
+		acct := k.GetAccountOrEmpty(ctx, address)
+		account := types.AccountRangeEntry{
+			Address:  address.Hex(),
+			Balance:  acct.Balance.String(),
+			Nonce:    acct.Nonce,
+			CodeHash: codeHash.Hex(),
+		}
+		if !req.NoCode {
+			account.Code = k.GetCode(ctx, codeHash)
+		}
+		if !req.NoStorage {
+			storageTrie := types.NewStorageTrie()
+			account.Storage = []types.StorageEntry{}
+			k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+				if err = storageTrie.Update(key, value); err != nil {
+					return false
+				}
+				account.Storage = append(account.Storage, types.StorageEntry{
+					Key:   key.Hex(),
+					Value: value.Hex(),
+				})
+				return true
+			})
+			account.StorageRoot = storageTrie.Hash().Hex()
+		}
+
+		res.Accounts = append(res.Accounts, account)
+		return err != nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
@@ -242,11 +474,15 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := applyCallOverrides(req.Overrides, req.BlockOverrides, cfg); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.getCallNonce(ctx, cfg, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
-	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, types.GetEthChainConfig().ChainID); err != nil {
+	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, k.GetEthChainConfig(ctx).ChainID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -262,6 +498,148 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	return res, nil
 }
 
+// CreateAccessList implements the eth_createAccessList rpc api. The call is
+// executed repeatedly with an access list tracer until the generated access
+// list stabilizes. The sender, the recipient and all the precompiled contracts
+// are excluded from the list, as they are warm by default.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := applyCallOverrides(req.Overrides, req.BlockOverrides, cfg); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if args.Nonce == nil {
+		nonce := k.getCallNonce(ctx, cfg, args.GetFrom())
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+
+	ethCfg := k.GetEthChainConfig(ctx)
+	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, ethCfg.ChainID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	from := args.GetFrom()
+	to := crypto.CreateAddress(from, uint64(*args.Nonce))
+	if args.To != nil {
+		to = *args.To
+	}
+
+	// exclude the sender, the recipient, the precompiles and the valid authorities
+	// as they don't need to be added to the access list
+	rules := ethCfg.Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	excludes := k.GetPrecompileAddresses(ctx, &cfg.Params)
+
+	// prevent redundant operations if args contain more authorizations than the EVM may handle
+	if uint64(len(args.AuthorizationList)) > uint64(*args.Gas)/ethparams.CallNewAccountGas {
+		return nil, status.Error(codes.InvalidArgument, "insufficient gas to process all authorizations")
+	}
+	for _, auth := range args.AuthorizationList {
+		if (!auth.ChainID.IsZero() && auth.ChainID.CmpBig(ethCfg.ChainID) != 0) || auth.Nonce+1 < auth.Nonce {
+			continue
+		}
+		if authority, err := auth.Authority(); err == nil {
+			excludes = append(excludes, authority)
+		}
+	}
+
+	var prevAccessList ethtypes.AccessList
+	if args.AccessList != nil {
+		prevAccessList = *args.AccessList
+	}
+	prevTracer := types.NewAccessListTracer(prevAccessList, from, &to, rules, excludes...)
+
+	baseFee := cfg.BaseFee
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	for {
+		if err := ctx.Context().Err(); err != nil {
+			return nil, status.Error(codes.Canceled, err.Error())
+		}
+
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+		msg := args.ToMessage(baseFee, true, true)
+
+		// lower the base fee to 0 to avoid breaking the EVM invariants
+		// (base fee < fee cap) when no gas price is provided
+		cfg.BaseFee = baseFee
+		if msg.GasPrice.Sign() == 0 {
+			cfg.BaseFee = new(big.Int)
+		}
+
+		tracer := types.NewAccessListTracer(accessList, from, &to, rules, excludes...)
+		// pass false to not commit StateDB
+		res, err := k.ApplyMessageWithConfig(ctx, *msg, tracer.Hooks(), false, cfg, txConfig, false)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &types.CreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
+// SimulateV1 implements the eth_simulateV1 rpc api. The calls of all the
+// simulated blocks are executed sequentially on a single StateDB, so every call
+// observes the state changes of the previous ones.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (*types.SimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var opts types.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty input")
+	}
+	if len(opts.BlockStateCalls) > types.MaxSimulateBlocks {
+		return nil, status.Error(codes.InvalidArgument, "too many blocks")
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sim := newSimulator(&k, ctx, cfg, req.GasCap, opts)
+	results, err := sim.execute(opts.BlockStateCalls)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.SimulateV1Response{Data: data}, nil
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
 	return k.EstimateGasInternal(c, req, types.RPC)
@@ -320,8 +698,12 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
 
+	if err := applyCallOverrides(req.Overrides, req.BlockOverrides, cfg); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.getCallNonce(ctx, cfg, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
@@ -329,21 +711,31 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	if args.Gas == nil {
 		args.Gas = new(hexutil.Uint64)
 	}
-	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, types.GetEthChainConfig().ChainID); err != nil {
+	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, k.GetEthChainConfig(ctx).ChainID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// convert the tx args to an ethereum message
 	msg := args.ToMessage(cfg.BaseFee, true, true)
 
-	// Recap the highest gas limit with account's available balance.
+	// Recap the highest gas limit with the fee payer's available balance.
 	if msg.GasFeeCap.BitLen() != 0 {
 		baseDenom := types.GetEVMCoinDenom()
 
-		balance := k.bankWrapper.SpendableCoin(ctx, sdk.AccAddress(args.From.Bytes()), baseDenom)
+		// the fees are paid by the fee granter, if any, on behalf of the
+		// sender, in which case the value is not transferred from its balance
+		feePayer := args.GetFrom()
+		if args.FeePayer != nil {
+			feePayer = *args.FeePayer
+		}
+
+		balance := k.bankWrapper.SpendableCoin(ctx, sdk.AccAddress(feePayer.Bytes()), baseDenom)
+		if override, ok := cfg.Overrides[feePayer]; ok && override.Balance != nil {
+			balance = sdk.NewCoin(baseDenom, sdkmath.NewIntFromBigInt(override.Balance.ToInt()))
+		}
 		available := balance.Amount
 		transfer := "0"
-		if args.Value != nil {
+		if args.Value != nil && args.FeePayer == nil {
 			if args.Value.ToInt().Cmp(available.BigInt()) >= 0 {
 				return nil, core.ErrInsufficientFundsForTransfer
 			}
@@ -487,7 +879,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		cfg.BaseFee = baseFee
 	}
 
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	signer := ethtypes.MakeSigner(k.GetEthChainConfig(ctx), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 
 	// gas used at this point corresponds to GetProposerAddress & CalculateBaseFee
@@ -496,25 +888,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 
 	ctx = evmante.BuildEvmExecutionCtx(ctx).
 		WithGasMeter(storetypes.NewGasMeter(maxPredecessorGas))
-	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
-		if err != nil {
-			continue
-		}
-		msg.GasLimit = min(msg.GasLimit, maxPredecessorGas)
-		txConfig.TxHash = ethTx.Hash()
-		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
-
-		ctx = buildTraceCtx(ctx, msg.GasLimit)
-		// we ignore the error here. this endpoint, ideally, is called internally from the ETH backend, which will call this query
-		// using all previous txs in the trace transaction's block. some of those _could_ be invalid transactions.
-		rsp, _ := k.ApplyMessageWithConfig(ctx, *msg, nil, true, cfg, txConfig, false)
-		if rsp != nil {
-			ctx.GasMeter().ConsumeGas(rsp.GasUsed, "evm predecessor tx")
-			txConfig.LogIndex += uint(len(rsp.Logs))
-		}
-	}
+	ctx = k.replayPredecessors(ctx, cfg, signer, &txConfig, req.Predecessors)
 
 	tx := req.Msg.AsTransaction()
 	txConfig.TxHash = tx.Hash()
@@ -538,6 +912,80 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the state of the requested block. The
+// optional state and block overrides are applied before the execution and are
+// never persisted. The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	requestedHeight := req.BlockNumber
+	if requestedHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		requestedHeight = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if requestedHeight > ctx.BlockHeight()+1 {
+		return nil, status.Errorf(codes.FailedPrecondition, "requested height [%d] must be less than or equal to current height [%d]", requestedHeight, ctx.BlockHeight())
+	}
+	ctx = ctx.WithBlockHeight(requestedHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+	})
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	if err := applyCallOverrides(req.Overrides, req.BlockOverrides, cfg); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	if args.Nonce == nil {
+		nonce := k.getCallNonce(ctx, cfg, args.GetFrom())
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+
+	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, k.GetEthChainConfig(ctx).ChainID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	msg := args.ToMessage(cfg.BaseFee, true, true)
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	// pass false to not commit StateDB
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment for all the transactions in the queried block.
 // The return value will be tracer dependent.
@@ -578,7 +1026,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		cfg.BaseFee = baseFee
 	}
 
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	signer := ethtypes.MakeSigner(k.GetEthChainConfig(ctx), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	txsLength := len(req.Txs)
 	results := make([]*types.TxTraceResult, 0, txsLength)
 
@@ -618,6 +1066,25 @@ func (k *Keeper) traceTx(
 	tx *ethtypes.Transaction,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
+) (*any, uint, error) {
+	msg, err := core.TransactionToMessage(tx, signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage)
+}
+
+// traceMsg configures a new tracer according to the provided configuration and
+// executes the given message with it. It returns the tracer dependent result
+// and the log index following the logs emitted by the message.
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg *core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
 ) (*any, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -627,10 +1094,6 @@ func (k *Keeper) traceTx(
 		err              error
 		timeout          = defaultTraceTimeout
 	)
-	msg, err := core.TransactionToMessage(tx, signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -642,7 +1105,7 @@ func (k *Keeper) traceTx(
 	}
 
 	if traceConfig.Overrides != nil {
-		overrides = traceConfig.Overrides.EthereumConfig(types.GetEthChainConfig().ChainID)
+		overrides = traceConfig.Overrides.EthereumConfig(k.GetEthChainConfig(ctx).ChainID)
 	}
 
 	logConfig := logger.Config{
@@ -662,9 +1125,10 @@ func (k *Keeper) traceTx(
 	}
 
 	tCtx := &tracers.Context{
-		BlockHash: txConfig.BlockHash,
-		TxIndex:   int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
-		TxHash:    txConfig.TxHash,
+		BlockHash:   txConfig.BlockHash,
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		TxIndex:     int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
+		TxHash:      txConfig.TxHash,
 	}
 
 	if traceConfig.Tracer != "" {
@@ -673,7 +1137,7 @@ func (k *Keeper) traceTx(
 			cfg = json.RawMessage(traceConfig.TracerJsonConfig)
 		}
 		if tracer, err = tracers.DefaultDirectory.New(traceConfig.Tracer, tCtx, cfg,
-			types.GetEthChainConfig()); err != nil {
+			k.GetEthChainConfig(ctx)); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
 	}
@@ -735,14 +1199,48 @@ func (k Keeper) GlobalMinGasPrice(c context.Context, _ *types.QueryGlobalMinGasP
 }
 
 // Config implements the Query/Config gRPC method
-func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
-	config := types.GetChainConfig()
+func (k Keeper) Config(c context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	config := k.GetChainConfig(ctx)
 	config.Denom = types.GetEVMCoinDenom()
 	config.Decimals = uint64(types.GetEVMCoinDecimals())
 
 	return &types.QueryConfigResponse{Config: config}, nil
 }
 
+// replayPredecessors applies the predecessor transactions of a transaction in its
+// block on top of the context, and returns the context holding the resulting
+// state.
+func (k *Keeper) replayPredecessors(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	signer ethtypes.Signer,
+	txConfig *statedb.TxConfig,
+	predecessors []*types.MsgEthereumTx,
+) sdk.Context {
+	for i, tx := range predecessors {
+		ethTx := tx.AsTransaction()
+		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		msg.GasLimit = min(msg.GasLimit, maxPredecessorGas)
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
+
+		ctx = buildTraceCtx(ctx, msg.GasLimit)
+		// we ignore the error here. the queries replaying predecessors are, ideally, called internally from the ETH backend,
+		// using all previous txs in the requested transaction's block. some of those _could_ be invalid transactions.
+		rsp, _ := k.ApplyMessageWithConfig(ctx, *msg, nil, true, cfg, *txConfig, false)
+		if rsp != nil {
+			ctx.GasMeter().ConsumeGas(rsp.GasUsed, "evm predecessor tx")
+			txConfig.LogIndex += uint(len(rsp.Logs))
+		}
+	}
+	return ctx
+}
+
 // buildTraceCtx builds a context for simulating or tracing transactions by:
 // 1. assigning a new infinite gas meter with the provided gasLimit
 // 2. calling BuildEvmExecutionCtx to set up gas configs consistent with Ethereum transaction execution.
@@ -750,3 +1248,34 @@ func buildTraceCtx(ctx sdk.Context, gasLimit uint64) sdk.Context {
 	return evmante.BuildEvmExecutionCtx(ctx).
 		WithGasMeter(cosmosevmtypes.NewInfiniteGasMeterWithLimit(gasLimit))
 }
+
+// applyCallOverrides decodes the json encoded state and block overrides and
+// sets them on the EVM config. The block overrides of the fee recipient and the
+// base fee also replace the values loaded from the current state.
+func applyCallOverrides(overridesBz, blockOverridesBz []byte, cfg *statedb.EVMConfig) error {
+	overrides, blockOverrides, err := types.UnmarshalOverrides(overridesBz, blockOverridesBz)
+	if err != nil {
+		return err
+	}
+
+	cfg.Overrides = overrides
+	cfg.BlockOverrides = blockOverrides
+	if blockOverrides != nil {
+		if blockOverrides.FeeRecipient != nil {
+			cfg.CoinBase = *blockOverrides.FeeRecipient
+		}
+		if blockOverrides.BaseFeePerGas != nil {
+			cfg.BaseFee = blockOverrides.BaseFeePerGas.ToInt()
+		}
+	}
+	return nil
+}
+
+// getCallNonce returns the nonce of the sender for a simulated call, taking
+// into account the nonce state override of the sender if present.
+func (k Keeper) getCallNonce(ctx sdk.Context, cfg *statedb.EVMConfig, from common.Address) uint64 {
+	if override, ok := cfg.Overrides[from]; ok && override.Nonce != nil {
+		return uint64(*override.Nonce)
+	}
+	return k.GetNonce(ctx, from)
+}
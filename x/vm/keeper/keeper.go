@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
@@ -75,6 +76,14 @@ type Keeper struct {
 	// Some of these precompiled contracts might not be active depending on the EVM
 	// parameters.
 	precompiles map[common.Address]vm.PrecompiledContract
+
+	// preimageDB is the node-local database of the SHA3 preimages seen by the EVM.
+	// Preimage recording is disabled if nil.
+	preimageDB dbm.DB
+
+	// mptProofs defines if the node serves the Merkle-Patricia storage proofs,
+	// which are built on demand from the account storage.
+	mptProofs bool
 }
 
 // NewKeeper generates new evm module keeper
@@ -182,6 +191,25 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientTxIndex))
 }
 
+// SetTxFeePayerTransient sets the account paying the fees of the processing
+// transaction on behalf of its sender, or deletes it if the sender pays the
+// fees itself. It's called in the ante handler.
+func (k Keeper) SetTxFeePayerTransient(ctx sdk.Context, feePayer sdk.AccAddress) {
+	store := ctx.TransientStore(k.transientKey)
+	if feePayer.Empty() {
+		store.Delete(types.KeyPrefixTransientFeePayer)
+		return
+	}
+	store.Set(types.KeyPrefixTransientFeePayer, feePayer.Bytes())
+}
+
+// GetTxFeePayerTransient returns the account paying the fees of the processing
+// transaction on behalf of its sender, or nil if the sender pays the fees.
+func (k Keeper) GetTxFeePayerTransient(ctx sdk.Context) sdk.AccAddress {
+	store := ctx.TransientStore(k.transientKey)
+	return store.Get(types.KeyPrefixTransientFeePayer)
+}
+
 // ----------------------------------------------------------------------------
 // Hooks
 // ----------------------------------------------------------------------------
@@ -330,7 +358,7 @@ func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *uint256.Int {
 // - `0`: london hardfork enabled but feemarket is not enabled.
 // - `n`: both london hardfork and feemarket are enabled.
 func (k Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
-	ethCfg := types.GetEthChainConfig()
+	ethCfg := k.GetEthChainConfig(ctx)
 	if !types.IsLondon(ethCfg, ctx.BlockHeight()) {
 		return nil
 	}
@@ -95,6 +95,11 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, to.Hex()))
 	}
 
+	if feePayer := k.GetTxFeePayerTransient(ctx); feePayer != nil {
+		// add event for the fee granter that paid the tx fees on behalf of the sender
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyTxFeePayer, types.HexAddress(feePayer)))
+	}
+
 	if response.Failed() {
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, response.VmError))
 	}
@@ -164,3 +169,23 @@ func (k *Keeper) RegisterPreinstalls(goCtx context.Context, req *types.MsgRegist
 
 	return &types.MsgRegisterPreinstallsResponse{}, nil
 }
+
+// ScheduleForkActivation implements the gRPC MsgServer interface. When a
+// ScheduleForkActivation proposal passes, it schedules the activation of the
+// hard fork, which is picked up by the EVM on the first block at or after the
+// activation time. The scheduling can only be performed if the requested
+// authority is the Cosmos SDK governance module account.
+func (k *Keeper) ScheduleForkActivation(goCtx context.Context, req *types.MsgScheduleForkActivation) (*types.
+	MsgScheduleForkActivationResponse, error,
+) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.AddForkActivation(ctx, req.ForkActivation); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleForkActivationResponse{}, nil
+}
@@ -66,3 +66,22 @@ func (k *Keeper) GetPrecompilesCallHook(ctx sdktypes.Context) types.CallHook {
 		return nil
 	}
 }
+
+// GetPrecompileAddresses returns the addresses of the active static precompiles
+// together with the ones of all the native and dynamic ERC20 precompiles.
+func (k *Keeper) GetPrecompileAddresses(ctx sdktypes.Context, params *types.Params) []common.Address {
+	nativePrecompiles := k.erc20Keeper.GetNativePrecompiles(ctx)
+	dynamicPrecompiles := k.erc20Keeper.GetDynamicPrecompiles(ctx)
+
+	addresses := make([]common.Address, 0, len(params.ActiveStaticPrecompiles)+len(nativePrecompiles)+len(dynamicPrecompiles))
+	for _, hexAddr := range params.ActiveStaticPrecompiles {
+		addresses = append(addresses, common.HexToAddress(hexAddr))
+	}
+	for _, hexAddr := range nativePrecompiles {
+		addresses = append(addresses, common.HexToAddress(hexAddr))
+	}
+	for _, hexAddr := range dynamicPrecompiles {
+		addresses = append(addresses, common.HexToAddress(hexAddr))
+	}
+	return addresses
+}
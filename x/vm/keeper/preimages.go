@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithPreimageDB sets the node-local database where the SHA3 preimages seen by
+// the EVM during the state transitions are recorded. Preimage recording is
+// disabled when no database is set.
+//
+// NOTE: the preimages are not part of the consensus state, so the database is
+// only written on a best-effort basis and a node only knows the preimages of the
+// blocks it executed while recording was enabled.
+func (k *Keeper) WithPreimageDB(db dbm.DB) *Keeper {
+	k.preimageDB = db
+	return k
+}
+
+// PreimageRecordingEnabled returns true if the SHA3 preimages seen by the EVM
+// are recorded.
+func (k Keeper) PreimageRecordingEnabled() bool {
+	return k.preimageDB != nil
+}
+
+// GetPreimage returns the recorded preimage of the given hash, or nil if it is
+// unknown.
+func (k Keeper) GetPreimage(hash common.Hash) ([]byte, error) {
+	if k.preimageDB == nil {
+		return nil, nil
+	}
+	return k.preimageDB.Get(hash.Bytes())
+}
+
+// recordPreimages writes the preimages to the preimage database. Failures are
+// only logged since the preimages are not part of the consensus state.
+func (k Keeper) recordPreimages(ctx sdk.Context, preimages map[common.Hash][]byte) {
+	if k.preimageDB == nil || len(preimages) == 0 {
+		return
+	}
+
+	batch := k.preimageDB.NewBatch()
+	defer batch.Close()
+
+	for hash, preimage := range preimages {
+		if err := batch.Set(hash.Bytes(), preimage); err != nil {
+			k.Logger(ctx).Error("failed to record preimage", "hash", hash.Hex(), "error", err)
+			return
+		}
+	}
+	if err := batch.Write(); err != nil {
+		k.Logger(ctx).Error("failed to record preimages", "error", err)
+	}
+}
+
+// StorageDump is the storage of an account, with the preimages of its slots.
+type StorageDump struct {
+	Address common.Address    `json:"address"`
+	Storage []StorageSlotDump `json:"storage"`
+}
+
+// StorageSlotDump is a storage slot of an account. When the slot is the hash of
+// a recorded preimage, the preimage is included. The slot of a value of a
+// Solidity mapping is the hash of the mapping key followed by the slot of the
+// mapping, in which case both are decoded from the preimage.
+type StorageSlotDump struct {
+	Slot        common.Hash   `json:"slot"`
+	Value       common.Hash   `json:"value"`
+	Preimage    hexutil.Bytes `json:"preimage,omitempty"`
+	MappingKey  *common.Hash  `json:"mappingKey,omitempty"`
+	MappingSlot *common.Hash  `json:"mappingSlot,omitempty"`
+}
+
+// DumpStorage writes the storage of the account as a JSON StorageDump into the
+// writer, resolving the slots with the recorded preimages.
+func (k *Keeper) DumpStorage(ctx sdk.Context, address common.Address, w io.Writer) error {
+	dump := StorageDump{
+		Address: address,
+		Storage: []StorageSlotDump{},
+	}
+
+	var err error
+	k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+		slot := StorageSlotDump{
+			Slot:  key,
+			Value: value,
+		}
+
+		var preimage []byte
+		preimage, err = k.GetPreimage(key)
+		if err != nil {
+			return false
+		}
+		if preimage != nil {
+			slot.Preimage = preimage
+			if len(preimage) == 2*common.HashLength {
+				mappingKey := common.BytesToHash(preimage[:common.HashLength])
+				mappingSlot := common.BytesToHash(preimage[common.HashLength:])
+				slot.MappingKey, slot.MappingSlot = &mappingKey, &mappingSlot
+			}
+		}
+
+		dump.Storage = append(dump.Storage, slot)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(dump)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPrevRandao returns the PREVRANDAO value of the block at the given context.
+// The value recorded for the current height is returned if any, otherwise it is
+// derived from the header hash of the context. The latter is the case when
+// replaying a block on top of the state of its parent (e.g. tracing), where the
+// header hash is set from the request.
+func (k Keeper) GetPrevRandao(ctx sdk.Context) common.Hash {
+	// the value is part of the block context, so reading it is not charged to
+	// the caller
+	infCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	store := prefix.NewStore(infCtx.KVStore(k.storeKey), types.KeyPrefixPrevRandao)
+	heightBz := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())) //nolint:gosec // G115 // won't exceed uint64
+	if bz := store.Get(heightBz); len(bz) != 0 {
+		return common.BytesToHash(bz)
+	}
+
+	return types.PrevRandao(k.GetHashFn(ctx)(uint64(ctx.BlockHeight())).Bytes()) //nolint:gosec // G115 // won't exceed uint64
+}
+
+// SetPrevRandao records the PREVRANDAO value of the current block, so that it
+// can be served by the queries executed on the state of the block. The value of
+// the previous block is removed, as it remains available in the state of the
+// previous height.
+func (k Keeper) SetPrevRandao(ctx sdk.Context, prevRandao common.Hash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrevRandao)
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // won't exceed uint64
+	if height > 0 {
+		store.Delete(sdk.Uint64ToBigEndian(height - 1))
+	}
+	store.Set(sdk.Uint64ToBigEndian(height), prevRandao.Bytes())
+}
@@ -0,0 +1,455 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// transferTopic is the topic of the synthetic ether transfer logs,
+	// keccak256("Transfer(address,address,uint256)")
+	transferTopic = common.HexToHash("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	// transferAddress is the address of the synthetic ether transfer logs, as
+	// defined by ERC-7528
+	transferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+)
+
+// simulator executes the blocks of an eth_simulateV1 request on top of the
+// state of the queried block. A single StateDB is carried across all the calls
+// of all the blocks and is never committed.
+type simulator struct {
+	k        *Keeper
+	ctx      sdk.Context
+	cfg      *statedb.EVMConfig
+	stateDB  *statedb.StateDB
+	gasCap   uint64
+	validate bool
+	tracer   *transferTracer
+
+	// base is the header of the queried block
+	base     *ethtypes.Header
+	baseHash common.Hash
+}
+
+// newSimulator returns a simulator on top of the state of the given context.
+func newSimulator(k *Keeper, ctx sdk.Context, cfg *statedb.EVMConfig, gasCap uint64, opts types.SimOpts) *simulator {
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.Hash{}))
+	return &simulator{
+		k:        k,
+		ctx:      ctx,
+		cfg:      cfg,
+		stateDB:  stateDB,
+		gasCap:   gasCap,
+		validate: opts.Validation,
+		tracer:   newTransferTracer(stateDB, opts.TraceTransfers),
+		base: &ethtypes.Header{
+			Number:     big.NewInt(ctx.BlockHeight()),
+			Time:       uint64(ctx.BlockTime().Unix()), //#nosec G115 -- int overflow is not a concern here
+			GasLimit:   cosmosevmtypes.BlockGasLimit(k.SetConsensusParamsInCtx(ctx)),
+			Coinbase:   cfg.CoinBase,
+			BaseFee:    cfg.BaseFee,
+			Difficulty: big.NewInt(0),
+		},
+		baseHash: common.BytesToHash(ctx.HeaderHash()),
+	}
+}
+
+// execute simulates the given blocks in order and returns their results.
+func (s *simulator) execute(blocks []types.SimBlock) ([]types.SimBlockResult, error) {
+	blocks, err := s.sanitizeChain(blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]types.SimBlockResult, len(blocks))
+	parent, parentHash := s.base, s.baseHash
+	for i := range blocks {
+		result, err := s.processBlock(&blocks[i], parent, parentHash)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = *result
+		parent, parentHash = result.Header, result.Header.Hash()
+	}
+	return results, nil
+}
+
+// processBlock applies the state overrides of the block and executes its calls
+// sequentially.
+func (s *simulator) processBlock(block *types.SimBlock, parent *ethtypes.Header, parentHash common.Hash) (*types.SimBlockResult, error) {
+	header := s.makeHeader(block.BlockOverrides, parent, parentHash)
+
+	if block.StateOverrides != nil {
+		if err := s.stateDB.ApplyStateOverride(block.StateOverrides); err != nil {
+			return nil, fmt.Errorf("block %d: %w", header.Number, err)
+		}
+	}
+
+	// the calls are executed against the simulated header
+	cfg := *s.cfg
+	cfg.CoinBase = header.Coinbase
+	cfg.BaseFee = header.BaseFee
+	cfg.Overrides = nil
+	cfg.BlockOverrides = &types.BlockOverrides{
+		Number:        (*hexutil.Big)(header.Number),
+		Difficulty:    (*hexutil.Big)(header.Difficulty),
+		Time:          (*hexutil.Uint64)(&header.Time),
+		GasLimit:      (*hexutil.Uint64)(&header.GasLimit),
+		FeeRecipient:  &header.Coinbase,
+		PrevRandao:    block.BlockOverrides.PrevRandao,
+		BaseFeePerGas: (*hexutil.Big)(header.BaseFee),
+		BlobBaseFee:   block.BlockOverrides.BlobBaseFee,
+	}
+
+	var (
+		gasUsed  uint64
+		logIndex uint
+		result   = &types.SimBlockResult{
+			Transactions: make([]*ethtypes.Transaction, len(block.Calls)),
+			Senders:      make([]common.Address, len(block.Calls)),
+			Calls:        make([]types.SimCallResult, len(block.Calls)),
+		}
+		receipts = make(ethtypes.Receipts, len(block.Calls))
+	)
+	for i := range block.Calls {
+		args := block.Calls[i]
+		if err := s.sanitizeCall(&args, header, gasUsed); err != nil {
+			return nil, fmt.Errorf("block %d, call %d: %w", header.Number, i, err)
+		}
+
+		tx := args.ToTransaction(ethtypes.DynamicFeeTxType)
+		msg := args.ToMessage(header.BaseFee, !s.validate, true)
+		if s.validate {
+			if err := s.validateMessage(msg, header.BaseFee); err != nil {
+				return nil, fmt.Errorf("block %d, call %d: %w", header.Number, i, err)
+			}
+		}
+
+		s.stateDB.SetTxContext(statedb.NewTxConfig(common.Hash{}, tx.Hash(), uint(i), logIndex))
+		s.tracer.reset()
+
+		res, err := s.k.applyMessageWithStateDB(s.ctx, *msg, s.tracer.hooks(), false, &cfg, s.stateDB, false)
+		if err != nil {
+			return nil, fmt.Errorf("block %d, call %d: %w", header.Number, i, err)
+		}
+		s.finalizeMessage(msg, res.GasUsed, header.BaseFee)
+
+		logs := s.tracer.logs(s.stateDB.Logs())
+		for _, log := range logs {
+			log.BlockNumber = header.Number.Uint64()
+			log.TxHash = tx.Hash()
+			log.TxIndex = uint(i)
+			log.Index = logIndex
+			logIndex++
+		}
+
+		gasUsed += res.GasUsed
+		receipt := &ethtypes.Receipt{
+			Type:              tx.Type(),
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: gasUsed,
+			Logs:              logs,
+			TxHash:            tx.Hash(),
+			GasUsed:           res.GasUsed,
+		}
+		if res.Failed() {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+		}
+		receipt.Bloom = ethtypes.CreateBloom(receipt)
+
+		receipts[i] = receipt
+		result.Transactions[i] = tx
+		result.Senders[i] = msg.From
+		result.Calls[i] = types.NewSimCallResult(res, logs)
+	}
+
+	header.GasUsed = gasUsed
+	header.Bloom = ethtypes.MergeBloom(receipts)
+	if len(receipts) > 0 {
+		header.TxHash = ethtypes.DeriveSha(ethtypes.Transactions(result.Transactions), trie.NewStackTrie(nil))
+		header.ReceiptHash = ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil))
+	}
+	result.Header = header
+
+	// the block hash is only known once all the calls are executed
+	blockHash := header.Hash()
+	for _, receipt := range receipts {
+		for _, log := range receipt.Logs {
+			log.BlockHash = blockHash
+		}
+	}
+	return result, nil
+}
+
+// makeHeader returns the header of a simulated block, before the execution of
+// its calls. The fields that are not overridden are inherited from the parent.
+func (s *simulator) makeHeader(overrides *types.BlockOverrides, parent *ethtypes.Header, parentHash common.Hash) *ethtypes.Header {
+	header := &ethtypes.Header{
+		ParentHash:  parentHash,
+		UncleHash:   ethtypes.EmptyUncleHash,
+		Coinbase:    parent.Coinbase,
+		TxHash:      ethtypes.EmptyTxsHash,
+		ReceiptHash: ethtypes.EmptyReceiptsHash,
+		Difficulty:  big.NewInt(0),
+		Number:      new(big.Int).Set(overrides.Number.ToInt()),
+		GasLimit:    parent.GasLimit,
+		Time:        uint64(*overrides.Time),
+		MixDigest:   common.MaxHash,
+	}
+	if overrides.Difficulty != nil {
+		header.Difficulty = new(big.Int).Set(overrides.Difficulty.ToInt())
+	}
+	if overrides.GasLimit != nil {
+		header.GasLimit = uint64(*overrides.GasLimit)
+	}
+	if overrides.FeeRecipient != nil {
+		header.Coinbase = *overrides.FeeRecipient
+	}
+	if overrides.PrevRandao != nil {
+		header.MixDigest = *overrides.PrevRandao
+	}
+
+	// Outside of the validation mode the base fee is zero unless overridden, so
+	// that calls without a gas price can be executed.
+	switch {
+	case overrides.BaseFeePerGas != nil:
+		header.BaseFee = new(big.Int).Set(overrides.BaseFeePerGas.ToInt())
+	case s.validate && s.base.BaseFee != nil:
+		header.BaseFee = new(big.Int).Set(s.base.BaseFee)
+	default:
+		header.BaseFee = big.NewInt(0)
+	}
+	return header
+}
+
+// sanitizeChain checks that the block numbers and timestamps are strictly
+// increasing, setting the default values when they're not overridden. Gaps in
+// block numbers are filled with empty blocks.
+func (s *simulator) sanitizeChain(blocks []types.SimBlock) ([]types.SimBlock, error) {
+	var (
+		res           = make([]types.SimBlock, 0, len(blocks))
+		prevNumber    = s.base.Number
+		prevTimestamp = s.base.Time
+	)
+	for _, block := range blocks {
+		overrides := new(types.BlockOverrides)
+		if block.BlockOverrides != nil {
+			*overrides = *block.BlockOverrides
+		}
+		block.BlockOverrides = overrides
+
+		if overrides.Number == nil {
+			overrides.Number = (*hexutil.Big)(new(big.Int).Add(prevNumber, big.NewInt(1)))
+		}
+		number := overrides.Number.ToInt()
+		diff := new(big.Int).Sub(number, prevNumber)
+		if diff.Sign() <= 0 {
+			return nil, fmt.Errorf("block numbers must be in order: %d <= %d", number, prevNumber)
+		}
+		if total := new(big.Int).Sub(number, s.base.Number); total.Cmp(big.NewInt(types.MaxSimulateBlocks)) > 0 {
+			return nil, fmt.Errorf("too many blocks: %d > %d", total, types.MaxSimulateBlocks)
+		}
+
+		// fill the gap with empty blocks
+		for gap := diff.Uint64() - 1; gap > 0; gap-- {
+			prevNumber = new(big.Int).Add(prevNumber, big.NewInt(1))
+			prevTimestamp += types.SimulateTimestampIncrement
+			timestamp := prevTimestamp
+			res = append(res, types.SimBlock{
+				BlockOverrides: &types.BlockOverrides{
+					Number: (*hexutil.Big)(prevNumber),
+					Time:   (*hexutil.Uint64)(&timestamp),
+				},
+			})
+		}
+
+		if overrides.Time == nil {
+			timestamp := prevTimestamp + types.SimulateTimestampIncrement
+			overrides.Time = (*hexutil.Uint64)(&timestamp)
+		} else if uint64(*overrides.Time) <= prevTimestamp {
+			return nil, fmt.Errorf("block timestamps must be in order: %d <= %d", uint64(*overrides.Time), prevTimestamp)
+		}
+
+		prevNumber = number
+		prevTimestamp = uint64(*overrides.Time)
+		res = append(res, block)
+	}
+	return res, nil
+}
+
+// sanitizeCall sets the default values of the call arguments. The nonce
+// defaults to the one of the simulated state and the gas limit to the gas left
+// in the block.
+func (s *simulator) sanitizeCall(args *types.TransactionArgs, header *ethtypes.Header, gasUsed uint64) error {
+	if args.Nonce == nil {
+		nonce := s.stateDB.GetNonce(args.GetFrom())
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+	if args.Gas == nil && header.GasLimit > 0 {
+		remaining := header.GasLimit - gasUsed
+		args.Gas = (*hexutil.Uint64)(&remaining)
+	}
+	if err := args.CallDefaults(s.gasCap, header.BaseFee, s.k.GetEthChainConfig(s.ctx).ChainID); err != nil {
+		return err
+	}
+	if header.GasLimit > 0 && uint64(*args.Gas) > header.GasLimit-gasUsed {
+		return fmt.Errorf("block gas limit reached: %d >= %d", gasUsed, header.GasLimit)
+	}
+	return nil
+}
+
+// validateMessage performs the checks of the validation mode, which are
+// otherwise done by the ante handler: the nonce must match the sender one, the
+// fee cap must cover the base fee and the sender must be able to pay for the
+// gas and the transferred value.
+func (s *simulator) validateMessage(msg *core.Message, baseFee *big.Int) error {
+	nonce := s.stateDB.GetNonce(msg.From)
+	switch {
+	case msg.Nonce < nonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooLow, msg.From.Hex(), msg.Nonce, nonce)
+	case msg.Nonce > nonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooHigh, msg.From.Hex(), msg.Nonce, nonce)
+	}
+
+	if baseFee != nil && msg.GasFeeCap.Cmp(baseFee) < 0 {
+		return fmt.Errorf("%w: address %s, maxFeePerGas: %s, baseFee: %s", core.ErrFeeCapTooLow, msg.From.Hex(), msg.GasFeeCap, baseFee)
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+	cost.Add(cost, msg.Value)
+	if balance := s.stateDB.GetBalance(msg.From).ToBig(); balance.Cmp(cost) < 0 {
+		return fmt.Errorf("%w: address %s have %s want %s", core.ErrInsufficientFunds, msg.From.Hex(), balance, cost)
+	}
+	return nil
+}
+
+// finalizeMessage increments the sender nonce of a call, which is otherwise
+// done by the ante handler, and charges the gas fees in validation mode.
+// The nonce of contract creations is already incremented by the state
+// transition.
+func (s *simulator) finalizeMessage(msg *core.Message, gasUsed uint64, baseFee *big.Int) {
+	if msg.To != nil {
+		s.stateDB.SetNonce(msg.From, msg.Nonce+1, tracing.NonceChangeEoACall)
+	}
+	if !s.validate {
+		return
+	}
+
+	price := msg.GasPrice
+	if baseFee != nil {
+		// price = min(tip + baseFee, feeCap)
+		price = new(big.Int).Add(msg.GasTipCap, baseFee)
+		if price.Cmp(msg.GasFeeCap) > 0 {
+			price = msg.GasFeeCap
+		}
+	}
+	fee, overflow := uint256.FromBig(new(big.Int).Mul(price, new(big.Int).SetUint64(gasUsed)))
+	if !overflow {
+		s.stateDB.SubBalance(msg.From, fee, tracing.BalanceDecreaseGasBuy)
+	}
+}
+
+// transferTracer records the ether transfers of a call as synthetic ERC-7528
+// transfer logs. As the logs of the call are held by the StateDB, every
+// transfer records the number of logs emitted before it, so that both can be
+// merged in execution order. The transfers of reverted call frames are
+// discarded, as their logs are by the StateDB.
+type transferTracer struct {
+	stateDB        *statedb.StateDB
+	traceTransfers bool
+	// frames holds the transfers of the open call frames
+	frames [][]transfer
+	// transfers holds the transfers of the executed call
+	transfers []transfer
+}
+
+// transfer is a synthetic transfer log together with the number of logs
+// emitted by the StateDB before it.
+type transfer struct {
+	log      *ethtypes.Log
+	position int
+}
+
+func newTransferTracer(stateDB *statedb.StateDB, traceTransfers bool) *transferTracer {
+	return &transferTracer{stateDB: stateDB, traceTransfers: traceTransfers}
+}
+
+func (t *transferTracer) hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnEnter: t.onEnter,
+		OnExit:  t.onExit,
+	}
+}
+
+// reset prepares the tracer for the next call.
+func (t *transferTracer) reset() {
+	t.frames = nil
+	t.transfers = nil
+}
+
+func (t *transferTracer) onEnter(_ int, typ byte, from, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, nil)
+	if !t.traceTransfers || vm.OpCode(typ) == vm.DELEGATECALL || value == nil || value.Sign() <= 0 {
+		return
+	}
+
+	top := len(t.frames) - 1
+	t.frames[top] = append(t.frames[top], transfer{
+		log: &ethtypes.Log{
+			Address: transferAddress,
+			Topics: []common.Hash{
+				transferTopic,
+				common.BytesToHash(from.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			},
+			Data: common.BigToHash(value).Bytes(),
+		},
+		position: len(t.stateDB.Logs()),
+	})
+}
+
+func (t *transferTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	size := len(t.frames)
+	if size == 0 {
+		return
+	}
+	frame := t.frames[size-1]
+	t.frames = t.frames[:size-1]
+	if reverted {
+		return
+	}
+	if size == 1 {
+		t.transfers = frame
+		return
+	}
+	t.frames[size-2] = append(t.frames[size-2], frame...)
+}
+
+// logs returns the given StateDB logs of the executed call merged with its
+// transfer logs.
+func (t *transferTracer) logs(stateLogs []*ethtypes.Log) []*ethtypes.Log {
+	logs := make([]*ethtypes.Log, 0, len(stateLogs)+len(t.transfers))
+	next := 0
+	for _, tr := range t.transfers {
+		for ; next < tr.position && next < len(stateLogs); next++ {
+			logs = append(logs, stateLogs[next])
+		}
+		logs = append(logs, tr.log)
+	}
+	return append(logs, stateLogs[next:]...)
+}
@@ -69,8 +69,17 @@ func (k *Keeper) GetCodeHash(ctx sdk.Context, addr common.Address) common.Hash {
 //
 // The iteration is stopped when the callback function returns true.
 func (k Keeper) IterateContracts(ctx sdk.Context, cb func(addr common.Address, codeHash common.Hash) (stop bool)) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixCodeHash)
+	k.IterateContractsFrom(ctx, common.Address{}, cb)
+}
+
+// IterateContractsFrom iterates over the smart contract addresses in the EVM keeper
+// in ascending order, starting from the given address included, and performs a
+// callback function.
+//
+// The iteration is stopped when the callback function returns true.
+func (k Keeper) IterateContractsFrom(ctx sdk.Context, start common.Address, cb func(addr common.Address, codeHash common.Hash) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeHash)
+	iterator := store.Iterator(start.Bytes(), nil)
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
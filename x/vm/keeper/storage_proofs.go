@@ -0,0 +1,16 @@
+package keeper
+
+// WithMPTProofs sets if the node serves the Merkle-Patricia storage proofs of
+// the accounts through the StorageProof query. The storage trie is built on
+// demand from the whole storage of the account, so the query is disabled by
+// default.
+func (k *Keeper) WithMPTProofs(enable bool) *Keeper {
+	k.mptProofs = enable
+	return k
+}
+
+// MPTProofsEnabled returns true if the node serves the Merkle-Patricia storage
+// proofs of the accounts.
+func (k Keeper) MPTProofsEnabled() bool {
+	return k.mptProofs
+}
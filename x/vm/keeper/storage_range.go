@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"bytes"
+	"container/heap"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// hashedStorageEntry is a storage slot together with the keccak256 hash of its
+// key, which defines the order of the slots in the Ethereum storage trie.
+type hashedStorageEntry struct {
+	hash  common.Hash
+	key   common.Hash
+	value common.Hash
+}
+
+// hashedStorageHeap is a max-heap of storage slots ordered by hashed key.
+type hashedStorageHeap []hashedStorageEntry
+
+func (h hashedStorageHeap) Len() int { return len(h) }
+
+func (h hashedStorageHeap) Less(i, j int) bool {
+	return bytes.Compare(h[i].hash.Bytes(), h[j].hash.Bytes()) > 0
+}
+
+func (h hashedStorageHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *hashedStorageHeap) Push(x any) { *h = append(*h, x.(hashedStorageEntry)) }
+
+func (h *hashedStorageHeap) Pop() any {
+	old := *h
+	entry := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return entry
+}
+
+// storageRange returns up to limit storage slots of the account in ascending
+// order of their hashed keys, starting from the given hashed key included, as
+// the storage trie is iterated by geth. The hashed key of the first slot past
+// the range is returned if there is one.
+//
+// NOTE: the slots are stored by key, so the whole storage of the account is
+// iterated to select the range, keeping at most limit+1 slots in memory.
+func (k *Keeper) storageRange(ctx sdk.Context, addr common.Address, start common.Hash, limit int) ([]hashedStorageEntry, *common.Hash) {
+	selected := make(hashedStorageHeap, 0, limit+1)
+	k.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+		entry := hashedStorageEntry{hash: crypto.Keccak256Hash(key.Bytes()), key: key, value: value}
+		switch {
+		case bytes.Compare(entry.hash.Bytes(), start.Bytes()) < 0:
+		case selected.Len() <= limit:
+			heap.Push(&selected, entry)
+		case bytes.Compare(entry.hash.Bytes(), selected[0].hash.Bytes()) < 0:
+			// replace the greatest selected slot
+			selected[0] = entry
+			heap.Fix(&selected, 0)
+		}
+		return true
+	})
+
+	sort.Slice(selected, func(i, j int) bool {
+		return bytes.Compare(selected[i].hash.Bytes(), selected[j].hash.Bytes()) < 0
+	})
+	if len(selected) > limit {
+		next := selected[limit].hash
+		return selected[:limit], &next
+	}
+	return selected, nil
+}
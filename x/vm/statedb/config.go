@@ -44,4 +44,8 @@ type EVMConfig struct {
 	CoinBase                common.Address
 	BaseFee                 *big.Int
 	EnablePreimageRecording bool
+	// Overrides and BlockOverrides are only set on simulated executions
+	// (e.g. eth_call) and must never be used on state-changing ones.
+	Overrides      types.StateOverride
+	BlockOverrides *types.BlockOverrides
 }
@@ -0,0 +1,47 @@
+package statedb
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+// ApplyStateOverride overrides the accounts of the StateDB with the given
+// overrides. The changes are only kept in memory, so the StateDB must not be
+// committed to the underlying store afterwards.
+func (s *StateDB) ApplyStateOverride(overrides types.StateOverride) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+
+	for addr, account := range overrides {
+		// Override account nonce.
+		if account.Nonce != nil {
+			s.SetNonce(addr, uint64(*account.Nonce), tracing.NonceChangeUnspecified)
+		}
+		// Override account(contract) code.
+		if account.Code != nil {
+			s.SetCode(addr, *account.Code)
+		}
+		// Override account balance.
+		if account.Balance != nil {
+			balance, overflow := uint256.FromBig(account.Balance.ToInt())
+			if overflow {
+				return fmt.Errorf("account %s balance override overflows uint256", addr.Hex())
+			}
+			s.SetBalance(addr, balance)
+		}
+		// Replace entire state if caller requires.
+		if account.State != nil {
+			s.SetStorage(addr, account.State)
+		}
+		// Apply state diff into specified accounts.
+		for key, value := range account.StateDiff {
+			s.SetState(addr, key, value)
+		}
+	}
+	return nil
+}
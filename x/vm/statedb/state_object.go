@@ -73,6 +73,9 @@ type stateObject struct {
 	dirtyCode      bool
 	selfDestructed bool
 	newContract    bool
+	// storageReset is set when the whole storage was replaced (e.g. by a state
+	// override), the committed storage is ignored afterwards.
+	storageReset bool
 }
 
 // newObject creates a state object.
@@ -231,6 +234,9 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.storageReset {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
@@ -266,3 +272,15 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) common.Hash {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// SetStorage replaces the entire storage of the account with the given one.
+// After this call the committed storage is ignored and lookups only happen on
+// the given storage.
+func (s *stateObject) SetStorage(storage Storage) {
+	s.storageReset = true
+	s.originStorage = make(Storage)
+	s.dirtyStorage = make(Storage)
+	for _, key := range storage.SortedKeys() {
+		s.SetState(key, storage[key])
+	}
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
-	"github.com/ethereum/go-ethereum/trie"
 	"github.com/ethereum/go-ethereum/trie/utils"
 	"github.com/holiman/uint256"
 
@@ -79,6 +78,10 @@ type StateDB struct {
 
 	// The count of calls to precompiles
 	precompileCallsCounter uint8
+
+	// SHA3 preimages seen by the VM, only recorded when the EnablePreimageRecording
+	// flag is enabled on the vm.Config
+	preimages map[common.Hash][]byte
 }
 
 func (s *StateDB) CreateContract(address common.Address) {
@@ -93,9 +96,9 @@ func (s *StateDB) CreateContract(address common.Address) {
 
 // GetStorageRoot calculates the hash of the trie root by iterating through all storage objects for a given account
 func (s *StateDB) GetStorageRoot(addr common.Address) common.Hash {
-	sr := trie.NewStackTrie(nil)
+	sr := types.NewStorageTrie()
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
-		if err := sr.Update(key.Bytes(), value.Bytes()); err != nil {
+		if err := sr.Update(key, value); err != nil {
 			s.ctx.Logger().Error("failed adding state during storage root hash", "err", err.Error())
 			return false
 		}
@@ -144,6 +147,7 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 		accessList:       newAccessList(),
 		transientStorage: newTransientStorage(),
 		txConfig:         txConfig,
+		preimages:        make(map[common.Hash][]byte),
 	}
 }
 
@@ -152,6 +156,20 @@ func (s *StateDB) Keeper() Keeper {
 	return s.keeper
 }
 
+// TxConfig returns the configuration of the current transaction.
+func (s *StateDB) TxConfig() TxConfig {
+	return s.txConfig
+}
+
+// SetTxContext prepares the StateDB for the execution of the next transaction
+// on top of the pending state changes, e.g. when executing several messages
+// with the same StateDB. It resets the per-transaction refund counter and logs.
+func (s *StateDB) SetTxContext(txConfig TxConfig) {
+	s.txConfig = txConfig
+	s.refund = 0
+	s.logs = nil
+}
+
 // GetContext returns the transaction Context.
 func (s *StateDB) GetContext() sdk.Context {
 	return s.ctx
@@ -328,11 +346,18 @@ func (s *StateDB) GetRefund() uint64 {
 	return s.refund
 }
 
-// AddPreimage records a SHA3 preimage seen by the VM.
-// AddPreimage performs a no-op since the EnablePreimageRecording flag is disabled
-// on the vm.Config during state transitions. No store trie preimages are written
-// to the database.
-func (s *StateDB) AddPreimage(_ common.Hash, _ []byte) {}
+// AddPreimage records a SHA3 preimage seen by the VM. The preimages are not
+// journaled: a preimage remains valid even if the call that computed it reverts.
+func (s *StateDB) AddPreimage(hash common.Hash, preimage []byte) {
+	if _, ok := s.preimages[hash]; !ok {
+		s.preimages[hash] = common.CopyBytes(preimage)
+	}
+}
+
+// Preimages returns the SHA3 preimages recorded by the VM.
+func (s *StateDB) Preimages() map[common.Hash][]byte {
+	return s.preimages
+}
 
 // getStateObject retrieves a state object given by the address, returning nil if
 // the object is not found.
@@ -402,6 +427,14 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
+	if so.storageReset {
+		for _, key := range so.dirtyStorage.SortedKeys() {
+			if !cb(key, so.dirtyStorage[key]) {
+				break
+			}
+		}
+		return nil
+	}
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
@@ -459,6 +492,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *uint256.Int, reason tr
 	return stateObject.SubBalance(amount)
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *uint256.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64, reason tracing.NonceChangeReason) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -486,6 +527,14 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) common.H
 	return common.Hash{}
 }
 
+// SetStorage replaces the entire storage of the account with the given one.
+// It should only be used for debugging and simulations (e.g. state overrides).
+func (s *StateDB) SetStorage(addr common.Address, storage Storage) {
+	if stateObject := s.getOrNewStateObject(addr); stateObject != nil {
+		stateObject.SetStorage(storage)
+	}
+}
+
 // SelfDestruct marks the given account as self-destructed.
 // This clears the account balance.
 //
@@ -700,6 +749,10 @@ func (s *StateDB) commitWithCtx(ctx sdk.Context) error {
 				return errorsmod.Wrap(err, "failed to set account")
 			}
 
+			if obj.storageReset {
+				s.clearStorage(ctx, obj.Address())
+			}
+
 			for _, key := range obj.dirtyStorage.SortedKeys() {
 				valueBytes := obj.dirtyStorage[key].Bytes()
 				if len(valueBytes) == 0 {
@@ -712,3 +765,15 @@ func (s *StateDB) commitWithCtx(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// clearStorage deletes all the committed storage of the given account.
+func (s *StateDB) clearStorage(ctx sdk.Context, addr common.Address) {
+	var keys []common.Hash
+	s.keeper.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+		keys = append(keys, key)
+		return true
+	})
+	for _, key := range keys {
+		s.keeper.DeleteState(ctx, addr, key)
+	}
+}
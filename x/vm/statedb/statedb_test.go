@@ -655,6 +655,21 @@ func (suite *StateDBTestSuite) TestRefund() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestPreimages() {
+	db := statedb.New(sdk.Context{}, mocks.NewEVMKeeper(), emptyTxConfig)
+	preimage := []byte("preimage")
+	hash := crypto.Keccak256Hash(preimage)
+
+	snapshot := db.Snapshot()
+	db.AddPreimage(hash, preimage)
+	// the preimage is copied
+	preimage[0] = 'x'
+	// and survives a revert
+	db.RevertToSnapshot(snapshot)
+
+	suite.Require().Equal(map[common.Hash][]byte{hash: []byte("preimage")}, db.Preimages())
+}
+
 func (suite *StateDBTestSuite) TestIterateStorage() {
 	ctx := sdk.Context{}
 
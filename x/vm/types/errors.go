@@ -7,7 +7,10 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	errorsmod "cosmossdk.io/errors"
 )
@@ -127,6 +130,21 @@ func NewExecErrorWithReason(revertReason []byte) *RevertError {
 	}
 }
 
+// HandleRevertError returns the error of a failed EVM execution, decoding the
+// revert reason from the return bytes when the execution was reverted.
+func HandleRevertError(vmError string, ret []byte) error {
+	if len(vmError) > 0 {
+		if vmError != vm.ErrExecutionReverted.Error() {
+			return status.Error(codes.Internal, vmError)
+		}
+		if len(ret) == 0 {
+			return errors.New(vmError)
+		}
+		return NewExecErrorWithReason(ret)
+	}
+	return nil
+}
+
 // RevertError is an API error that encompass an EVM revert with JSON error
 // code and a binary data blob.
 type RevertError struct {
@@ -49,3 +49,31 @@ func TestNewExecErrorWithReason(t *testing.T) {
 		require.Equal(t, 3, errWithReason.ErrorCode())
 	}
 }
+
+func TestHandleRevertError(t *testing.T) {
+	revertReason, err := types.RevertReasonBytes("COUNTER_TOO_LOW")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		vmError  string
+		ret      []byte
+		expError string
+	}{
+		{"no error", "", nil, ""},
+		{"non revert error", "out of gas", nil, "out of gas"},
+		{"revert without reason", "execution reverted", nil, "execution reverted"},
+		{"revert with reason", "execution reverted", revertReason, "execution reverted: COUNTER_TOO_LOW"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.HandleRevertError(tc.vmError, tc.ret)
+			if tc.expError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expError)
+		})
+	}
+}
@@ -924,6 +924,64 @@ func (m *Preinstall) GetCode() string {
 	return ""
 }
 
+// ForkActivation defines the activation time of a timestamp based Ethereum
+// hard fork, scheduled through governance. It overrides the activation time of
+// the fork in the chain config.
+type ForkActivation struct {
+	// fork is the name of the hard fork (e.g. "osaka")
+	Fork string `protobuf:"bytes,1,opt,name=fork,proto3" json:"fork,omitempty"`
+	// activation_time is the unix timestamp, in seconds, of the first block on
+	// which the fork is active
+	ActivationTime uint64 `protobuf:"varint,2,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`
+}
+
+func (m *ForkActivation) Reset()         { *m = ForkActivation{} }
+func (m *ForkActivation) String() string { return proto.CompactTextString(m) }
+func (*ForkActivation) ProtoMessage()    {}
+func (*ForkActivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{11}
+}
+func (m *ForkActivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkActivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkActivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkActivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkActivation.Merge(m, src)
+}
+func (m *ForkActivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkActivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkActivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkActivation proto.InternalMessageInfo
+
+func (m *ForkActivation) GetFork() string {
+	if m != nil {
+		return m.Fork
+	}
+	return ""
+}
+
+func (m *ForkActivation) GetActivationTime() uint64 {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.evm.vm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.vm.v1.Params")
@@ -937,137 +995,140 @@ func init() {
 	proto.RegisterType((*AccessTuple)(nil), "cosmos.evm.vm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "cosmos.evm.vm.v1.TraceConfig")
 	proto.RegisterType((*Preinstall)(nil), "cosmos.evm.vm.v1.Preinstall")
+	proto.RegisterType((*ForkActivation)(nil), "cosmos.evm.vm.v1.ForkActivation")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 1988 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x5d, 0x6f, 0x1b, 0xc7,
-	0xd5, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xc9, 0x34, 0xed, 0x68, 0xf9, 0xee,
-	0xdb, 0x0b, 0xd5, 0x48, 0x25, 0x4b, 0x8e, 0x5a, 0xc3, 0xe9, 0x07, 0x44, 0x99, 0x69, 0xa5, 0xda,
-	0x0e, 0x31, 0x54, 0x12, 0xa4, 0x68, 0xb1, 0x18, 0xee, 0x8e, 0x97, 0x1b, 0xee, 0xee, 0x10, 0x3b,
-	0x4b, 0x86, 0xea, 0x2f, 0x08, 0x7c, 0x95, 0xfe, 0x00, 0x03, 0x01, 0x7a, 0x93, 0x4b, 0xff, 0x84,
-	0x5e, 0x06, 0xbd, 0xca, 0x65, 0x51, 0xa0, 0x8b, 0x82, 0xbe, 0x08, 0xa0, 0x4b, 0xfd, 0x82, 0x62,
-	0x3e, 0xf8, 0x29, 0x85, 0x55, 0x01, 0xc1, 0x9e, 0xe7, 0xcc, 0x39, 0xcf, 0x73, 0x66, 0xe6, 0xec,
-	0xec, 0x59, 0x82, 0x8a, 0x43, 0x59, 0x48, 0xd9, 0x3e, 0xe9, 0x87, 0xfb, 0xfc, 0xef, 0x80, 0x8f,
-	0xf6, 0xba, 0x31, 0x4d, 0x28, 0x34, 0xe4, 0xdc, 0x1e, 0xb7, 0xf0, 0xbf, 0x83, 0xca, 0x1d, 0x1c,
-	0xfa, 0x11, 0xdd, 0x17, 0xff, 0x4a, 0xa7, 0x4a, 0xc9, 0xa3, 0x1e, 0x15, 0xc3, 0x7d, 0x3e, 0x92,
-	0x56, 0xeb, 0x6d, 0x16, 0xac, 0x36, 0x70, 0x8c, 0x43, 0x06, 0x0f, 0x40, 0x8e, 0xf4, 0x43, 0xdb,
-	0x25, 0x11, 0x0d, 0xcb, 0x99, 0x6a, 0x66, 0x37, 0x57, 0x2b, 0x5d, 0xa5, 0xa6, 0x71, 0x81, 0xc3,
-	0xe0, 0xa9, 0x35, 0x9e, 0xb2, 0x90, 0x4e, 0xfa, 0xe1, 0x33, 0x3e, 0x84, 0xc7, 0x00, 0x90, 0x41,
-	0x12, 0x63, 0x9b, 0xf8, 0x5d, 0x56, 0xd6, 0xaa, 0xd9, 0xdd, 0x6c, 0xcd, 0x1a, 0xa6, 0x66, 0xae,
-	0xce, 0xad, 0xf5, 0xd3, 0x06, 0xbb, 0x4a, 0xcd, 0x3b, 0x8a, 0x60, 0xec, 0x68, 0xa1, 0x9c, 0x00,
-	0x75, 0xbf, 0xcb, 0xe0, 0x21, 0xd8, 0xc2, 0x41, 0x40, 0xbf, 0xb4, 0x7b, 0x11, 0xcf, 0x88, 0x38,
-	0x09, 0x71, 0xed, 0x64, 0xc0, 0xca, 0x2b, 0xd5, 0xcc, 0xae, 0x8e, 0x36, 0xc5, 0xe4, 0x27, 0x93,
-	0xb9, 0xf3, 0x01, 0x8f, 0x29, 0xf0, 0x74, 0x9c, 0x36, 0x8e, 0x22, 0x12, 0xb0, 0xf2, 0x5a, 0x35,
-	0xbb, 0x9b, 0xab, 0x6d, 0x0c, 0x53, 0x33, 0x5f, 0xff, 0xf4, 0xc5, 0x89, 0x32, 0xa3, 0x3c, 0xe9,
-	0x87, 0x23, 0x00, 0xff, 0x04, 0x8a, 0xd8, 0x71, 0x08, 0x63, 0xb6, 0x43, 0xa3, 0x24, 0xa6, 0x41,
-	0x59, 0xaf, 0x66, 0x76, 0xf3, 0x87, 0xe6, 0xde, 0xfc, 0xe6, 0xed, 0x1d, 0x0b, 0xbf, 0x13, 0xe9,
-	0x56, 0xdb, 0xfa, 0x2e, 0x35, 0x97, 0x86, 0xa9, 0xb9, 0x3e, 0x63, 0x46, 0xeb, 0x78, 0x1a, 0xc2,
-	0xa7, 0xe0, 0x1e, 0x76, 0x12, 0xbf, 0x4f, 0x6c, 0x96, 0xe0, 0xc4, 0x77, 0xec, 0x6e, 0x4c, 0x1c,
-	0x1a, 0x76, 0xfd, 0x80, 0xb0, 0x72, 0x8e, 0xe7, 0x87, 0xee, 0x4a, 0x87, 0xa6, 0x98, 0x6f, 0x4c,
-	0xa6, 0x9f, 0xde, 0x7f, 0xfd, 0xc3, 0xdb, 0x87, 0xdb, 0x53, 0xe7, 0x3b, 0xe0, 0x27, 0x2c, 0x4f,
-	0xe5, 0x4c, 0xd3, 0x97, 0x8d, 0xec, 0x99, 0xa6, 0x67, 0x0d, 0xed, 0x4c, 0xd3, 0x57, 0x8d, 0x35,
-	0xeb, 0x2f, 0x19, 0x30, 0x9b, 0x0b, 0x3c, 0x06, 0xab, 0x4e, 0x4c, 0x70, 0x42, 0xc4, 0xb1, 0xe5,
-	0x0f, 0xff, 0xff, 0xbf, 0xac, 0xe9, 0xfc, 0xa2, 0x4b, 0x6a, 0x1a, 0x5f, 0x17, 0x52, 0x81, 0xf0,
-	0x57, 0x40, 0x73, 0x70, 0x10, 0x94, 0x97, 0xff, 0x57, 0x02, 0x11, 0x66, 0xfd, 0x2b, 0x03, 0xee,
-	0x5c, 0xf3, 0x80, 0x0e, 0xc8, 0xab, 0x3d, 0x4f, 0x2e, 0xba, 0x32, 0xb9, 0xe2, 0xe1, 0x83, 0x1f,
-	0xe3, 0x16, 0xa4, 0x3f, 0x19, 0xa6, 0x26, 0x98, 0xe0, 0xab, 0xd4, 0x84, 0xb2, 0x7c, 0xa6, 0x88,
-	0x2c, 0x04, 0xf0, 0xd8, 0x03, 0x3a, 0x60, 0x73, 0xf6, 0x60, 0xed, 0xc0, 0x67, 0x49, 0x79, 0x59,
-	0xd4, 0xc4, 0xe3, 0x61, 0x6a, 0xce, 0x26, 0xf6, 0xdc, 0x67, 0xc9, 0x55, 0x6a, 0x56, 0x66, 0x58,
-	0xa7, 0x23, 0x2d, 0x74, 0x07, 0xcf, 0x07, 0x58, 0xdf, 0x1a, 0x20, 0x7f, 0xd2, 0xc6, 0x7e, 0x74,
-	0x42, 0xa3, 0x57, 0xbe, 0x07, 0xff, 0x08, 0x36, 0xda, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x5b,
-	0x01, 0x75, 0x3a, 0xea, 0x89, 0x79, 0xfc, 0xcf, 0xd4, 0xdc, 0x92, 0x0b, 0x64, 0x6e, 0x67, 0xcf,
-	0xa7, 0xfb, 0x21, 0x4e, 0xda, 0x7b, 0xa7, 0x11, 0x17, 0xdd, 0x96, 0xa2, 0x73, 0x91, 0x16, 0x2a,
-	0x8e, 0x2d, 0x35, 0x6e, 0x80, 0x6d, 0x50, 0x74, 0x31, 0xb5, 0x5f, 0xd1, 0xb8, 0xa3, 0xc8, 0x97,
-	0x05, 0x79, 0xed, 0x47, 0xc9, 0x87, 0xa9, 0x59, 0x78, 0x76, 0xfc, 0xf1, 0x47, 0x34, 0xee, 0x08,
-	0x8a, 0xab, 0xd4, 0xdc, 0x92, 0x62, 0xb3, 0x44, 0x16, 0x2a, 0xb8, 0x98, 0x8e, 0xdd, 0xe0, 0x67,
-	0xc0, 0x18, 0x3b, 0xb0, 0x5e, 0xb7, 0x4b, 0xe3, 0xa4, 0x9c, 0xe5, 0x0f, 0x5e, 0xed, 0x67, 0xc3,
-	0xd4, 0x2c, 0x2a, 0xca, 0xa6, 0x9c, 0xb9, 0x4a, 0xcd, 0xbb, 0x73, 0xa4, 0x2a, 0xc6, 0x42, 0x45,
-	0x45, 0xab, 0x5c, 0x61, 0x0b, 0x14, 0x88, 0xdf, 0x3d, 0x38, 0x7a, 0xa4, 0x16, 0xa0, 0x89, 0x05,
-	0xfc, 0x66, 0xd1, 0x02, 0xf2, 0xf5, 0xd3, 0xc6, 0xc1, 0xd1, 0xa3, 0x51, 0xfe, 0x9b, 0xea, 0xda,
-	0x98, 0x62, 0xb1, 0x50, 0x5e, 0x42, 0x99, 0xfc, 0x48, 0xe3, 0x48, 0x69, 0xac, 0xde, 0x56, 0xe3,
-	0xe8, 0x26, 0x8d, 0xa3, 0x59, 0x8d, 0xa3, 0x59, 0x8d, 0x27, 0x4a, 0x63, 0xed, 0xb6, 0x1a, 0x4f,
-	0x6e, 0xd2, 0x78, 0x32, 0xab, 0x21, 0x7d, 0x78, 0x31, 0xb5, 0x2e, 0xfe, 0x8c, 0xa3, 0xc4, 0xef,
-	0x85, 0x4a, 0x46, 0xbf, 0x75, 0x31, 0xcd, 0x45, 0x5a, 0xa8, 0x38, 0xb6, 0x48, 0xf6, 0x0e, 0x28,
-	0x39, 0x34, 0x62, 0x09, 0xb7, 0x45, 0xb4, 0x1b, 0x10, 0x25, 0x91, 0x13, 0x12, 0x4f, 0x16, 0x49,
-	0xdc, 0x97, 0x12, 0x37, 0x85, 0x5b, 0x68, 0x73, 0xd6, 0x2c, 0xc5, 0x6c, 0x60, 0x74, 0x49, 0x42,
-	0x62, 0xd6, 0xea, 0xc5, 0x9e, 0x12, 0x02, 0x42, 0xe8, 0x83, 0x45, 0x42, 0xaa, 0xac, 0xe6, 0x43,
-	0x2d, 0xb4, 0x31, 0x31, 0x49, 0x81, 0xcf, 0x41, 0xd1, 0xe7, 0xaa, 0xad, 0x5e, 0xa0, 0xe8, 0xf3,
-	0x82, 0xfe, 0x70, 0x11, 0xbd, 0x7a, 0x14, 0x66, 0x03, 0x2d, 0xb4, 0x3e, 0x32, 0x48, 0x6a, 0x17,
-	0xc0, 0xb0, 0xe7, 0xc7, 0xb6, 0x17, 0x60, 0xc7, 0x27, 0xb1, 0xa2, 0x2f, 0x08, 0xfa, 0x9f, 0x2f,
-	0xa2, 0xbf, 0x27, 0xe9, 0xaf, 0x07, 0x5b, 0xc8, 0xe0, 0xc6, 0xdf, 0x4a, 0x9b, 0x54, 0x69, 0x82,
-	0x42, 0x8b, 0xc4, 0x81, 0x1f, 0x29, 0xfe, 0x75, 0xc1, 0xff, 0x68, 0x11, 0xbf, 0xaa, 0xa0, 0xe9,
-	0x30, 0x0b, 0xe5, 0x25, 0x1c, 0x93, 0x06, 0x34, 0x72, 0xe9, 0x88, 0xf4, 0xce, 0xad, 0x49, 0xa7,
-	0xc3, 0x2c, 0x94, 0x97, 0x50, 0x92, 0x7a, 0x60, 0x13, 0xc7, 0x31, 0xfd, 0x72, 0x6e, 0x43, 0xa0,
-	0xe0, 0xfe, 0xc5, 0x22, 0xee, 0xd1, 0xe5, 0x7a, 0x3d, 0x9a, 0x5f, 0xae, 0xdc, 0x3a, 0xb3, 0x25,
-	0x2e, 0x80, 0x5e, 0x8c, 0x2f, 0xe6, 0x74, 0x4a, 0xb7, 0xde, 0xf8, 0xeb, 0xc1, 0x16, 0x32, 0xb8,
-	0x71, 0x46, 0xe5, 0x0b, 0x50, 0x0a, 0x49, 0xec, 0x11, 0x3b, 0x22, 0x09, 0xeb, 0x06, 0x7e, 0xa2,
-	0x74, 0xb6, 0x6e, 0xfd, 0x1c, 0xdc, 0x14, 0x6e, 0x21, 0x28, 0xcc, 0x2f, 0x95, 0x55, 0x6a, 0xdd,
-	0x03, 0xba, 0xc3, 0xdf, 0x16, 0xb6, 0xef, 0x96, 0xcb, 0xd5, 0xcc, 0xae, 0x86, 0xd6, 0x04, 0x3e,
-	0x75, 0x61, 0x09, 0xac, 0xc8, 0x0e, 0xeb, 0x1e, 0xd7, 0x45, 0x12, 0xc0, 0x0a, 0xd0, 0x5d, 0xe2,
-	0xf8, 0x21, 0x0e, 0x58, 0xb9, 0x22, 0x02, 0xc6, 0x18, 0x7e, 0x0a, 0xd6, 0x59, 0x1b, 0x47, 0x5e,
-	0x1b, 0xfb, 0x76, 0xe2, 0x87, 0xa4, 0x7c, 0x5f, 0x64, 0x7c, 0xb0, 0x28, 0xe3, 0x92, 0xcc, 0x78,
-	0x26, 0xce, 0x42, 0x85, 0x11, 0x3e, 0xf7, 0x43, 0x02, 0x1b, 0x20, 0xef, 0xe0, 0xc8, 0xe9, 0x45,
-	0x92, 0xf5, 0x81, 0x60, 0xdd, 0x5f, 0xc4, 0xaa, 0x5e, 0xc5, 0x53, 0x51, 0x16, 0x02, 0x12, 0x8d,
-	0x18, 0xbb, 0x31, 0xf6, 0x7a, 0x44, 0x32, 0xbe, 0x77, 0x6b, 0xc6, 0xa9, 0x28, 0x0b, 0x01, 0x89,
-	0x46, 0x8c, 0x7d, 0x12, 0x77, 0x02, 0xc5, 0xb8, 0x73, 0x6b, 0xc6, 0xa9, 0x28, 0x0b, 0x01, 0x89,
-	0x04, 0xe3, 0x0b, 0x00, 0x28, 0xc3, 0x1d, 0x2c, 0x09, 0x4d, 0x41, 0xb8, 0xb7, 0x88, 0x50, 0xb5,
-	0xaf, 0x93, 0x20, 0x0b, 0xe5, 0x04, 0xe0, 0x74, 0x67, 0x9a, 0xbe, 0x62, 0xac, 0x9e, 0x69, 0xfa,
-	0xb6, 0x71, 0xf7, 0x4c, 0xd3, 0xef, 0x1a, 0x65, 0x6b, 0x1f, 0xac, 0xf0, 0x16, 0x8f, 0x40, 0x03,
-	0x64, 0x3b, 0xe4, 0x42, 0xf6, 0x05, 0x88, 0x0f, 0xf9, 0xd9, 0xf7, 0x71, 0xd0, 0x23, 0xf2, 0x75,
-	0x8e, 0x24, 0xb0, 0x1a, 0x60, 0xe3, 0x3c, 0xc6, 0x11, 0xe3, 0xed, 0x21, 0x8d, 0x9e, 0x53, 0x8f,
-	0x41, 0x08, 0xb4, 0x36, 0x66, 0x6d, 0x15, 0x2b, 0xc6, 0xf0, 0xa7, 0x40, 0x0b, 0xa8, 0xc7, 0x44,
-	0x63, 0x93, 0x3f, 0xdc, 0xba, 0xde, 0x45, 0x3d, 0xa7, 0x1e, 0x12, 0x2e, 0xd6, 0xdf, 0x97, 0x41,
-	0xf6, 0x39, 0xf5, 0x60, 0x19, 0xac, 0x61, 0xd7, 0x8d, 0x09, 0x63, 0x8a, 0x69, 0x04, 0xe1, 0x36,
-	0x58, 0x4d, 0x68, 0xd7, 0x77, 0x24, 0x5d, 0x0e, 0x29, 0xc4, 0x85, 0x5d, 0x9c, 0x60, 0xd1, 0x03,
-	0x14, 0x90, 0x18, 0xf3, 0x6e, 0x5b, 0x94, 0xba, 0x1d, 0xf5, 0xc2, 0x16, 0x89, 0xc5, 0xab, 0x5c,
-	0xab, 0x6d, 0x5c, 0xa6, 0x66, 0x5e, 0xd8, 0x5f, 0x0a, 0x33, 0x9a, 0x06, 0xf0, 0x7d, 0xb0, 0x96,
-	0x0c, 0x6c, 0xb1, 0x86, 0x15, 0xb1, 0xc5, 0x9b, 0x97, 0xa9, 0xb9, 0x91, 0x4c, 0x96, 0xf9, 0x3b,
-	0xcc, 0xda, 0x68, 0x35, 0x19, 0xf0, 0xff, 0xe1, 0x3e, 0xd0, 0x93, 0x81, 0xed, 0x47, 0x2e, 0x19,
-	0x88, 0x97, 0xb8, 0x56, 0x2b, 0x5d, 0xa6, 0xa6, 0x31, 0xe5, 0x7e, 0xca, 0xe7, 0xd0, 0x5a, 0x32,
-	0x10, 0x03, 0xf8, 0x3e, 0x00, 0x32, 0x25, 0xa1, 0x20, 0xdf, 0xc9, 0xeb, 0x97, 0xa9, 0x99, 0x13,
-	0x56, 0xc1, 0x3d, 0x19, 0x42, 0x0b, 0xac, 0x48, 0x6e, 0x5d, 0x70, 0x17, 0x2e, 0x53, 0x53, 0x0f,
-	0xa8, 0x27, 0x39, 0xe5, 0x14, 0xdf, 0xaa, 0x98, 0x84, 0xb4, 0x4f, 0x5c, 0xf1, 0x62, 0xd4, 0xd1,
-	0x08, 0x5a, 0x5f, 0x2f, 0x03, 0xfd, 0x7c, 0x80, 0x08, 0xeb, 0x05, 0x09, 0xfc, 0x08, 0x18, 0xa2,
-	0x57, 0xc4, 0x4e, 0x62, 0xcf, 0x6c, 0x6d, 0xed, 0xfe, 0xe4, 0x35, 0x36, 0xef, 0x61, 0xa1, 0x8d,
-	0x91, 0xe9, 0x58, 0xed, 0x7f, 0x09, 0xac, 0xb4, 0x02, 0x4a, 0x43, 0x51, 0x09, 0x05, 0x24, 0x01,
-	0xfc, 0x4c, 0xec, 0x9a, 0x38, 0xe5, 0xac, 0xe8, 0xc3, 0xff, 0xef, 0xfa, 0x29, 0xcf, 0x95, 0x4a,
-	0xed, 0x3e, 0xef, 0xc2, 0xaf, 0x52, 0xb3, 0x28, 0xb5, 0x55, 0xbc, 0xf5, 0xed, 0x0f, 0x6f, 0x1f,
-	0x66, 0xf8, 0x06, 0x8b, 0x7a, 0x32, 0x40, 0x36, 0x26, 0x89, 0x38, 0xb9, 0x02, 0xe2, 0x43, 0x7e,
-	0xe1, 0xc4, 0xa4, 0x4f, 0xe2, 0x84, 0xb8, 0xea, 0x4b, 0x6b, 0x8c, 0xf9, 0xed, 0xe5, 0x61, 0x66,
-	0xf7, 0x18, 0x71, 0xe5, 0x71, 0xa0, 0x35, 0x0f, 0xb3, 0x4f, 0x18, 0x71, 0x9f, 0x6a, 0x5f, 0x7d,
-	0x63, 0x2e, 0x59, 0x18, 0xe4, 0x55, 0x8b, 0xde, 0xeb, 0x06, 0x64, 0x41, 0x99, 0x1d, 0x82, 0x02,
-	0x4b, 0x68, 0x8c, 0x3d, 0x62, 0x77, 0xc8, 0x85, 0x2a, 0x36, 0x59, 0x3a, 0xca, 0xfe, 0x7b, 0x72,
-	0xc1, 0xd0, 0x34, 0x50, 0x12, 0xdf, 0x68, 0x20, 0x7f, 0x1e, 0x63, 0x87, 0xa8, 0x86, 0x9b, 0x17,
-	0x2c, 0x87, 0xb1, 0x92, 0x50, 0x88, 0x6b, 0xf3, 0x67, 0x92, 0xf6, 0x12, 0xf5, 0x50, 0x8d, 0x20,
-	0x8f, 0x88, 0x09, 0x19, 0x10, 0x47, 0xec, 0xa5, 0x86, 0x14, 0x82, 0x47, 0x60, 0xdd, 0xf5, 0x19,
-	0x6e, 0x05, 0xe2, 0x53, 0xcd, 0xe9, 0xc8, 0xe5, 0xd7, 0x8c, 0xcb, 0xd4, 0x2c, 0xa8, 0x89, 0x26,
-	0xb7, 0xa3, 0x19, 0x04, 0x3f, 0x04, 0x1b, 0x93, 0x30, 0x91, 0xad, 0xd8, 0x1b, 0xbd, 0x06, 0x2f,
-	0x53, 0xb3, 0x38, 0x76, 0x15, 0x33, 0x68, 0x0e, 0xcb, 0x4b, 0xbf, 0xd5, 0xf3, 0x44, 0x05, 0xea,
-	0x48, 0x02, 0x6e, 0x0d, 0xfc, 0xd0, 0x4f, 0x44, 0xc5, 0xad, 0x20, 0x09, 0xe0, 0x87, 0x20, 0x47,
-	0xfb, 0x24, 0x8e, 0x7d, 0x97, 0x30, 0xd1, 0x3b, 0xe5, 0x0f, 0xdf, 0xbb, 0x5e, 0x06, 0x53, 0x1f,
-	0x23, 0x68, 0xe2, 0xcf, 0x17, 0x47, 0x22, 0x91, 0x64, 0x48, 0x42, 0x1a, 0x5f, 0x88, 0xee, 0x48,
-	0x2d, 0x4e, 0x4e, 0xbc, 0x10, 0x76, 0x34, 0x83, 0x60, 0x0d, 0x40, 0x15, 0x16, 0x93, 0xa4, 0x17,
-	0x47, 0xb6, 0xb8, 0x04, 0x0a, 0x22, 0x56, 0x3c, 0x8a, 0x72, 0x16, 0x89, 0xc9, 0x67, 0x38, 0xc1,
-	0xe8, 0x9a, 0x05, 0xfe, 0x1a, 0x40, 0x79, 0x26, 0xf6, 0x17, 0x8c, 0x46, 0xfc, 0x93, 0xea, 0x95,
-	0xef, 0xa9, 0xf6, 0x46, 0xe8, 0xcb, 0x59, 0x95, 0xb3, 0x21, 0xd1, 0x19, 0xa3, 0x6a, 0x15, 0x67,
-	0x9a, 0xae, 0x19, 0x2b, 0x67, 0x9a, 0xbe, 0x66, 0xe8, 0xe3, 0xfd, 0x53, 0xab, 0x40, 0x9b, 0x23,
-	0x3c, 0x95, 0x9e, 0xf5, 0x12, 0x80, 0x46, 0x4c, 0x7c, 0xde, 0x84, 0x06, 0x01, 0xbf, 0xb9, 0x22,
-	0x1c, 0x92, 0xd1, 0x95, 0xc9, 0xc7, 0xd3, 0x85, 0xb9, 0x3c, 0x5b, 0x98, 0x10, 0x68, 0x0e, 0x75,
-	0x89, 0x28, 0x8d, 0x1c, 0x12, 0xe3, 0x87, 0x7f, 0xcb, 0x80, 0xa9, 0x2f, 0x4f, 0xf8, 0x4b, 0x50,
-	0x39, 0x3e, 0x39, 0xa9, 0x37, 0x9b, 0xf6, 0xf9, 0xe7, 0x8d, 0xba, 0xdd, 0xa8, 0xa3, 0x17, 0xa7,
-	0xcd, 0xe6, 0xe9, 0xc7, 0x2f, 0x9f, 0xd7, 0x9b, 0x4d, 0x63, 0xa9, 0xf2, 0xe0, 0xf5, 0x9b, 0x6a,
-	0x79, 0xe2, 0xdf, 0x20, 0x71, 0xe8, 0x33, 0xe6, 0xd3, 0x28, 0xe0, 0x02, 0x1f, 0x80, 0xed, 0xe9,
-	0x68, 0x54, 0x6f, 0x9e, 0xa3, 0xd3, 0x93, 0xf3, 0xfa, 0x33, 0x23, 0x53, 0x29, 0xbf, 0x7e, 0x53,
-	0x2d, 0x4d, 0x22, 0x11, 0x61, 0x49, 0xec, 0x3b, 0xfc, 0xc9, 0x7b, 0x02, 0xca, 0x37, 0x6b, 0xd6,
-	0x9f, 0x19, 0xcb, 0x95, 0xca, 0xeb, 0x37, 0xd5, 0xed, 0x9b, 0x14, 0x89, 0x5b, 0xd1, 0xbe, 0xfa,
-	0xeb, 0xce, 0x52, 0xed, 0xe9, 0x77, 0xc3, 0x9d, 0xcc, 0xf7, 0xc3, 0x9d, 0xcc, 0xbf, 0x87, 0x3b,
-	0x99, 0xaf, 0xdf, 0xed, 0x2c, 0x7d, 0xff, 0x6e, 0x67, 0xe9, 0x1f, 0xef, 0x76, 0x96, 0xfe, 0x50,
-	0xf5, 0xfc, 0xa4, 0xdd, 0x6b, 0xed, 0x39, 0x34, 0xdc, 0x9f, 0xff, 0xa5, 0x81, 0x7f, 0x53, 0xb3,
-	0xd6, 0xaa, 0xf8, 0x41, 0xe8, 0xf1, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x92, 0xb6, 0x78, 0x1a,
-	0x69, 0x12, 0x00, 0x00,
+	// 2023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xdd, 0x6e, 0x1b, 0xc7,
+	0x15, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xc9, 0x34, 0x9d, 0x68, 0xd9, 0x6d,
+	0x81, 0xaa, 0x46, 0x2a, 0x59, 0x72, 0xd4, 0x1a, 0x4e, 0x7f, 0x20, 0xca, 0x4c, 0x2b, 0xd5, 0x76,
+	0x88, 0xa1, 0x92, 0x20, 0x45, 0x8b, 0xc5, 0x70, 0x77, 0xbc, 0xdc, 0x70, 0x77, 0x87, 0xd8, 0x59,
+	0x32, 0x54, 0x9f, 0x20, 0xf0, 0x55, 0xfa, 0x00, 0x06, 0x02, 0xf4, 0x26, 0x97, 0x7e, 0x84, 0x5e,
+	0x06, 0xbd, 0xca, 0x65, 0x51, 0xa0, 0x8b, 0x82, 0xbe, 0x08, 0xa0, 0x4b, 0x3d, 0x41, 0x31, 0x3f,
+	0xfc, 0x95, 0xc2, 0xaa, 0x80, 0x60, 0xcf, 0x77, 0xe6, 0x9c, 0xef, 0x9b, 0x9f, 0xb3, 0x33, 0x67,
+	0x08, 0x2a, 0x0e, 0x65, 0x21, 0x65, 0xfb, 0xa4, 0x1f, 0xee, 0xf3, 0xbf, 0x03, 0xde, 0xda, 0xeb,
+	0xc6, 0x34, 0xa1, 0xd0, 0x90, 0x7d, 0x7b, 0xdc, 0xc2, 0xff, 0x0e, 0x2a, 0x77, 0x70, 0xe8, 0x47,
+	0x74, 0x5f, 0xfc, 0x2b, 0x9d, 0x2a, 0x25, 0x8f, 0x7a, 0x54, 0x34, 0xf7, 0x79, 0x4b, 0x5a, 0xad,
+	0x37, 0x59, 0xb0, 0xda, 0xc0, 0x31, 0x0e, 0x19, 0x3c, 0x00, 0x39, 0xd2, 0x0f, 0x6d, 0x97, 0x44,
+	0x34, 0x2c, 0x67, 0xaa, 0x99, 0xdd, 0x5c, 0xad, 0x74, 0x95, 0x9a, 0xc6, 0x05, 0x0e, 0x83, 0x27,
+	0xd6, 0xb8, 0xcb, 0x42, 0x3a, 0xe9, 0x87, 0x4f, 0x79, 0x13, 0x1e, 0x03, 0x40, 0x06, 0x49, 0x8c,
+	0x6d, 0xe2, 0x77, 0x59, 0x59, 0xab, 0x66, 0x77, 0xb3, 0x35, 0x6b, 0x98, 0x9a, 0xb9, 0x3a, 0xb7,
+	0xd6, 0x4f, 0x1b, 0xec, 0x2a, 0x35, 0xef, 0x28, 0x82, 0xb1, 0xa3, 0x85, 0x72, 0x02, 0xd4, 0xfd,
+	0x2e, 0x83, 0x87, 0x60, 0x0b, 0x07, 0x01, 0xfd, 0xc2, 0xee, 0x45, 0x7c, 0x44, 0xc4, 0x49, 0x88,
+	0x6b, 0x27, 0x03, 0x56, 0x5e, 0xa9, 0x66, 0x76, 0x75, 0xb4, 0x29, 0x3a, 0x3f, 0x9e, 0xf4, 0x9d,
+	0x0f, 0x78, 0x4c, 0x81, 0x0f, 0xc7, 0x69, 0xe3, 0x28, 0x22, 0x01, 0x2b, 0xaf, 0x55, 0xb3, 0xbb,
+	0xb9, 0xda, 0xc6, 0x30, 0x35, 0xf3, 0xf5, 0x4f, 0x9e, 0x9f, 0x28, 0x33, 0xca, 0x93, 0x7e, 0x38,
+	0x02, 0xf0, 0xcf, 0xa0, 0x88, 0x1d, 0x87, 0x30, 0x66, 0x3b, 0x34, 0x4a, 0x62, 0x1a, 0x94, 0xf5,
+	0x6a, 0x66, 0x37, 0x7f, 0x68, 0xee, 0xcd, 0x2f, 0xde, 0xde, 0xb1, 0xf0, 0x3b, 0x91, 0x6e, 0xb5,
+	0xad, 0x6f, 0x53, 0x73, 0x69, 0x98, 0x9a, 0xeb, 0x33, 0x66, 0xb4, 0x8e, 0xa7, 0x21, 0x7c, 0x02,
+	0xee, 0x61, 0x27, 0xf1, 0xfb, 0xc4, 0x66, 0x09, 0x4e, 0x7c, 0xc7, 0xee, 0xc6, 0xc4, 0xa1, 0x61,
+	0xd7, 0x0f, 0x08, 0x2b, 0xe7, 0xf8, 0xf8, 0xd0, 0x5d, 0xe9, 0xd0, 0x14, 0xfd, 0x8d, 0x49, 0xf7,
+	0x93, 0xfb, 0xaf, 0xbe, 0x7f, 0xf3, 0x60, 0x7b, 0x6a, 0x7f, 0x07, 0x7c, 0x87, 0xe5, 0xae, 0x9c,
+	0x69, 0xfa, 0xb2, 0x91, 0x3d, 0xd3, 0xf4, 0xac, 0xa1, 0x9d, 0x69, 0xfa, 0xaa, 0xb1, 0x66, 0xfd,
+	0x35, 0x03, 0x66, 0xc7, 0x02, 0x8f, 0xc1, 0xaa, 0x13, 0x13, 0x9c, 0x10, 0xb1, 0x6d, 0xf9, 0xc3,
+	0x1f, 0xff, 0x8f, 0x39, 0x9d, 0x5f, 0x74, 0x49, 0x4d, 0xe3, 0xf3, 0x42, 0x2a, 0x10, 0xfe, 0x1a,
+	0x68, 0x0e, 0x0e, 0x82, 0xf2, 0xf2, 0xff, 0x4b, 0x20, 0xc2, 0xac, 0x7f, 0x67, 0xc0, 0x9d, 0x6b,
+	0x1e, 0xd0, 0x01, 0x79, 0xb5, 0xe6, 0xc9, 0x45, 0x57, 0x0e, 0xae, 0x78, 0xf8, 0xce, 0x0f, 0x71,
+	0x0b, 0xd2, 0x9f, 0x0c, 0x53, 0x13, 0x4c, 0xf0, 0x55, 0x6a, 0x42, 0x99, 0x3e, 0x53, 0x44, 0x16,
+	0x02, 0x78, 0xec, 0x01, 0x1d, 0xb0, 0x39, 0xbb, 0xb1, 0x76, 0xe0, 0xb3, 0xa4, 0xbc, 0x2c, 0x72,
+	0xe2, 0xd1, 0x30, 0x35, 0x67, 0x07, 0xf6, 0xcc, 0x67, 0xc9, 0x55, 0x6a, 0x56, 0x66, 0x58, 0xa7,
+	0x23, 0x2d, 0x74, 0x07, 0xcf, 0x07, 0x58, 0xdf, 0x18, 0x20, 0x7f, 0xd2, 0xc6, 0x7e, 0x74, 0x42,
+	0xa3, 0x97, 0xbe, 0x07, 0xff, 0x04, 0x36, 0xda, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x5b, 0x01,
+	0x75, 0x3a, 0xea, 0x8b, 0x79, 0xf4, 0xaf, 0xd4, 0xdc, 0x92, 0x13, 0x64, 0x6e, 0x67, 0xcf, 0xa7,
+	0xfb, 0x21, 0x4e, 0xda, 0x7b, 0xa7, 0x11, 0x17, 0xdd, 0x96, 0xa2, 0x73, 0x91, 0x16, 0x2a, 0x8e,
+	0x2d, 0x35, 0x6e, 0x80, 0x6d, 0x50, 0x74, 0x31, 0xb5, 0x5f, 0xd2, 0xb8, 0xa3, 0xc8, 0x97, 0x05,
+	0x79, 0xed, 0x07, 0xc9, 0x87, 0xa9, 0x59, 0x78, 0x7a, 0xfc, 0xd1, 0x87, 0x34, 0xee, 0x08, 0x8a,
+	0xab, 0xd4, 0xdc, 0x92, 0x62, 0xb3, 0x44, 0x16, 0x2a, 0xb8, 0x98, 0x8e, 0xdd, 0xe0, 0xa7, 0xc0,
+	0x18, 0x3b, 0xb0, 0x5e, 0xb7, 0x4b, 0xe3, 0xa4, 0x9c, 0xe5, 0x1f, 0x5e, 0xed, 0xe7, 0xc3, 0xd4,
+	0x2c, 0x2a, 0xca, 0xa6, 0xec, 0xb9, 0x4a, 0xcd, 0xbb, 0x73, 0xa4, 0x2a, 0xc6, 0x42, 0x45, 0x45,
+	0xab, 0x5c, 0x61, 0x0b, 0x14, 0x88, 0xdf, 0x3d, 0x38, 0x7a, 0xa8, 0x26, 0xa0, 0x89, 0x09, 0xfc,
+	0x76, 0xd1, 0x04, 0xf2, 0xf5, 0xd3, 0xc6, 0xc1, 0xd1, 0xc3, 0xd1, 0xf8, 0x37, 0xd5, 0xb1, 0x31,
+	0xc5, 0x62, 0xa1, 0xbc, 0x84, 0x72, 0xf0, 0x23, 0x8d, 0x23, 0xa5, 0xb1, 0x7a, 0x5b, 0x8d, 0xa3,
+	0x9b, 0x34, 0x8e, 0x66, 0x35, 0x8e, 0x66, 0x35, 0x1e, 0x2b, 0x8d, 0xb5, 0xdb, 0x6a, 0x3c, 0xbe,
+	0x49, 0xe3, 0xf1, 0xac, 0x86, 0xf4, 0xe1, 0xc9, 0xd4, 0xba, 0xf8, 0x0b, 0x8e, 0x12, 0xbf, 0x17,
+	0x2a, 0x19, 0xfd, 0xd6, 0xc9, 0x34, 0x17, 0x69, 0xa1, 0xe2, 0xd8, 0x22, 0xd9, 0x3b, 0xa0, 0xe4,
+	0xd0, 0x88, 0x25, 0xdc, 0x16, 0xd1, 0x6e, 0x40, 0x94, 0x44, 0x4e, 0x48, 0x3c, 0x5e, 0x24, 0x71,
+	0x5f, 0x4a, 0xdc, 0x14, 0x6e, 0xa1, 0xcd, 0x59, 0xb3, 0x14, 0xb3, 0x81, 0xd1, 0x25, 0x09, 0x89,
+	0x59, 0xab, 0x17, 0x7b, 0x4a, 0x08, 0x08, 0xa1, 0xf7, 0x17, 0x09, 0xa9, 0xb4, 0x9a, 0x0f, 0xb5,
+	0xd0, 0xc6, 0xc4, 0x24, 0x05, 0x3e, 0x03, 0x45, 0x9f, 0xab, 0xb6, 0x7a, 0x81, 0xa2, 0xcf, 0x0b,
+	0xfa, 0xc3, 0x45, 0xf4, 0xea, 0x53, 0x98, 0x0d, 0xb4, 0xd0, 0xfa, 0xc8, 0x20, 0xa9, 0x5d, 0x00,
+	0xc3, 0x9e, 0x1f, 0xdb, 0x5e, 0x80, 0x1d, 0x9f, 0xc4, 0x8a, 0xbe, 0x20, 0xe8, 0x7f, 0xb1, 0x88,
+	0xfe, 0x9e, 0xa4, 0xbf, 0x1e, 0x6c, 0x21, 0x83, 0x1b, 0x7f, 0x27, 0x6d, 0x52, 0xa5, 0x09, 0x0a,
+	0x2d, 0x12, 0x07, 0x7e, 0xa4, 0xf8, 0xd7, 0x05, 0xff, 0xc3, 0x45, 0xfc, 0x2a, 0x83, 0xa6, 0xc3,
+	0x2c, 0x94, 0x97, 0x70, 0x4c, 0x1a, 0xd0, 0xc8, 0xa5, 0x23, 0xd2, 0x3b, 0xb7, 0x26, 0x9d, 0x0e,
+	0xb3, 0x50, 0x5e, 0x42, 0x49, 0xea, 0x81, 0x4d, 0x1c, 0xc7, 0xf4, 0x8b, 0xb9, 0x05, 0x81, 0x82,
+	0xfb, 0x97, 0x8b, 0xb8, 0x47, 0x87, 0xeb, 0xf5, 0x68, 0x7e, 0xb8, 0x72, 0xeb, 0xcc, 0x92, 0xb8,
+	0x00, 0x7a, 0x31, 0xbe, 0x98, 0xd3, 0x29, 0xdd, 0x7a, 0xe1, 0xaf, 0x07, 0x5b, 0xc8, 0xe0, 0xc6,
+	0x19, 0x95, 0xcf, 0x41, 0x29, 0x24, 0xb1, 0x47, 0xec, 0x88, 0x24, 0xac, 0x1b, 0xf8, 0x89, 0xd2,
+	0xd9, 0xba, 0xf5, 0x77, 0x70, 0x53, 0xb8, 0x85, 0xa0, 0x30, 0xbf, 0x50, 0x56, 0xa9, 0x75, 0x0f,
+	0xe8, 0x0e, 0xbf, 0x2d, 0x6c, 0xdf, 0x2d, 0x97, 0xab, 0x99, 0x5d, 0x0d, 0xad, 0x09, 0x7c, 0xea,
+	0xc2, 0x12, 0x58, 0x91, 0x15, 0xd6, 0x3d, 0xae, 0x8b, 0x24, 0x80, 0x15, 0xa0, 0xbb, 0xc4, 0xf1,
+	0x43, 0x1c, 0xb0, 0x72, 0x45, 0x04, 0x8c, 0x31, 0xfc, 0x04, 0xac, 0xb3, 0x36, 0x8e, 0xbc, 0x36,
+	0xf6, 0xed, 0xc4, 0x0f, 0x49, 0xf9, 0xbe, 0x18, 0xf1, 0xc1, 0xa2, 0x11, 0x97, 0xe4, 0x88, 0x67,
+	0xe2, 0x2c, 0x54, 0x18, 0xe1, 0x73, 0x3f, 0x24, 0xb0, 0x01, 0xf2, 0x0e, 0x8e, 0x9c, 0x5e, 0x24,
+	0x59, 0xdf, 0x11, 0xac, 0xfb, 0x8b, 0x58, 0xd5, 0x55, 0x3c, 0x15, 0x65, 0x21, 0x20, 0xd1, 0x88,
+	0xb1, 0x1b, 0x63, 0xaf, 0x47, 0x24, 0xe3, 0xbb, 0xb7, 0x66, 0x9c, 0x8a, 0xb2, 0x10, 0x90, 0x68,
+	0xc4, 0xd8, 0x27, 0x71, 0x27, 0x50, 0x8c, 0x3b, 0xb7, 0x66, 0x9c, 0x8a, 0xb2, 0x10, 0x90, 0x48,
+	0x30, 0x3e, 0x07, 0x80, 0x32, 0xdc, 0xc1, 0x92, 0xd0, 0x14, 0x84, 0x7b, 0x8b, 0x08, 0x55, 0xf9,
+	0x3a, 0x09, 0xb2, 0x50, 0x4e, 0x00, 0x4e, 0x77, 0xa6, 0xe9, 0x2b, 0xc6, 0xea, 0x99, 0xa6, 0x6f,
+	0x1b, 0x77, 0xcf, 0x34, 0xfd, 0xae, 0x51, 0xb6, 0xf6, 0xc1, 0x0a, 0x2f, 0xf1, 0x08, 0x34, 0x40,
+	0xb6, 0x43, 0x2e, 0x64, 0x5d, 0x80, 0x78, 0x93, 0xef, 0x7d, 0x1f, 0x07, 0x3d, 0x22, 0xaf, 0x73,
+	0x24, 0x81, 0xd5, 0x00, 0x1b, 0xe7, 0x31, 0x8e, 0x18, 0x2f, 0x0f, 0x69, 0xf4, 0x8c, 0x7a, 0x0c,
+	0x42, 0xa0, 0xb5, 0x31, 0x6b, 0xab, 0x58, 0xd1, 0x86, 0x3f, 0x03, 0x5a, 0x40, 0x3d, 0x26, 0x0a,
+	0x9b, 0xfc, 0xe1, 0xd6, 0xf5, 0x2a, 0xea, 0x19, 0xf5, 0x90, 0x70, 0xb1, 0xfe, 0xb1, 0x0c, 0xb2,
+	0xcf, 0xa8, 0x07, 0xcb, 0x60, 0x0d, 0xbb, 0x6e, 0x4c, 0x18, 0x53, 0x4c, 0x23, 0x08, 0xb7, 0xc1,
+	0x6a, 0x42, 0xbb, 0xbe, 0x23, 0xe9, 0x72, 0x48, 0x21, 0x2e, 0xec, 0xe2, 0x04, 0x8b, 0x1a, 0xa0,
+	0x80, 0x44, 0x9b, 0x57, 0xdb, 0x22, 0xd5, 0xed, 0xa8, 0x17, 0xb6, 0x48, 0x2c, 0xae, 0x72, 0xad,
+	0xb6, 0x71, 0x99, 0x9a, 0x79, 0x61, 0x7f, 0x21, 0xcc, 0x68, 0x1a, 0xc0, 0xf7, 0xc0, 0x5a, 0x32,
+	0xb0, 0xc5, 0x1c, 0x56, 0xc4, 0x12, 0x6f, 0x5e, 0xa6, 0xe6, 0x46, 0x32, 0x99, 0xe6, 0xef, 0x31,
+	0x6b, 0xa3, 0xd5, 0x64, 0xc0, 0xff, 0x87, 0xfb, 0x40, 0x4f, 0x06, 0xb6, 0x1f, 0xb9, 0x64, 0x20,
+	0x2e, 0x71, 0xad, 0x56, 0xba, 0x4c, 0x4d, 0x63, 0xca, 0xfd, 0x94, 0xf7, 0xa1, 0xb5, 0x64, 0x20,
+	0x1a, 0xf0, 0x3d, 0x00, 0xe4, 0x90, 0x84, 0x82, 0xbc, 0x93, 0xd7, 0x2f, 0x53, 0x33, 0x27, 0xac,
+	0x82, 0x7b, 0xd2, 0x84, 0x16, 0x58, 0x91, 0xdc, 0xba, 0xe0, 0x2e, 0x5c, 0xa6, 0xa6, 0x1e, 0x50,
+	0x4f, 0x72, 0xca, 0x2e, 0xbe, 0x54, 0x31, 0x09, 0x69, 0x9f, 0xb8, 0xe2, 0x62, 0xd4, 0xd1, 0x08,
+	0x5a, 0x5f, 0x2d, 0x03, 0xfd, 0x7c, 0x80, 0x08, 0xeb, 0x05, 0x09, 0xfc, 0x10, 0x18, 0xa2, 0x56,
+	0xc4, 0x4e, 0x62, 0xcf, 0x2c, 0x6d, 0xed, 0xfe, 0xe4, 0x1a, 0x9b, 0xf7, 0xb0, 0xd0, 0xc6, 0xc8,
+	0x74, 0xac, 0xd6, 0xbf, 0x04, 0x56, 0x5a, 0x01, 0xa5, 0xa1, 0xc8, 0x84, 0x02, 0x92, 0x00, 0x7e,
+	0x2a, 0x56, 0x4d, 0xec, 0x72, 0x56, 0xd4, 0xe1, 0x3f, 0xba, 0xbe, 0xcb, 0x73, 0xa9, 0x52, 0xbb,
+	0xcf, 0xab, 0xf0, 0xab, 0xd4, 0x2c, 0x4a, 0x6d, 0x15, 0x6f, 0x7d, 0xf3, 0xfd, 0x9b, 0x07, 0x19,
+	0xbe, 0xc0, 0x22, 0x9f, 0x0c, 0x90, 0x8d, 0x49, 0x22, 0x76, 0xae, 0x80, 0x78, 0x93, 0x1f, 0x38,
+	0x31, 0xe9, 0x93, 0x38, 0x21, 0xae, 0x7a, 0x69, 0x8d, 0x31, 0x3f, 0xbd, 0x3c, 0xcc, 0xec, 0x1e,
+	0x23, 0xae, 0xdc, 0x0e, 0xb4, 0xe6, 0x61, 0xf6, 0x31, 0x23, 0xee, 0x13, 0xed, 0xcb, 0xaf, 0xcd,
+	0x25, 0x0b, 0x83, 0xbc, 0x2a, 0xd1, 0x7b, 0xdd, 0x80, 0x2c, 0x48, 0xb3, 0x43, 0x50, 0x60, 0x09,
+	0x8d, 0xb1, 0x47, 0xec, 0x0e, 0xb9, 0x50, 0xc9, 0x26, 0x53, 0x47, 0xd9, 0xff, 0x40, 0x2e, 0x18,
+	0x9a, 0x06, 0x4a, 0xe2, 0x6b, 0x0d, 0xe4, 0xcf, 0x63, 0xec, 0x10, 0x55, 0x70, 0xf3, 0x84, 0xe5,
+	0x30, 0x56, 0x12, 0x0a, 0x71, 0x6d, 0xfe, 0x4d, 0xd2, 0x5e, 0xa2, 0x3e, 0xaa, 0x11, 0xe4, 0x11,
+	0x31, 0x21, 0x03, 0xe2, 0x88, 0xb5, 0xd4, 0x90, 0x42, 0xf0, 0x08, 0xac, 0xbb, 0x3e, 0xc3, 0xad,
+	0x40, 0x3c, 0xd5, 0x9c, 0x8e, 0x9c, 0x7e, 0xcd, 0xb8, 0x4c, 0xcd, 0x82, 0xea, 0x68, 0x72, 0x3b,
+	0x9a, 0x41, 0xf0, 0x03, 0xb0, 0x31, 0x09, 0x13, 0xa3, 0x15, 0x6b, 0xa3, 0xd7, 0xe0, 0x65, 0x6a,
+	0x16, 0xc7, 0xae, 0xa2, 0x07, 0xcd, 0x61, 0x79, 0xe8, 0xb7, 0x7a, 0x9e, 0xc8, 0x40, 0x1d, 0x49,
+	0xc0, 0xad, 0x81, 0x1f, 0xfa, 0x89, 0xc8, 0xb8, 0x15, 0x24, 0x01, 0xfc, 0x00, 0xe4, 0x68, 0x9f,
+	0xc4, 0xb1, 0xef, 0x12, 0x26, 0x6a, 0xa7, 0xfc, 0xe1, 0xbb, 0xd7, 0xd3, 0x60, 0xea, 0x31, 0x82,
+	0x26, 0xfe, 0x7c, 0x72, 0x24, 0x12, 0x83, 0x0c, 0x49, 0x48, 0xe3, 0x0b, 0x51, 0x1d, 0xa9, 0xc9,
+	0xc9, 0x8e, 0xe7, 0xc2, 0x8e, 0x66, 0x10, 0xac, 0x01, 0xa8, 0xc2, 0x62, 0x92, 0xf4, 0xe2, 0xc8,
+	0x16, 0x87, 0x40, 0x41, 0xc4, 0x8a, 0x4f, 0x51, 0xf6, 0x22, 0xd1, 0xf9, 0x14, 0x27, 0x18, 0x5d,
+	0xb3, 0xc0, 0xdf, 0x00, 0x28, 0xf7, 0xc4, 0xfe, 0x9c, 0xd1, 0x88, 0x3f, 0xa9, 0x5e, 0xfa, 0x9e,
+	0x2a, 0x6f, 0x84, 0xbe, 0xec, 0x55, 0x63, 0x36, 0x24, 0x3a, 0x63, 0x54, 0xcd, 0xe2, 0x4c, 0xd3,
+	0x35, 0x63, 0xe5, 0x4c, 0xd3, 0xd7, 0x0c, 0x7d, 0xbc, 0x7e, 0x6a, 0x16, 0x68, 0x73, 0x84, 0xa7,
+	0x86, 0x67, 0xbd, 0x00, 0xa0, 0x11, 0x13, 0x9f, 0x17, 0xa1, 0x41, 0xc0, 0x4f, 0xae, 0x08, 0x87,
+	0x64, 0x74, 0x64, 0xf2, 0xf6, 0x74, 0x62, 0x2e, 0xcf, 0x26, 0x26, 0x04, 0x9a, 0x43, 0x5d, 0x22,
+	0x52, 0x23, 0x87, 0x44, 0xdb, 0x7a, 0x0e, 0x8a, 0xfc, 0x05, 0x73, 0xcc, 0x5f, 0xe9, 0x98, 0x7f,
+	0x5f, 0xdc, 0x8b, 0xbf, 0x72, 0x46, 0x9c, 0xbc, 0x0d, 0x7f, 0x0a, 0x36, 0xf0, 0xd8, 0x43, 0x5e,
+	0x22, 0xcb, 0x22, 0xbf, 0x8a, 0x13, 0x33, 0xbf, 0x19, 0x1e, 0xfc, 0x3d, 0x03, 0xa6, 0x1e, 0xb2,
+	0xf0, 0x57, 0xa0, 0x72, 0x7c, 0x72, 0x52, 0x6f, 0x36, 0xed, 0xf3, 0xcf, 0x1a, 0x75, 0xbb, 0x51,
+	0x47, 0xcf, 0x4f, 0x9b, 0xcd, 0xd3, 0x8f, 0x5e, 0x3c, 0xab, 0x37, 0x9b, 0xc6, 0x52, 0xe5, 0x9d,
+	0x57, 0xaf, 0xab, 0xe5, 0x89, 0x7f, 0x83, 0xc4, 0xa1, 0xcf, 0x98, 0x4f, 0xa3, 0x80, 0x8f, 0xf7,
+	0x7d, 0xb0, 0x3d, 0x1d, 0x8d, 0xea, 0xcd, 0x73, 0x74, 0x7a, 0x72, 0x5e, 0x7f, 0x6a, 0x64, 0x2a,
+	0xe5, 0x57, 0xaf, 0xab, 0xa5, 0x49, 0x24, 0x22, 0x2c, 0x89, 0x7d, 0x87, 0x7f, 0xc8, 0x8f, 0x41,
+	0xf9, 0x66, 0xcd, 0xfa, 0x53, 0x63, 0xb9, 0x52, 0x79, 0xf5, 0xba, 0xba, 0x7d, 0x93, 0x22, 0x71,
+	0x2b, 0xda, 0x97, 0x7f, 0xdb, 0x59, 0xaa, 0x3d, 0xf9, 0x76, 0xb8, 0x93, 0xf9, 0x6e, 0xb8, 0x93,
+	0xf9, 0xcf, 0x70, 0x27, 0xf3, 0xd5, 0xdb, 0x9d, 0xa5, 0xef, 0xde, 0xee, 0x2c, 0xfd, 0xf3, 0xed,
+	0xce, 0xd2, 0x1f, 0xab, 0x9e, 0x9f, 0xb4, 0x7b, 0xad, 0x3d, 0x87, 0x86, 0xfb, 0xf3, 0x3f, 0x5c,
+	0xf0, 0x27, 0x3a, 0x6b, 0xad, 0x8a, 0xdf, 0x97, 0x1e, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff, 0xab,
+	0x75, 0x73, 0xc6, 0xb8, 0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1985,6 +2046,41 @@ func (m *Preinstall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForkActivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkActivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkActivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationTime != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ActivationTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Fork) > 0 {
+		i -= len(m.Fork)
+		copy(dAtA[i:], m.Fork)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Fork)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvm(v)
 	base := offset
@@ -2360,6 +2456,22 @@ func (m *Preinstall) Size() (n int) {
 	return n
 }
 
+func (m *ForkActivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fork)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ActivationTime != 0 {
+		n += 1 + sovEvm(uint64(m.ActivationTime))
+	}
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5039,6 +5151,107 @@ func (m *Preinstall) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ForkActivation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkActivation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkActivation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fork", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fork = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			m.ActivationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// Names of the timestamp based Ethereum hard forks that can be scheduled through
+// governance, in activation order. Verkle is not included, as the EVM does not
+// support the verkle state transition.
+const (
+	ForkShanghai = "shanghai"
+	ForkCancun   = "cancun"
+	ForkPrague   = "prague"
+	ForkOsaka    = "osaka"
+)
+
+// ScheduledForks is the list of the hard forks that can be scheduled through
+// governance.
+var ScheduledForks = []string{ForkShanghai, ForkCancun, ForkPrague, ForkOsaka}
+
+// Validate performs a stateless validation of the fork activation.
+func (fa ForkActivation) Validate() error {
+	if !slices.Contains(ScheduledForks, fa.Fork) {
+		return errorsmod.Wrapf(
+			ErrInvalidChainConfig, "unknown fork %q, expected one of %v", fa.Fork, ScheduledForks,
+		)
+	}
+	return nil
+}
+
+// ValidateForkActivations validates the given fork activations and checks
+// that no fork is scheduled more than once.
+func ValidateForkActivations(activations []ForkActivation) error {
+	seenForks := make(map[string]bool)
+	for _, activation := range activations {
+		if seenForks[activation.Fork] {
+			return fmt.Errorf("duplicated fork activation %s", activation.Fork)
+		}
+		if err := activation.Validate(); err != nil {
+			return err
+		}
+		seenForks[activation.Fork] = true
+	}
+	return nil
+}
+
+// WithForkActivations returns a copy of the chain config with the activation
+// times of the given forks replaced by their scheduled values.
+func (cc ChainConfig) WithForkActivations(activations []ForkActivation) *ChainConfig {
+	config := cc
+	for _, activation := range activations {
+		activationTime := sdkmath.NewIntFromUint64(activation.ActivationTime)
+		switch activation.Fork {
+		case ForkShanghai:
+			config.ShanghaiTime = &activationTime
+		case ForkCancun:
+			config.CancunTime = &activationTime
+		case ForkPrague:
+			config.PragueTime = &activationTime
+		case ForkOsaka:
+			config.OsakaTime = &activationTime
+		}
+	}
+	return &config
+}
+
+// ForkActivationTime returns the activation time of the given fork in the chain
+// config, or nil if the fork is not scheduled.
+func (cc ChainConfig) ForkActivationTime(fork string) *sdkmath.Int {
+	switch fork {
+	case ForkShanghai:
+		return cc.ShanghaiTime
+	case ForkCancun:
+		return cc.CancunTime
+	case ForkPrague:
+		return cc.PragueTime
+	case ForkOsaka:
+		return cc.OsakaTime
+	default:
+		return nil
+	}
+}
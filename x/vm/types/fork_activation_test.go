@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestValidateForkActivations(t *testing.T) {
+	testCases := []struct {
+		name        string
+		activations []ForkActivation
+		expPass     bool
+	}{
+		{"empty", nil, true},
+		{"valid", []ForkActivation{{Fork: ForkPrague, ActivationTime: 10}, {Fork: ForkOsaka, ActivationTime: 20}}, true},
+		{"unknown fork", []ForkActivation{{Fork: "london", ActivationTime: 10}}, false},
+		{"verkle fork", []ForkActivation{{Fork: "verkle", ActivationTime: 10}}, false},
+		{"duplicated fork", []ForkActivation{{Fork: ForkOsaka, ActivationTime: 10}, {Fork: ForkOsaka, ActivationTime: 20}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateForkActivations(tc.activations)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestWithForkActivations(t *testing.T) {
+	config := DefaultChainConfig(0)
+	scheduled := config.WithForkActivations([]ForkActivation{{Fork: ForkOsaka, ActivationTime: 10}})
+
+	require.Nil(t, config.OsakaTime)
+	require.Equal(t, sdkmath.NewInt(10), *scheduled.OsakaTime)
+	require.Equal(t, sdkmath.NewInt(10), *scheduled.ForkActivationTime(ForkOsaka))
+	require.Equal(t, config.PragueTime, scheduled.PragueTime)
+	require.NoError(t, scheduled.Validate())
+
+	// a fork cannot be scheduled before its predecessor
+	invalid := scheduled.WithForkActivations([]ForkActivation{{Fork: ForkPrague, ActivationTime: 20}})
+	require.Error(t, invalid.Validate())
+}
@@ -18,9 +18,10 @@ func (ga GenesisAccount) Validate() error {
 // chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Accounts:    []GenesisAccount{},
-		Params:      DefaultParams(),
-		Preinstalls: []Preinstall{},
+		Accounts:        []GenesisAccount{},
+		Params:          DefaultParams(),
+		Preinstalls:     []Preinstall{},
+		ForkActivations: []ForkActivation{},
 	}
 }
 
@@ -66,5 +67,9 @@ func (gs GenesisState) Validate() error {
 		seenPreinstalls[preinstall.Address] = true
 	}
 
+	if err := ValidateForkActivations(gs.ForkActivations); err != nil {
+		return fmt.Errorf("invalid fork activations: %w", err)
+	}
+
 	return gs.Params.Validate()
 }
@@ -32,6 +32,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// preinstalls defines a set of predefined contracts
 	Preinstalls []Preinstall `protobuf:"bytes,3,rep,name=preinstalls,proto3" json:"preinstalls"`
+	// fork_activations defines the hard fork activation times scheduled through
+	// governance
+	ForkActivations []ForkActivation `protobuf:"bytes,4,rep,name=fork_activations,json=forkActivations,proto3" json:"fork_activations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +91,13 @@ func (m *GenesisState) GetPreinstalls() []Preinstall {
 	return nil
 }
 
+func (m *GenesisState) GetForkActivations() []ForkActivation {
+	if m != nil {
+		return m.ForkActivations
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -162,29 +172,31 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/genesis.proto", fileDescriptor_e6b6f3a3ceb84d18) }
 
 var fileDescriptor_e6b6f3a3ceb84d18 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x07, 0x21, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2,
-	0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x01, 0x88, 0xbc, 0x5e, 0x6a, 0x59, 0xae,
-	0x1e, 0x08, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29,
-	0x29, 0x0c, 0x43, 0x40, 0xca, 0x21, 0x72, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0xba, 0xcf, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x9d, 0x8b, 0x23, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4, 0x58, 0x82, 0x51, 0x81, 0x59,
-	0x83, 0xdb, 0x48, 0x41, 0x0f, 0xdd, 0x6a, 0x3d, 0xa8, 0x0e, 0x47, 0x88, 0x42, 0x27, 0xce, 0x13,
-	0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd7, 0x2c, 0x64, 0xcd, 0xc5, 0x56,
-	0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x81, 0x69, 0x4c,
-	0x00, 0x58, 0x1e, 0x59, 0x3b, 0x54, 0x8b, 0x90, 0x27, 0x17, 0x77, 0x41, 0x51, 0x6a, 0x66, 0x5e,
-	0x71, 0x49, 0x62, 0x4e, 0x4e, 0xb1, 0x04, 0x33, 0xd8, 0x21, 0x32, 0x58, 0x4c, 0x80, 0x2b, 0x42,
-	0x36, 0x05, 0x59, 0xaf, 0x52, 0x3b, 0x23, 0x17, 0x1f, 0xaa, 0x7b, 0x85, 0x24, 0xb8, 0xd8, 0x13,
-	0x53, 0x52, 0x8a, 0x52, 0x8b, 0x41, 0x5e, 0x64, 0xd4, 0xe0, 0x0c, 0x82, 0x71, 0x85, 0x84, 0xb8,
-	0x58, 0x92, 0xf3, 0x53, 0x52, 0xc1, 0x4e, 0xe6, 0x0c, 0x02, 0xb3, 0x85, 0xdc, 0xb9, 0xd8, 0x8b,
-	0x4b, 0xf2, 0x8b, 0x12, 0xd3, 0x53, 0xa1, 0xee, 0x10, 0xc7, 0x74, 0x07, 0x38, 0xec, 0x9c, 0x44,
-	0x40, 0x4e, 0x58, 0x75, 0x5f, 0x9e, 0x3d, 0x18, 0xa2, 0x1e, 0xe2, 0x1a, 0x98, 0x6e, 0x27, 0xab,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x8a, 0xc2, 0x0a, 0x50, 0x24, 0x96, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0xa3, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x23, 0x28, 0x5f,
-	0x27, 0x02, 0x00, 0x00,
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcd, 0x4e, 0xea, 0x40,
+	0x14, 0xee, 0x00, 0x81, 0xcb, 0x70, 0x73, 0x2f, 0x77, 0x42, 0x72, 0x1b, 0x62, 0x4a, 0xc3, 0x8a,
+	0xb8, 0x68, 0x03, 0xee, 0x74, 0x05, 0x0b, 0x89, 0x3b, 0x03, 0x89, 0x0b, 0x37, 0x66, 0x28, 0x43,
+	0x6d, 0xa0, 0x3d, 0x4d, 0x67, 0x20, 0xfa, 0x04, 0x6e, 0x7d, 0x0c, 0xe3, 0xca, 0x9d, 0xaf, 0xc0,
+	0x92, 0xa5, 0x2b, 0x35, 0xb0, 0xf0, 0x35, 0xcc, 0xcc, 0x00, 0x29, 0xa2, 0xc9, 0xa4, 0x39, 0xed,
+	0xf7, 0x33, 0xdf, 0x39, 0x3d, 0xd8, 0xf2, 0x80, 0x87, 0xc0, 0x5d, 0x36, 0x0b, 0x5d, 0x79, 0x9a,
+	0xae, 0xcf, 0x22, 0xc6, 0x03, 0xee, 0xc4, 0x09, 0x08, 0x20, 0x65, 0x8d, 0x3b, 0x6c, 0x16, 0x3a,
+	0xf2, 0x34, 0xab, 0xff, 0x68, 0x18, 0x44, 0xe0, 0xaa, 0xa7, 0x26, 0x55, 0xab, 0x7b, 0x26, 0x92,
+	0xae, 0xb1, 0x8a, 0x0f, 0x3e, 0xa8, 0xd2, 0x95, 0x95, 0xfe, 0x5a, 0x7f, 0xce, 0xe0, 0xdf, 0x5d,
+	0x7d, 0x51, 0x5f, 0x50, 0xc1, 0x48, 0x17, 0xff, 0xa2, 0x9e, 0x07, 0xd3, 0x48, 0x70, 0x13, 0xd9,
+	0xd9, 0x46, 0xa9, 0x65, 0x3b, 0x5f, 0xaf, 0x76, 0xd6, 0x8a, 0xb6, 0x26, 0x76, 0x8a, 0xf3, 0xd7,
+	0x9a, 0xf1, 0xf0, 0xf1, 0x74, 0x88, 0x7a, 0x5b, 0x31, 0x39, 0xc1, 0xf9, 0x98, 0x26, 0x34, 0xe4,
+	0x66, 0xc6, 0x46, 0x8d, 0x52, 0xcb, 0xdc, 0xb7, 0x39, 0x57, 0x78, 0x5a, 0xbe, 0x96, 0x90, 0x33,
+	0x5c, 0x8a, 0x13, 0x16, 0x44, 0x5c, 0xd0, 0xc9, 0x84, 0x9b, 0x59, 0x15, 0xe4, 0xe0, 0x1b, 0x87,
+	0x2d, 0x29, 0xed, 0x92, 0xd6, 0x92, 0x0b, 0x5c, 0x1e, 0x41, 0x32, 0xbe, 0xa2, 0x9e, 0x08, 0x66,
+	0x54, 0x04, 0x10, 0x71, 0x33, 0xf7, 0x53, 0x63, 0xa7, 0x90, 0x8c, 0xdb, 0x5b, 0x62, 0xda, 0xf3,
+	0xef, 0x68, 0x07, 0xe2, 0xf5, 0x3b, 0x84, 0xff, 0xec, 0xce, 0x81, 0x98, 0xb8, 0x40, 0x87, 0xc3,
+	0x84, 0x71, 0x39, 0x3a, 0xd4, 0x28, 0xf6, 0x36, 0xaf, 0x84, 0xe0, 0x9c, 0x07, 0x43, 0xa6, 0x46,
+	0x51, 0xec, 0xa9, 0x9a, 0x74, 0x71, 0x81, 0x0b, 0x48, 0xa8, 0xcf, 0xd6, 0xfd, 0xfd, 0xdf, 0xcf,
+	0xa3, 0xfe, 0x49, 0xa7, 0x22, 0x63, 0x3c, 0xbe, 0xd5, 0x0a, 0x7d, 0xcd, 0xd7, 0x89, 0x36, 0xea,
+	0xce, 0xf1, 0x7c, 0x69, 0xa1, 0xc5, 0xd2, 0x42, 0xef, 0x4b, 0x0b, 0xdd, 0xaf, 0x2c, 0x63, 0xb1,
+	0xb2, 0x8c, 0x97, 0x95, 0x65, 0x5c, 0xda, 0x7e, 0x20, 0xae, 0xa7, 0x03, 0xc7, 0x83, 0xd0, 0x4d,
+	0xad, 0xc6, 0x8d, 0x5c, 0x0e, 0x71, 0x1b, 0x33, 0x3e, 0xc8, 0xab, 0x35, 0x38, 0xfa, 0x0c, 0x00,
+	0x00, 0xff, 0xff, 0xf7, 0x1e, 0xdb, 0xb5, 0x7f, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +219,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForkActivations) > 0 {
+		for iNdEx := len(m.ForkActivations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForkActivations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Preinstalls) > 0 {
 		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +356,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ForkActivations) > 0 {
+		for _, e := range m.ForkActivations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -492,6 +524,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkActivations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkActivations = append(m.ForkActivations, ForkActivation{})
+			if err := m.ForkActivations[len(m.ForkActivations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
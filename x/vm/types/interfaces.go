@@ -28,6 +28,7 @@ type AccountKeeper interface {
 	GetModuleAddress(moduleName string) sdk.AccAddress
 	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) (stop bool))
 	SetAccount(ctx context.Context, account sdk.AccountI)
 	RemoveAccount(ctx context.Context, account sdk.AccountI)
 	GetParams(ctx context.Context) (params authtypes.Params)
@@ -70,6 +71,8 @@ type FeeMarketKeeper interface {
 // Erc20Keeper defines the expected interface needed to instantiate ERC20 precompiles.
 type Erc20Keeper interface {
 	GetERC20PrecompileInstance(ctx sdk.Context, address common.Address) (contract vm.PrecompiledContract, found bool, err error)
+	GetNativePrecompiles(ctx sdk.Context) []string
+	GetDynamicPrecompiles(ctx sdk.Context) []string
 }
 
 // EvmHooks event hooks for evm tx processing
@@ -27,6 +27,8 @@ const (
 	prefixStorage
 	prefixParams
 	prefixCodeHash
+	prefixForkActivation
+	prefixPrevRandao
 )
 
 // prefix bytes for the EVM transient store
@@ -35,22 +37,26 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeePayer
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode     = []byte{prefixCode}
-	KeyPrefixStorage  = []byte{prefixStorage}
-	KeyPrefixParams   = []byte{prefixParams}
-	KeyPrefixCodeHash = []byte{prefixCodeHash}
+	KeyPrefixCode           = []byte{prefixCode}
+	KeyPrefixStorage        = []byte{prefixStorage}
+	KeyPrefixParams         = []byte{prefixParams}
+	KeyPrefixCodeHash       = []byte{prefixCodeHash}
+	KeyPrefixForkActivation = []byte{prefixForkActivation}
+	KeyPrefixPrevRandao     = []byte{prefixPrevRandao}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom    = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex  = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize  = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed  = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeePayer = []byte{prefixTransientFeePayer}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
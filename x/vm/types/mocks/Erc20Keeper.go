@@ -16,6 +16,26 @@ type Erc20Keeper struct {
 	mock.Mock
 }
 
+// GetDynamicPrecompiles provides a mock function with given fields: ctx
+func (_m *Erc20Keeper) GetDynamicPrecompiles(ctx types.Context) []string {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDynamicPrecompiles")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(types.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // GetERC20PrecompileInstance provides a mock function with given fields: ctx, address
 func (_m *Erc20Keeper) GetERC20PrecompileInstance(ctx types.Context, address common.Address) (vm.PrecompiledContract, bool, error) {
 	ret := _m.Called(ctx, address)
@@ -53,6 +73,26 @@ func (_m *Erc20Keeper) GetERC20PrecompileInstance(ctx types.Context, address com
 	return r0, r1, r2
 }
 
+// GetNativePrecompiles provides a mock function with given fields: ctx
+func (_m *Erc20Keeper) GetNativePrecompiles(ctx types.Context) []string {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNativePrecompiles")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(types.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // NewErc20Keeper creates a new instance of Erc20Keeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewErc20Keeper(t interface {
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if stateDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64             `json:"nonce"`
+	Code      *hexutil.Bytes              `json:"code"`
+	Balance   *hexutil.Big                `json:"balance"`
+	State     map[common.Hash]common.Hash `json:"state"`
+	StateDiff map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate performs a stateless validation of the state overrides.
+func (so StateOverride) Validate() error {
+	for addr, account := range so {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		if account.Balance != nil && account.Balance.ToInt().Sign() < 0 {
+			return fmt.Errorf("account %s has a negative balance override", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is a set of header fields to override during the execution of
+// a message call.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Difficulty    *hexutil.Big    `json:"difficulty"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	PrevRandao    *common.Hash    `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	BlobBaseFee   *hexutil.Big    `json:"blobBaseFee"`
+}
+
+// Apply overrides the given block context fields with the non-nil override
+// values.
+func (o *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
+	if o == nil {
+		return
+	}
+	if o.Number != nil {
+		blockCtx.BlockNumber = new(big.Int).Set(o.Number.ToInt())
+	}
+	if o.Difficulty != nil {
+		blockCtx.Difficulty = new(big.Int).Set(o.Difficulty.ToInt())
+	}
+	if o.Time != nil {
+		blockCtx.Time = uint64(*o.Time)
+	}
+	if o.GasLimit != nil {
+		blockCtx.GasLimit = uint64(*o.GasLimit)
+	}
+	if o.FeeRecipient != nil {
+		blockCtx.Coinbase = *o.FeeRecipient
+	}
+	if o.PrevRandao != nil {
+		random := *o.PrevRandao
+		blockCtx.Random = &random
+	}
+	if o.BaseFeePerGas != nil {
+		blockCtx.BaseFee = new(big.Int).Set(o.BaseFeePerGas.ToInt())
+	}
+	if o.BlobBaseFee != nil {
+		blockCtx.BlobBaseFee = new(big.Int).Set(o.BlobBaseFee.ToInt())
+	}
+}
+
+// UnmarshalOverrides decodes the JSON encoded state and block overrides of an
+// EthCallRequest. Empty payloads result in nil overrides.
+func UnmarshalOverrides(stateBz, blockBz []byte) (StateOverride, *BlockOverrides, error) {
+	var (
+		stateOverride  StateOverride
+		blockOverrides *BlockOverrides
+	)
+
+	if len(stateBz) > 0 {
+		if err := json.Unmarshal(stateBz, &stateOverride); err != nil {
+			return nil, nil, fmt.Errorf("invalid state overrides: %w", err)
+		}
+		if err := stateOverride.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(blockBz) > 0 {
+		blockOverrides = new(BlockOverrides)
+		if err := json.Unmarshal(blockBz, blockOverrides); err != nil {
+			return nil, nil, fmt.Errorf("invalid block overrides: %w", err)
+		}
+	}
+
+	return stateOverride, blockOverrides, nil
+}
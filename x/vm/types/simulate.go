@@ -0,0 +1,95 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks that can be simulated
+	// in a single eth_simulateV1 request.
+	MaxSimulateBlocks = 256
+	// SimulateTimestampIncrement is the default increment in seconds between
+	// the timestamps of the simulated blocks.
+	SimulateTimestampIncrement = 12
+
+	// simulateErrCodeReverted is the JSON-RPC error code of a reverted call.
+	simulateErrCodeReverted = 3
+	// simulateErrCodeVMError is the JSON-RPC error code of a call that failed
+	// with an EVM error.
+	simulateErrCodeVMError = -32015
+)
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially in a block, on top
+// of the state resulting from the previous blocks.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides StateOverride     `json:"stateOverrides"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// SimBlockResult is the result of a simulated block. The transactions are
+// built from the call arguments and are therefore unsigned, so their senders
+// are returned alongside them.
+type SimBlockResult struct {
+	Header       *ethtypes.Header        `json:"header"`
+	Transactions []*ethtypes.Transaction `json:"transactions"`
+	Senders      []common.Address        `json:"senders"`
+	Calls        []SimCallResult         `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+// NewSimCallResult returns the result of a simulated call from the EVM
+// response and the logs emitted by the call.
+func NewSimCallResult(res *MsgEthereumTxResponse, logs []*ethtypes.Log) SimCallResult {
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+	result := SimCallResult{
+		ReturnValue: res.Return(),
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if !res.Failed() {
+		return result
+	}
+
+	result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+	if res.VmError == vm.ErrExecutionReverted.Error() {
+		revertErr := NewExecErrorWithReason(res.Revert())
+		result.Error = &SimCallError{
+			Message: revertErr.Error(),
+			Code:    simulateErrCodeReverted,
+			Data:    revertErr.ErrorData().(string),
+		}
+	} else {
+		result.Error = &SimCallError{Message: res.VmError, Code: simulateErrCodeVMError}
+	}
+	return result
+}
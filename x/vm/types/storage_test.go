@@ -4,6 +4,11 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/stretchr/testify/require"
 )
 
@@ -80,3 +85,51 @@ func TestStorageString(t *testing.T) {
 	str := "key:\"0x00000000000000000000000000000000000000000000000000000000006b6579\" value:\"0x00000000000000000000000000000000000000000000000000000076616c7565\" \n"
 	require.Equal(t, str, storage.String())
 }
+
+func TestStorageTrie(t *testing.T) {
+	storageTrie := NewStorageTrie()
+	require.Equal(t, ethtypes.EmptyRootHash, storageTrie.Hash())
+
+	key1, key2, key3 := common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")
+	value := common.HexToHash("0xff")
+	require.NoError(t, storageTrie.Update(key1, value))
+	require.NoError(t, storageTrie.Update(key2, common.HexToHash("0x0100")))
+	// empty slots are not part of the trie
+	require.NoError(t, storageTrie.Update(key3, common.Hash{}))
+
+	// the root matches the one of the geth state
+	stateDB, err := state.New(ethtypes.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	stateDB.SetState(address, key1, value)
+	stateDB.SetState(address, key2, common.HexToHash("0x0100"))
+	stateDB.Finalise(false)
+	stateDB.IntermediateRoot(false)
+	require.Equal(t, stateDB.GetStorageRoot(address), storageTrie.Hash())
+
+	proofDB := memorydb.New()
+	proof, err := storageTrie.Prove(key1)
+	require.NoError(t, err)
+	for _, node := range proof {
+		require.NoError(t, proofDB.Put(crypto.Keccak256(node), node))
+	}
+	bz, err := trie.VerifyProof(storageTrie.Hash(), crypto.Keccak256(key1.Bytes()), proofDB)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x81, 0xff}, bz)
+
+	// the proof of an absent slot proves its absence
+	proofDB = memorydb.New()
+	proof, err = storageTrie.Prove(key3)
+	require.NoError(t, err)
+	for _, node := range proof {
+		require.NoError(t, proofDB.Put(crypto.Keccak256(node), node))
+	}
+	bz, err = trie.VerifyProof(storageTrie.Hash(), crypto.Keccak256(key3.Bytes()), proofDB)
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	// deleting the slots empties the trie
+	require.NoError(t, storageTrie.Update(key1, common.Hash{}))
+	require.NoError(t, storageTrie.Update(key2, common.Hash{}))
+	require.Equal(t, ethtypes.EmptyRootHash, storageTrie.Hash())
+}
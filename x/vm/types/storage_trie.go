@@ -0,0 +1,64 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// StorageTrie is the in-memory Merkle-Patricia trie of the storage of an
+// account, committed the way Ethereum commits it: the slots are keyed by the
+// keccak256 hash of their key and hold the RLP encoding of their value,
+// without leading zeros. Empty slots are not part of the trie.
+type StorageTrie struct {
+	trie *trie.Trie
+}
+
+// NewStorageTrie creates an empty storage trie.
+func NewStorageTrie() *StorageTrie {
+	return &StorageTrie{trie: trie.NewEmpty(nil)}
+}
+
+// Update sets the value of a storage slot.
+func (t *StorageTrie) Update(key, value common.Hash) error {
+	if value == (common.Hash{}) {
+		return t.trie.Delete(crypto.Keccak256(key.Bytes()))
+	}
+	bz, err := rlp.EncodeToBytes(common.TrimLeftZeroes(value.Bytes()))
+	if err != nil {
+		return errorsmod.Wrapf(err, "encode storage value of key %s", key)
+	}
+	return t.trie.Update(crypto.Keccak256(key.Bytes()), bz)
+}
+
+// Hash returns the root hash of the storage trie, the storage root of the account.
+func (t *StorageTrie) Hash() common.Hash {
+	return t.trie.Hash()
+}
+
+// Prove returns the RLP encoded trie nodes on the path from the root to the
+// storage slot, as returned by eth_getProof. The proof of a slot absent from
+// the trie proves its absence.
+func (t *StorageTrie) Prove(key common.Hash) ([][]byte, error) {
+	var proof proofList
+	if err := t.trie.Prove(crypto.Keccak256(key.Bytes()), &proof); err != nil {
+		return nil, errorsmod.Wrapf(err, "prove storage key %s", key)
+	}
+	return proof, nil
+}
+
+// proofList collects the trie nodes of a proof in the order they are written,
+// from the root to the leaf.
+type proofList [][]byte
+
+func (l *proofList) Put(_ []byte, value []byte) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func (l *proofList) Delete([]byte) error {
+	panic("not supported")
+}
@@ -28,14 +28,8 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 
 	switch tracer {
 	case TracerAccessList:
-		blockAddrs := map[common.Address]struct{}{
-			*msg.To: {}, msg.From: {},
-		}
-		precompiles := vm.ActivePrecompiles(cfg.Rules(big.NewInt(height), cfg.MergeNetsplitBlock != nil, timestamp))
-		for _, addr := range precompiles {
-			blockAddrs[addr] = struct{}{}
-		}
-		return logger.NewAccessListTracer(msg.AccessList, blockAddrs).Hooks()
+		rules := cfg.Rules(big.NewInt(height), cfg.MergeNetsplitBlock != nil, timestamp)
+		return NewAccessListTracer(msg.AccessList, msg.From, msg.To, rules).Hooks()
 	case TracerJSON:
 		return logger.NewJSONLogger(logCfg, os.Stderr)
 	case TracerMarkdown:
@@ -47,6 +41,29 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 	}
 }
 
+// NewAccessListTracer creates a new access list tracer starting from the given
+// access list. The sender, the recipient, the active precompiles and the given
+// additional addresses are excluded from the collected access list.
+func NewAccessListTracer(
+	acl types.AccessList,
+	from common.Address,
+	to *common.Address,
+	rules params.Rules,
+	excludes ...common.Address,
+) *logger.AccessListTracer {
+	blockAddrs := map[common.Address]struct{}{from: {}}
+	if to != nil {
+		blockAddrs[*to] = struct{}{}
+	}
+	for _, addr := range vm.ActivePrecompiles(rules) {
+		blockAddrs[addr] = struct{}{}
+	}
+	for _, addr := range excludes {
+		blockAddrs[addr] = struct{}{}
+	}
+	return logger.NewAccessListTracer(acl, blockAddrs)
+}
+
 // TxTraceResult is the result of a single transaction trace during a block trace.
 type TxTraceResult struct {
 	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
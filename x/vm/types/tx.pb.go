@@ -350,6 +350,100 @@ func (m *MsgRegisterPreinstallsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterPreinstallsResponse proto.InternalMessageInfo
 
+// MsgScheduleForkActivation defines a Msg for scheduling the activation time of
+// an Ethereum hard fork.
+type MsgScheduleForkActivation struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// fork_activation defines the hard fork and its activation time.
+	ForkActivation ForkActivation `protobuf:"bytes,2,opt,name=fork_activation,json=forkActivation,proto3" json:"fork_activation"`
+}
+
+func (m *MsgScheduleForkActivation) Reset()         { *m = MsgScheduleForkActivation{} }
+func (m *MsgScheduleForkActivation) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleForkActivation) ProtoMessage()    {}
+func (*MsgScheduleForkActivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{7}
+}
+func (m *MsgScheduleForkActivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleForkActivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleForkActivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleForkActivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleForkActivation.Merge(m, src)
+}
+func (m *MsgScheduleForkActivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleForkActivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleForkActivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleForkActivation proto.InternalMessageInfo
+
+func (m *MsgScheduleForkActivation) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleForkActivation) GetForkActivation() ForkActivation {
+	if m != nil {
+		return m.ForkActivation
+	}
+	return ForkActivation{}
+}
+
+// MsgScheduleForkActivationResponse defines the response structure for
+// executing a MsgScheduleForkActivation message.
+type MsgScheduleForkActivationResponse struct {
+}
+
+func (m *MsgScheduleForkActivationResponse) Reset()         { *m = MsgScheduleForkActivationResponse{} }
+func (m *MsgScheduleForkActivationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleForkActivationResponse) ProtoMessage()    {}
+func (*MsgScheduleForkActivationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{8}
+}
+func (m *MsgScheduleForkActivationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleForkActivationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleForkActivationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleForkActivationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleForkActivationResponse.Merge(m, src)
+}
+func (m *MsgScheduleForkActivationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleForkActivationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleForkActivationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleForkActivationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "cosmos.evm.vm.v1.MsgEthereumTx")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "cosmos.evm.vm.v1.ExtensionOptionsEthereumTx")
@@ -358,55 +452,63 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.vm.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgRegisterPreinstalls)(nil), "cosmos.evm.vm.v1.MsgRegisterPreinstalls")
 	proto.RegisterType((*MsgRegisterPreinstallsResponse)(nil), "cosmos.evm.vm.v1.MsgRegisterPreinstallsResponse")
+	proto.RegisterType((*MsgScheduleForkActivation)(nil), "cosmos.evm.vm.v1.MsgScheduleForkActivation")
+	proto.RegisterType((*MsgScheduleForkActivationResponse)(nil), "cosmos.evm.vm.v1.MsgScheduleForkActivationResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/tx.proto", fileDescriptor_77a8ac5e8c9c4850) }
 
 var fileDescriptor_77a8ac5e8c9c4850 = []byte{
-	// 678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6b, 0x13, 0x41,
-	0x1c, 0xcd, 0x66, 0xb7, 0x6d, 0x3a, 0xad, 0x18, 0xc7, 0xd6, 0x6e, 0x97, 0xba, 0x49, 0x17, 0xff,
-	0xa4, 0x05, 0xb3, 0x36, 0x82, 0x60, 0x3c, 0x19, 0xe8, 0xc1, 0x60, 0xb0, 0xac, 0xf6, 0x22, 0x42,
-	0x98, 0x36, 0xe3, 0x64, 0x21, 0xbb, 0xb3, 0xce, 0x4c, 0x62, 0x7a, 0x10, 0xa4, 0x78, 0x10, 0x4f,
-	0x82, 0x5f, 0xc0, 0x83, 0x07, 0x8f, 0x3d, 0x78, 0xf2, 0x13, 0xf4, 0x58, 0x14, 0x44, 0x44, 0x8a,
-	0xb4, 0x42, 0xbf, 0x86, 0xcc, 0xee, 0xb6, 0xd9, 0x34, 0x0b, 0x15, 0x61, 0x09, 0xb3, 0xf3, 0xde,
-	0xef, 0xfd, 0x7e, 0x6f, 0xde, 0x64, 0xc1, 0xfc, 0x26, 0xe5, 0x1e, 0xe5, 0x36, 0xee, 0x79, 0xb6,
-	0x7c, 0x56, 0x6c, 0xd1, 0x2f, 0x07, 0x8c, 0x0a, 0x0a, 0xf3, 0x11, 0x54, 0xc6, 0x3d, 0xaf, 0x2c,
-	0x9f, 0x15, 0xe3, 0x02, 0xf2, 0x5c, 0x9f, 0xda, 0xe1, 0x6f, 0x44, 0x32, 0x8c, 0x91, 0x7a, 0x49,
-	0x8f, 0xb0, 0xb9, 0x18, 0xf3, 0x38, 0x91, 0x80, 0xc7, 0x49, 0x0c, 0xc4, 0x4d, 0x9b, 0xe1, 0x9b,
-	0x1d, 0xb7, 0x89, 0xa0, 0x19, 0x42, 0x09, 0x8d, 0xf6, 0xe5, 0x2a, 0xde, 0x5d, 0x20, 0x94, 0x92,
-	0x0e, 0xb6, 0x51, 0xe0, 0xda, 0xc8, 0xf7, 0xa9, 0x40, 0xc2, 0xa5, 0x7e, 0x5c, 0x63, 0xbd, 0x56,
-	0xc0, 0xb9, 0x06, 0x27, 0xab, 0xa2, 0x8d, 0x19, 0xee, 0x7a, 0x8f, 0xfb, 0x10, 0x02, 0xed, 0x19,
-	0xa3, 0x9e, 0x3e, 0x56, 0x54, 0x4a, 0xd3, 0x4e, 0xb8, 0x86, 0x57, 0x80, 0xca, 0xd0, 0x0b, 0x7d,
-	0x5c, 0x6e, 0xd5, 0xe0, 0xee, 0x7e, 0x21, 0xf3, 0x73, 0xbf, 0x00, 0x06, 0x45, 0x8e, 0x84, 0xab,
-	0x8b, 0x6f, 0x3e, 0x14, 0x32, 0x6f, 0x8f, 0x76, 0x96, 0xf5, 0x84, 0xb1, 0x21, 0xf1, 0xba, 0x96,
-	0x53, 0xf2, 0xd9, 0xba, 0x96, 0xcb, 0xe6, 0xd5, 0xba, 0x96, 0x53, 0xf3, 0x5a, 0x5d, 0xcb, 0x69,
-	0xf9, 0x31, 0xcb, 0x02, 0xc6, 0x6a, 0x5f, 0x60, 0x9f, 0xbb, 0xd4, 0x7f, 0x18, 0x84, 0x03, 0x0e,
-	0xaa, 0xaa, 0x9a, 0x14, 0xb6, 0x3e, 0x2a, 0x60, 0x76, 0x48, 0xcd, 0xc1, 0x3c, 0xa0, 0x3e, 0xc7,
-	0x72, 0xe4, 0x36, 0xe2, 0x6d, 0x5d, 0x29, 0x2a, 0xa5, 0x49, 0x27, 0x5c, 0xc3, 0x25, 0xa0, 0x75,
-	0x28, 0xe1, 0x7a, 0xb6, 0xa8, 0x96, 0xa6, 0x2a, 0xb3, 0xe5, 0xd3, 0x81, 0x94, 0x1f, 0x50, 0xe2,
-	0x84, 0x14, 0x98, 0x07, 0x2a, 0xc3, 0x42, 0x57, 0x43, 0xc3, 0x72, 0x09, 0xe7, 0x41, 0xae, 0xe7,
-	0x35, 0x31, 0x63, 0x94, 0xe9, 0x5a, 0x28, 0x3a, 0xd1, 0xf3, 0x56, 0xe5, 0xab, 0x84, 0x08, 0xe2,
-	0xcd, 0x2e, 0xc7, 0xad, 0xf0, 0x88, 0x34, 0x67, 0x82, 0x20, 0xbe, 0xce, 0x71, 0x2b, 0x1e, 0xf3,
-	0x8b, 0x02, 0xce, 0x37, 0x38, 0x59, 0x0f, 0x5a, 0x48, 0xe0, 0x35, 0xc4, 0x90, 0xc7, 0xe1, 0x6d,
-	0x30, 0x89, 0xba, 0xa2, 0x4d, 0x99, 0x2b, 0xb6, 0xa2, 0x29, 0x6b, 0xfa, 0xd7, 0xcf, 0x37, 0x66,
-	0xe2, 0xa1, 0xee, 0xb5, 0x5a, 0x0c, 0x73, 0xfe, 0x48, 0x30, 0xd7, 0x27, 0xce, 0x80, 0x0a, 0xef,
-	0x82, 0xf1, 0x20, 0x54, 0xd0, 0xb3, 0x45, 0xa5, 0x34, 0x55, 0xd1, 0x47, 0x6d, 0x44, 0x1d, 0x6a,
-	0x93, 0x32, 0x94, 0x4f, 0x47, 0x3b, 0xcb, 0x8a, 0x13, 0x97, 0x54, 0x2b, 0xdb, 0x47, 0x3b, 0xcb,
-	0x03, 0x31, 0x19, 0x4c, 0x21, 0x11, 0x4c, 0xdf, 0x8e, 0xd2, 0x49, 0x0e, 0x6a, 0xcd, 0x83, 0xb9,
-	0x53, 0x5b, 0xc7, 0x87, 0x6c, 0x7d, 0x57, 0xc0, 0xa5, 0x06, 0x27, 0x0e, 0x26, 0x2e, 0x17, 0x98,
-	0xad, 0x31, 0xec, 0xfa, 0x5c, 0xa0, 0x4e, 0xe7, 0xff, 0xed, 0xdd, 0x07, 0x53, 0xc1, 0x40, 0x26,
-	0x8e, 0x6a, 0x21, 0xc5, 0xe3, 0x09, 0x29, 0xe9, 0x33, 0x59, 0x5b, 0xbd, 0x33, 0x6a, 0xf6, 0x5a,
-	0x8a, 0xd9, 0x94, 0xe9, 0xad, 0x22, 0x30, 0xd3, 0x91, 0x63, 0xeb, 0x95, 0x5f, 0x59, 0xa0, 0x36,
-	0x38, 0x81, 0x2f, 0x41, 0xe2, 0xce, 0xc3, 0xc2, 0xe8, 0xa0, 0x43, 0xd7, 0xd3, 0xb8, 0x7e, 0x06,
-	0xe1, 0xe4, 0x68, 0xaf, 0x6e, 0x7f, 0xfb, 0xf3, 0x3e, 0x5b, 0xb0, 0x2e, 0xdb, 0xa3, 0x5f, 0x84,
-	0x98, 0xdd, 0x14, 0x7d, 0xf8, 0x14, 0x4c, 0x0f, 0xdd, 0xaa, 0xc5, 0x54, 0xfd, 0x24, 0xc5, 0x58,
-	0x3a, 0x93, 0x72, 0xf2, 0x27, 0x7a, 0x0e, 0x2e, 0xa6, 0x65, 0x5b, 0x4a, 0x55, 0x48, 0x61, 0x1a,
-	0x37, 0xff, 0x95, 0x79, 0xdc, 0xd2, 0x18, 0x7b, 0x25, 0x83, 0xac, 0x55, 0x77, 0x0f, 0x4c, 0x65,
-	0xef, 0xc0, 0x54, 0x7e, 0x1f, 0x98, 0xca, 0xbb, 0x43, 0x33, 0xb3, 0x77, 0x68, 0x66, 0x7e, 0x1c,
-	0x9a, 0x99, 0x27, 0x45, 0xe2, 0x8a, 0x76, 0x77, 0xa3, 0xbc, 0x49, 0x3d, 0xfb, 0x74, 0x9a, 0x62,
-	0x2b, 0xc0, 0x7c, 0x63, 0x3c, 0xfc, 0x8c, 0xdd, 0xfa, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x48,
-	0x3b, 0x6f, 0x8c, 0x05, 0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6b, 0xdb, 0x48,
+	0x14, 0xb6, 0x2c, 0x25, 0x71, 0x26, 0xd9, 0x8d, 0x57, 0x9b, 0x1f, 0xb2, 0xc8, 0xda, 0x8e, 0xf6,
+	0x97, 0x93, 0x65, 0xad, 0x8d, 0x03, 0x0b, 0x75, 0x7b, 0x89, 0x21, 0x85, 0x9a, 0x9a, 0x06, 0x25,
+	0xb9, 0x94, 0x82, 0x51, 0xec, 0xc9, 0x58, 0xc4, 0xd2, 0xa8, 0x33, 0x63, 0xd7, 0x29, 0x14, 0x4a,
+	0xe8, 0xa1, 0xf4, 0x54, 0xe8, 0x3f, 0xd0, 0x43, 0x0f, 0x3d, 0xe6, 0xd0, 0x53, 0xff, 0x82, 0x1c,
+	0x43, 0x0b, 0x25, 0xf4, 0x10, 0x4a, 0x52, 0xf0, 0xbf, 0x51, 0x46, 0x52, 0x6c, 0x39, 0x56, 0x48,
+	0x1b, 0x10, 0x66, 0x34, 0xdf, 0xf7, 0xbe, 0xf7, 0xbe, 0xf7, 0x46, 0x63, 0x90, 0xaa, 0x61, 0x6a,
+	0x63, 0xaa, 0xc3, 0xb6, 0xad, 0xf3, 0x67, 0x59, 0x67, 0x9d, 0xbc, 0x4b, 0x30, 0xc3, 0x72, 0xd2,
+	0x87, 0xf2, 0xb0, 0x6d, 0xe7, 0xf9, 0xb3, 0xac, 0xfe, 0x62, 0xda, 0x96, 0x83, 0x75, 0xef, 0xd7,
+	0x27, 0xa9, 0xea, 0x50, 0x3c, 0xa7, 0xfb, 0xd8, 0x5c, 0x80, 0xd9, 0x14, 0x71, 0xc0, 0xa6, 0x28,
+	0x00, 0x82, 0xa4, 0x55, 0xef, 0x4d, 0x0f, 0xd2, 0xf8, 0xd0, 0x34, 0xc2, 0x08, 0xfb, 0xfb, 0x7c,
+	0x15, 0xec, 0xce, 0x23, 0x8c, 0x51, 0x13, 0xea, 0xa6, 0x6b, 0xe9, 0xa6, 0xe3, 0x60, 0x66, 0x32,
+	0x0b, 0x3b, 0x41, 0x8c, 0xf6, 0x4c, 0x00, 0x3f, 0x55, 0x28, 0x5a, 0x63, 0x0d, 0x48, 0x60, 0xcb,
+	0xde, 0xec, 0xc8, 0x32, 0x90, 0x76, 0x08, 0xb6, 0x95, 0x91, 0xac, 0x90, 0x9b, 0x34, 0xbc, 0xb5,
+	0xfc, 0x07, 0x10, 0x89, 0xf9, 0x48, 0x19, 0xe5, 0x5b, 0x25, 0xf9, 0xf0, 0x24, 0x13, 0xfb, 0x7c,
+	0x92, 0x01, 0xfd, 0x20, 0x83, 0xc3, 0xc5, 0x85, 0xe7, 0xaf, 0x33, 0xb1, 0x17, 0xdd, 0x83, 0x25,
+	0x25, 0x64, 0x6c, 0x40, 0xbc, 0x2c, 0x25, 0x84, 0x64, 0xbc, 0x2c, 0x25, 0xe2, 0x49, 0xb1, 0x2c,
+	0x25, 0xc4, 0xa4, 0x54, 0x96, 0x12, 0x52, 0x72, 0x44, 0xd3, 0x80, 0xba, 0xd6, 0x61, 0xd0, 0xa1,
+	0x16, 0x76, 0xee, 0xb9, 0x5e, 0x81, 0xfd, 0xa8, 0xa2, 0xc4, 0x85, 0xb5, 0x37, 0x02, 0x98, 0x19,
+	0x50, 0x33, 0x20, 0x75, 0xb1, 0x43, 0x21, 0x2f, 0xb9, 0x61, 0xd2, 0x86, 0x22, 0x64, 0x85, 0xdc,
+	0xb8, 0xe1, 0xad, 0xe5, 0x45, 0x20, 0x35, 0x31, 0xa2, 0x4a, 0x3c, 0x2b, 0xe6, 0x26, 0x0a, 0x33,
+	0xf9, 0x8b, 0x03, 0xc9, 0xdf, 0xc5, 0xc8, 0xf0, 0x28, 0x72, 0x12, 0x88, 0x04, 0x32, 0x45, 0xf4,
+	0x0c, 0xf3, 0xa5, 0x9c, 0x02, 0x89, 0xb6, 0x5d, 0x85, 0x84, 0x60, 0xa2, 0x48, 0x9e, 0xe8, 0x58,
+	0xdb, 0x5e, 0xe3, 0xaf, 0x1c, 0x42, 0x26, 0xad, 0xb6, 0x28, 0xac, 0x7b, 0x2d, 0x92, 0x8c, 0x31,
+	0x64, 0xd2, 0x2d, 0x0a, 0xeb, 0x41, 0x99, 0xef, 0x05, 0x30, 0x55, 0xa1, 0x68, 0xcb, 0xad, 0x9b,
+	0x0c, 0xae, 0x9b, 0xc4, 0xb4, 0xa9, 0xfc, 0x3f, 0x18, 0x37, 0x5b, 0xac, 0x81, 0x89, 0xc5, 0xf6,
+	0xfc, 0x2a, 0x4b, 0xca, 0x87, 0x77, 0xff, 0x4e, 0x07, 0x45, 0xad, 0xd6, 0xeb, 0x04, 0x52, 0xba,
+	0xc1, 0x88, 0xe5, 0x20, 0xa3, 0x4f, 0x95, 0x6f, 0x82, 0x51, 0xd7, 0x53, 0x50, 0xe2, 0x59, 0x21,
+	0x37, 0x51, 0x50, 0x86, 0x6d, 0xf8, 0x19, 0x4a, 0xe3, 0x7c, 0x28, 0x6f, 0xbb, 0x07, 0x4b, 0x82,
+	0x11, 0x84, 0x14, 0x0b, 0xfb, 0xdd, 0x83, 0xa5, 0xbe, 0x18, 0x1f, 0x4c, 0x26, 0x34, 0x98, 0x8e,
+	0xee, 0x4f, 0x27, 0x5c, 0xa8, 0x96, 0x02, 0x73, 0x17, 0xb6, 0xce, 0x9b, 0xac, 0x7d, 0x12, 0xc0,
+	0x6c, 0x85, 0x22, 0x03, 0x22, 0x8b, 0x32, 0x48, 0xd6, 0x09, 0xb4, 0x1c, 0xca, 0xcc, 0x66, 0xf3,
+	0xfa, 0xf6, 0xee, 0x80, 0x09, 0xb7, 0x2f, 0x13, 0x8c, 0x6a, 0x3e, 0xc2, 0x63, 0x8f, 0x14, 0xf6,
+	0x19, 0x8e, 0x2d, 0xde, 0x18, 0x36, 0xfb, 0x57, 0x84, 0xd9, 0x88, 0xea, 0xb5, 0x2c, 0x48, 0x47,
+	0x23, 0x3d, 0xeb, 0x5d, 0x01, 0xa4, 0x2a, 0x14, 0x6d, 0xd4, 0x1a, 0xb0, 0xde, 0x6a, 0xc2, 0xdb,
+	0x98, 0xec, 0xae, 0xd6, 0x98, 0xd5, 0xf6, 0xbe, 0xa4, 0x6b, 0xbb, 0xdf, 0x04, 0x53, 0x3b, 0x98,
+	0xec, 0x56, 0xcd, 0x9e, 0x54, 0x30, 0xe5, 0xec, 0x70, 0x07, 0x06, 0x53, 0x86, 0xbb, 0xf0, 0xf3,
+	0xce, 0x00, 0x54, 0xbc, 0x35, 0xdc, 0x88, 0xc5, 0x88, 0x46, 0x44, 0x7b, 0xd1, 0x7e, 0x07, 0x0b,
+	0x97, 0x82, 0xe7, 0xed, 0x28, 0x1c, 0x8b, 0x40, 0xac, 0x50, 0x24, 0x3f, 0x01, 0xa1, 0x2b, 0x40,
+	0xce, 0x0c, 0x57, 0x3d, 0xf0, 0xb5, 0xaa, 0x7f, 0x5f, 0x41, 0xe8, 0xb5, 0xfb, 0xcf, 0xfd, 0x8f,
+	0x5f, 0x5f, 0xc5, 0x33, 0xda, 0x6f, 0xfa, 0xf0, 0x05, 0x19, 0xb0, 0xab, 0xac, 0x23, 0x3f, 0x00,
+	0x93, 0x03, 0x1f, 0xd9, 0x42, 0xa4, 0x7e, 0x98, 0xa2, 0x2e, 0x5e, 0x49, 0xe9, 0xdd, 0x29, 0x0f,
+	0xc1, 0xaf, 0x51, 0x47, 0x3d, 0x17, 0xa9, 0x10, 0xc1, 0x54, 0xff, 0xfb, 0x5e, 0x66, 0x2f, 0xe5,
+	0x63, 0x30, 0x7b, 0xc9, 0x11, 0xfb, 0x27, 0x52, 0x2b, 0x9a, 0xac, 0xae, 0xfc, 0x00, 0xf9, 0x3c,
+	0xb7, 0x3a, 0xf2, 0x94, 0x9f, 0xa6, 0x52, 0xf1, 0xf0, 0x34, 0x2d, 0x1c, 0x9d, 0xa6, 0x85, 0x2f,
+	0xa7, 0x69, 0xe1, 0xe5, 0x59, 0x3a, 0x76, 0x74, 0x96, 0x8e, 0x1d, 0x9f, 0xa5, 0x63, 0xf7, 0xb3,
+	0xc8, 0x62, 0x8d, 0xd6, 0x76, 0xbe, 0x86, 0x6d, 0xfd, 0xe2, 0x79, 0x62, 0x7b, 0x2e, 0xa4, 0xdb,
+	0xa3, 0xde, 0x3f, 0xca, 0xca, 0xb7, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x9a, 0x5d, 0xfd, 0x17,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -431,6 +533,10 @@ type MsgClient interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// ScheduleForkActivation defines a governance operation for scheduling the
+	// activation time of a future Ethereum hard fork. The authority is the same
+	// as is used for Params updates.
+	ScheduleForkActivation(ctx context.Context, in *MsgScheduleForkActivation, opts ...grpc.CallOption) (*MsgScheduleForkActivationResponse, error)
 }
 
 type msgClient struct {
@@ -468,6 +574,15 @@ func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPrei
 	return out, nil
 }
 
+func (c *msgClient) ScheduleForkActivation(ctx context.Context, in *MsgScheduleForkActivation, opts ...grpc.CallOption) (*MsgScheduleForkActivationResponse, error) {
+	out := new(MsgScheduleForkActivationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Msg/ScheduleForkActivation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -480,6 +595,10 @@ type MsgServer interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// ScheduleForkActivation defines a governance operation for scheduling the
+	// activation time of a future Ethereum hard fork. The authority is the same
+	// as is used for Params updates.
+	ScheduleForkActivation(context.Context, *MsgScheduleForkActivation) (*MsgScheduleForkActivationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -495,6 +614,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) RegisterPreinstalls(ctx context.Context, req *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleForkActivation(ctx context.Context, req *MsgScheduleForkActivation) (*MsgScheduleForkActivationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleForkActivation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -554,6 +676,24 @@ func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleForkActivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleForkActivation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleForkActivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Msg/ScheduleForkActivation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleForkActivation(ctx, req.(*MsgScheduleForkActivation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -570,6 +710,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPreinstalls",
 			Handler:    _Msg_RegisterPreinstalls_Handler,
 		},
+		{
+			MethodName: "ScheduleForkActivation",
+			Handler:    _Msg_ScheduleForkActivation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
@@ -831,6 +975,69 @@ func (m *MsgRegisterPreinstallsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgScheduleForkActivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleForkActivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleForkActivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ForkActivation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleForkActivationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleForkActivationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleForkActivationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -948,6 +1155,30 @@ func (m *MsgRegisterPreinstallsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleForkActivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ForkActivation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleForkActivationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1653,6 +1884,171 @@ func (m *MsgRegisterPreinstallsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleForkActivation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleForkActivation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleForkActivation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkActivation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForkActivation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleForkActivationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleForkActivationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleForkActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
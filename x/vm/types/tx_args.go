@@ -64,6 +64,10 @@ type TransactionArgs struct {
 
 	// For SetCodeTxType
 	AuthorizationList []types.SetCodeAuthorization `json:"authorizationList"`
+
+	// FeePayer is the fee granter paying the fees on behalf of the sender,
+	// through the ExtensionOptionFeePayerTx extension option.
+	FeePayer *common.Address `json:"feePayer,omitempty"`
 }
 
 // GetFrom retrieves the transaction sender address.
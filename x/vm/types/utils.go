@@ -38,6 +38,16 @@ func IsEmptyCodeHash(bz []byte) bool {
 	return bytes.Equal(bz, EmptyCodeHash)
 }
 
+// PrevRandao returns the value of the PREVRANDAO opcode, served as the mixHash
+// of the Ethereum block, for the block with the given CometBFT header hash. The
+// header hash commits to the consensus data of the block, such as the last
+// commit signatures, the proposer and the app hash, so the value is the same on
+// every validator and changes on every block. It is not a secure source of
+// randomness, as it can be influenced by the block proposer.
+func PrevRandao(headerHash []byte) common.Hash {
+	return crypto.Keccak256Hash(headerHash)
+}
+
 // DecodeTxResponse decodes a protobuf-encoded byte slice into TxResponse
 func DecodeTxResponse(in []byte) (*MsgEthereumTxResponse, error) {
 	responses, err := DecodeTxResponses(in)
@@ -109,6 +119,35 @@ func TxLogsFromEvents(events []abci.Event, msgIndex int) ([]*ethtypes.Log, error
 	return nil, fmt.Errorf("eth tx logs not found for message index %d", index)
 }
 
+// TxFeePayerFromEvents returns the fee granter that paid the fees of the
+// Ethereum tx with the given hash on behalf of its sender. It returns false if
+// the sender paid the fees of the tx.
+func TxFeePayerFromEvents(events []abci.Event, txHash common.Hash) (common.Address, bool) {
+	for _, event := range events {
+		if event.Type != EventTypeEthereumTx {
+			continue
+		}
+
+		var (
+			hash     common.Hash
+			feePayer string
+		)
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case AttributeKeyEthereumTxHash:
+				hash = common.HexToHash(attr.Value)
+			case AttributeKeyTxFeePayer:
+				feePayer = attr.Value
+			}
+		}
+
+		if hash == txHash && feePayer != "" {
+			return common.HexToAddress(feePayer), true
+		}
+	}
+	return common.Address{}, false
+}
+
 // ParseTxLogsFromEvent parse tx logs from one event
 func ParseTxLogsFromEvent(event abci.Event) ([]*ethtypes.Log, error) {
 	logs := make([]*Log, 0, len(event.Attributes))
@@ -605,6 +605,71 @@ func TestTxLogsFromEvents(t *testing.T) {
 	}
 }
 
+func TestTxFeePayerFromEvents(t *testing.T) {
+	txHash := common.HexToHash("0x0eb002bd8fa02c0b0d549acfca70f7aab5fa745af118c76dda60a1f4329d0de1")
+	feePayer := common.HexToAddress("0xc5570e6B97044960be06962E13248EC6b13107AE")
+
+	testCases := []struct {
+		name        string
+		events      []abci.Event
+		expFound    bool
+		expFeePayer common.Address
+	}{
+		{
+			name: "fee payer found",
+			events: []abci.Event{
+				{
+					Type: evmtypes.EventTypeEthereumTx,
+					Attributes: []abci.EventAttribute{
+						{Key: evmtypes.AttributeKeyEthereumTxHash, Value: txHash.Hex()},
+						{Key: evmtypes.AttributeKeyTxFeePayer, Value: feePayer.Hex()},
+					},
+				},
+			},
+			expFound:    true,
+			expFeePayer: feePayer,
+		},
+		{
+			name: "fees paid by the sender",
+			events: []abci.Event{
+				{
+					Type: evmtypes.EventTypeEthereumTx,
+					Attributes: []abci.EventAttribute{
+						{Key: evmtypes.AttributeKeyEthereumTxHash, Value: txHash.Hex()},
+					},
+				},
+			},
+			expFound: false,
+		},
+		{
+			name: "fee payer of another tx",
+			events: []abci.Event{
+				{
+					Type: evmtypes.EventTypeEthereumTx,
+					Attributes: []abci.EventAttribute{
+						{Key: evmtypes.AttributeKeyEthereumTxHash, Value: common.HexToHash("0x01").Hex()},
+						{Key: evmtypes.AttributeKeyTxFeePayer, Value: feePayer.Hex()},
+					},
+				},
+			},
+			expFound: false,
+		},
+		{
+			name:     "no events",
+			events:   []abci.Event{},
+			expFound: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, found := evmtypes.TxFeePayerFromEvents(tc.events, txHash)
+			require.Equal(t, tc.expFound, found)
+			require.Equal(t, tc.expFeePayer, addr)
+		})
+	}
+}
+
 const testBlockNumber = uint64(3)
 
 // createLogEventValue creates a JSON string representation of an EVM log event
@@ -544,6 +544,20 @@ func (m *MockErc20Keeper) EXPECT() *MockErc20KeeperMockRecorder {
 	return m.recorder
 }
 
+// GetDynamicPrecompiles mocks base method.
+func (m *MockErc20Keeper) GetDynamicPrecompiles(ctx types.Context) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicPrecompiles", ctx)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetDynamicPrecompiles indicates an expected call of GetDynamicPrecompiles.
+func (mr *MockErc20KeeperMockRecorder) GetDynamicPrecompiles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicPrecompiles", reflect.TypeOf((*MockErc20Keeper)(nil).GetDynamicPrecompiles), ctx)
+}
+
 // GetERC20PrecompileInstance mocks base method.
 func (m *MockErc20Keeper) GetERC20PrecompileInstance(ctx types.Context, address common.Address) (vm.PrecompiledContract, bool, error) {
 	m.ctrl.T.Helper()
@@ -560,6 +574,20 @@ func (mr *MockErc20KeeperMockRecorder) GetERC20PrecompileInstance(ctx, address i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetERC20PrecompileInstance", reflect.TypeOf((*MockErc20Keeper)(nil).GetERC20PrecompileInstance), ctx, address)
 }
 
+// GetNativePrecompiles mocks base method.
+func (m *MockErc20Keeper) GetNativePrecompiles(ctx types.Context) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNativePrecompiles", ctx)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetNativePrecompiles indicates an expected call of GetNativePrecompiles.
+func (mr *MockErc20KeeperMockRecorder) GetNativePrecompiles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNativePrecompiles", reflect.TypeOf((*MockErc20Keeper)(nil).GetNativePrecompiles), ctx)
+}
+
 // MockEvmHooks is a mock of EvmHooks interface.
 type MockEvmHooks struct {
 	ctrl     *gomock.Controller